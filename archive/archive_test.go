@@ -8,7 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/microindex"
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
@@ -18,7 +20,9 @@ import (
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 )
 
 const babble = "../ztests/suite/data/babble.tzng"
@@ -185,3 +189,180 @@ func TestSeekIndex(t *testing.T) {
 `
 	require.Equal(t, test.Trim(exp), buf.String())
 }
+
+func TestZstDataFormat(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	createArchiveSpace(t, datapath, babble, &CreateOptions{
+		DataFormat: "zst",
+	})
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+
+	var chunks []Chunk
+	err = Walk(context.Background(), ark, func(chunk Chunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, chunks, 2)
+	require.EqualValues(t, FileKindDataZst, chunks[0].DataFileKind)
+
+	// Reading a subset of fields yields records holding only those fields
+	// and ts.
+	rc, err := newDataFileReader(context.Background(), resolver.NewContext(), chunks[0].Path(ark), []string{"v"})
+	require.NoError(t, err)
+	defer rc.Close()
+	rec, err := rc.Read()
+	require.NoError(t, err)
+	require.Equal(t, "record[ts:time,v:int64]", rec.Type.String())
+}
+
+func TestConcurrentWriters(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	var g errgroup.Group
+	for i := 0; i < 2; i++ {
+		g.Go(func() error {
+			ark, err := CreateOrOpenArchive(datapath, &CreateOptions{}, nil)
+			if err != nil {
+				return err
+			}
+			zctx := resolver.NewContext()
+			reader, err := detector.OpenFile(zctx, babble, zio.ReaderOpts{})
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+			return Import(context.Background(), ark, zctx, reader)
+		})
+	}
+	require.NoError(t, g.Wait())
+
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	count := func() int {
+		var n int
+		err := Walk(context.Background(), ark, func(chunk Chunk) error {
+			n += chunk.RecordCount
+			return nil
+		})
+		require.NoError(t, err)
+		return n
+	}
+	require.Equal(t, 2000, count())
+
+	// Concurrent deletes of the same records must each see the other's
+	// changes, so that every matching record is deleted exactly once.
+	query, err := zql.ParseProc("v=336")
+	require.NoError(t, err)
+	var deleted [2]int64
+	for i := range deleted {
+		i := i
+		g.Go(func() error {
+			var err error
+			deleted[i], err = Delete(context.Background(), ark, query.(*ast.FilterProc).Filter, nil)
+			return err
+		})
+	}
+	require.NoError(t, g.Wait())
+	require.EqualValues(t, 4, deleted[0]+deleted[1])
+	require.Equal(t, 1996, count())
+}
+
+func TestSpanScannerHoldsLock(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+	createArchiveSpace(t, datapath, babble, &CreateOptions{})
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	var sinfos []SpanInfo
+	err = SpanWalk(ctx, ark, nano.MaxSpan, ark.DataSortDirection, func(si SpanInfo) error {
+		sinfos = append(sinfos, si)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, sinfos)
+	sc, err := newSpanScanner(ctx, ark, resolver.NewContext(), nil, nil, nil, sinfos[0], ark.DataSortDirection, 0)
+	require.NoError(t, err)
+
+	// A delete must wait until the scanner is closed.
+	query, err := zql.ParseProc("v=336")
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() {
+		_, err := Delete(ctx, ark, query.(*ast.FilterProc).Filter, nil)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("delete finished while a scanner was open: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, sc.Close())
+	require.NoError(t, <-done)
+}
+
+func TestParseBundleEntry(t *testing.T) {
+	const id = "1cXcKz0JoJfnv4rNGNKFmSqBtJy"
+	for _, name := range []string{
+		"zd/20200422/d-" + id + ".zng",
+		"zd/20200422/dz-" + id + ".zst",
+		"zd/20200422/d-" + id + ".zng.zar/microindex-field-v.zng",
+		"zd/20200422/ts-" + id + "-10-2-1.zng",
+	} {
+		e, err := parseBundleEntry(name)
+		require.NoError(t, err, name)
+		require.Equal(t, id, e.id.String(), name)
+	}
+	for _, name := range []string{
+		"zar.json",
+		"../zd/20200422/d-" + id + ".zng",
+		"zd/20200422/../../d-" + id + ".zng",
+		"zd/20200422/d-" + id + ".zng.zar/..",
+		"zd/notadir/d-" + id + ".zng",
+		"zd/20200422/x-d-" + id + ".zng",
+		"zd/20200422/d-" + id + ".zng.zar/sub/microindex-field-v.zng",
+	} {
+		_, err := parseBundleEntry(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestNewIndexDef(t *testing.T) {
+	def, err := NewIndexDef("bytes", "", nil, "sum(orig_bytes) by id.orig_h,id.resp_p", 0)
+	require.NoError(t, err)
+	rule, err := def.Rule()
+	require.NoError(t, err)
+	require.Equal(t, []string{"id.orig_h", "id.resp_p"}, rule.keys)
+	require.Equal(t, "bytes", rule.path)
+
+	def, err = NewIndexDef("v", "v", nil, "", 0)
+	require.NoError(t, err)
+	rule, err = def.Rule()
+	require.NoError(t, err)
+	require.Equal(t, fieldMicroIndexName("v"), rule.path)
+
+	for _, c := range []struct {
+		name, pattern string
+		keys          []string
+		zql           string
+	}{
+		{"", "v", nil, ""},
+		{"../x", "v", nil, ""},
+		{"microindex-x", "v", nil, ""},
+		{"x", "", nil, ""},
+		{"x", "v", []string{"v"}, ""},
+		{"x", "", nil, "head 1"},
+	} {
+		_, err := NewIndexDef(c.name, c.pattern, c.keys, c.zql, 0)
+		require.Error(t, err, "%+v", c)
+	}
+}
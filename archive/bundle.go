@@ -0,0 +1,337 @@
+package archive
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/pkg/bufwriter"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqe"
+	"github.com/segmentio/ksuid"
+)
+
+// A bundle is a tar stream holding an archive's metadata file followed by
+// the files of some of its chunks.  The path of each chunk file in the bundle
+// is its path relative to the archive's data path.  The files of a chunk
+// appear in the order data file, microindexes, seek index.
+
+// Export writes a bundle holding every chunk of the archive whose span
+// overlaps span to the location uri and returns the number of chunks
+// written.  Chunks are not split, so a bundle may hold records outside of
+// span.
+func Export(ctx context.Context, ark *Archive, span nano.Span, uri iosrc.URI, progress chan<- string) (int, error) {
+	out, err := iosrc.NewWriter(ctx, uri)
+	if err != nil {
+		return 0, err
+	}
+	bw := bufwriter.New(out)
+	tw := tar.NewWriter(bw)
+	n, err := exportChunks(ctx, ark, span, tw, progress)
+	if err == nil {
+		err = tw.Close()
+	}
+	if closeErr := bw.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Ignore context here in the event that context is the reason
+		// for the failure.
+		iosrc.Remove(context.Background(), uri)
+		return 0, err
+	}
+	return n, nil
+}
+
+func exportChunks(ctx context.Context, ark *Archive, span nano.Span, tw *tar.Writer, progress chan<- string) (int, error) {
+	m := &Metadata{
+		Version:           0,
+		LogSizeThreshold:  ark.LogSizeThreshold,
+		DataSortDirection: ark.DataSortDirection,
+		DataPath:          ".",
+		DataFormat:        ark.DataFormat,
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	hdr := &tar.Header{
+		Name:    metadataFilename,
+		Mode:    0644,
+		Size:    int64(len(b)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return 0, err
+	}
+	if _, err := tw.Write(b); err != nil {
+		return 0, err
+	}
+	var n int
+	err = tsDirVisit(ctx, ark, span, func(_ tsDir, chunks []Chunk) error {
+		chunksSort(ark.DataSortDirection, chunks)
+		for _, chunk := range chunks {
+			ok, err := exportChunk(ctx, ark, chunk, tw)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			n++
+			if progress != nil {
+				progress <- fmt.Sprintf("%s: exported", chunk.Path(ark))
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
+// exportChunk writes the files of chunk to tw.  It holds the archive lock so
+// that the chunk cannot be removed while it is being written, and it
+// reports false if the chunk was removed after it was listed.
+func exportChunk(ctx context.Context, ark *Archive, chunk Chunk, tw *tar.Writer) (bool, error) {
+	unlock, ok, err := ark.lockChunk(ctx, chunk, false)
+	if err != nil || !ok {
+		return false, err
+	}
+	defer unlock()
+	if err := exportFile(ctx, ark, chunk.Path(ark), tw); err != nil {
+		return false, err
+	}
+	zardir := chunk.ZarDir(ark)
+	dirents, err := ark.dataSrc.ReadDir(ctx, zardir)
+	if err != nil && !zqe.IsNotFound(err) {
+		return false, err
+	}
+	for _, e := range dirents {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		if err := exportFile(ctx, ark, zardir.AppendPath(e.Name()), tw); err != nil {
+			return false, err
+		}
+	}
+	return true, exportFile(ctx, ark, chunk.seekIndexPath(ark), tw)
+}
+
+func exportFile(ctx context.Context, ark *Archive, uri iosrc.URI, tw *tar.Writer) error {
+	info, err := ark.dataSrc.Stat(ctx, uri)
+	if err != nil {
+		return err
+	}
+	r, err := ark.dataSrc.NewReader(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	hdr := &tar.Header{
+		Name:    strings.TrimPrefix(strings.TrimPrefix(uri.Path, ark.DataPath.Path), "/"),
+		Mode:    0644,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, io.LimitReader(r, info.Size()))
+	return err
+}
+
+type bundleEntry struct {
+	id   ksuid.KSUID
+	kind FileKind
+}
+
+// parseBundleEntry returns the chunk id of a bundle entry and, for data
+// files and seek indexes, the kind of the file.  A microindex has kind
+// FileKindUnknown.
+func parseBundleEntry(name string) (bundleEntry, error) {
+	bad := zqe.E(zqe.Invalid, "bundle entry %q is not an archive file", name)
+	parts := strings.Split(name, "/")
+	if path.Clean(name) != name || len(parts) < 3 || len(parts) > 4 || parts[0] != dataDirname {
+		return bundleEntry{}, bad
+	}
+	if _, ok := parseTsDirName(parts[1]); !ok {
+		return bundleEntry{}, bad
+	}
+	if len(parts) == 4 {
+		if parts[3] == ".." || !strings.HasSuffix(parts[2], zarExt) {
+			return bundleEntry{}, bad
+		}
+		df, ok := dataFileNameMatch(strings.TrimSuffix(parts[2], zarExt))
+		if !ok || df.name()+zarExt != parts[2] {
+			return bundleEntry{}, bad
+		}
+		return bundleEntry{df.id, FileKindUnknown}, nil
+	}
+	if df, ok := dataFileNameMatch(parts[2]); ok && df.name() == parts[2] {
+		return bundleEntry{df.id, df.kind}, nil
+	}
+	if sf, ok := seekIndexNameMatch(parts[2]); ok && sf.name() == parts[2] {
+		return bundleEntry{sf.id, FileKindSeek}, nil
+	}
+	return bundleEntry{}, bad
+}
+
+// Restore merges the chunks of the bundle at the location uri into the
+// archive and returns the number of chunks added.  Chunks already present
+// in the archive are skipped.  The restored chunks become visible to
+// readers of the archive only once all of them have been written.
+func Restore(ctx context.Context, ark *Archive, uri iosrc.URI, progress chan<- string) (int, error) {
+	r, err := iosrc.NewReader(ctx, uri)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil {
+		if err == io.EOF {
+			err = zqe.E(zqe.Invalid, "bundle is empty")
+		}
+		return 0, err
+	}
+	if hdr.Name != metadataFilename {
+		return 0, zqe.E(zqe.Invalid, "bundle does not begin with %s", metadataFilename)
+	}
+	var m Metadata
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return 0, err
+	}
+	if m.DataSortDirection != ark.DataSortDirection {
+		return 0, zqe.E(zqe.Invalid, "bundle data sort direction does not match archive")
+	}
+	// Chunks are skipped or restored based on whether they are already
+	// visible in the archive, so that the files of a chunk left by an
+	// interrupted restore are overwritten.  Seek indexes are written last,
+	// since they make chunks visible, so they are held until the rest of
+	// the bundle is written.
+	type seekIndex struct {
+		uri  iosrc.URI
+		data []byte
+	}
+	restore := make(map[ksuid.KSUID]bool)
+	var seekIndexes []seekIndex
+	dirmkr, _ := ark.dataSrc.(iosrc.DirMaker)
+	ids := make(map[string]map[ksuid.KSUID]bool)
+	visible := func(ctx context.Context, dir string, id ksuid.KSUID) (bool, error) {
+		if _, ok := ids[dir]; !ok {
+			m, err := seekIndexIDs(ctx, ark, ark.DataPath.AppendPath(dir))
+			if err != nil {
+				return false, err
+			}
+			ids[dir] = m
+		}
+		return ids[dir][id], nil
+	}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return 0, zqe.E(zqe.Invalid, "bundle entry %q is not a regular file", hdr.Name)
+		}
+		e, err := parseBundleEntry(hdr.Name)
+		if err != nil {
+			return 0, err
+		}
+		dst := ark.DataPath.AppendPath(hdr.Name)
+		ok, seen := restore[e.id]
+		switch {
+		case e.kind == FileKindData || e.kind == FileKindDataZst:
+			if seen {
+				return 0, zqe.E(zqe.Invalid, "bundle holds chunk %s more than once", e.id)
+			}
+			exists, err := visible(ctx, path.Dir(hdr.Name), e.id)
+			if err != nil {
+				return 0, err
+			}
+			restore[e.id] = !exists
+			if exists {
+				if progress != nil {
+					progress <- fmt.Sprintf("%s: skipping existing chunk", dst)
+				}
+				continue
+			}
+		case !seen:
+			return 0, zqe.E(zqe.Invalid, "bundle entry %q precedes its data file", hdr.Name)
+		case !ok:
+			continue
+		case e.kind == FileKindSeek:
+			b, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return 0, err
+			}
+			seekIndexes = append(seekIndexes, seekIndex{dst, b})
+			continue
+		}
+		if dirmkr != nil {
+			if err := dirmkr.MkdirAll(ark.DataPath.AppendPath(path.Dir(hdr.Name)), 0755); err != nil {
+				return 0, err
+			}
+		}
+		if err := restoreFile(ctx, ark, dst, tr); err != nil {
+			return 0, err
+		}
+	}
+	unlock, err := ark.lock(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	for _, s := range seekIndexes {
+		if err := ark.dataSrc.WriteFile(ctx, s.data, s.uri); err != nil {
+			return 0, err
+		}
+		if progress != nil {
+			progress <- fmt.Sprintf("%s: restored", s.uri)
+		}
+	}
+	return len(seekIndexes), nil
+}
+
+// seekIndexIDs returns the ids of the chunks having a seek index in the
+// directory dir.
+func seekIndexIDs(ctx context.Context, ark *Archive, dir iosrc.URI) (map[ksuid.KSUID]bool, error) {
+	unlock, err := ark.lock(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	dirents, err := ark.dataSrc.ReadDir(ctx, dir)
+	if err != nil && !zqe.IsNotFound(err) {
+		return nil, err
+	}
+	ids := make(map[ksuid.KSUID]bool)
+	for _, e := range dirents {
+		if sf, ok := seekIndexNameMatch(e.Name()); ok {
+			ids[sf.id] = true
+		}
+	}
+	return ids, nil
+}
+
+func restoreFile(ctx context.Context, ark *Archive, uri iosrc.URI, r io.Reader) error {
+	w, err := ark.dataSrc.NewWriter(ctx, uri)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
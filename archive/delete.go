@@ -0,0 +1,278 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/microindex"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqe"
+)
+
+// Delete removes all records matching filterExpr from the archive and
+// returns the number of records removed. Each chunk containing matching
+// records is rewritten as a new chunk holding only the remaining records,
+// and the microindexes of the archive's index definitions and the standard
+// field and type microindexes of the original chunk are built for the new
+// one. A chunk whose zar directory holds any other file, such as an index
+// created without a definition, cannot be rewritten without losing that
+// file, so Delete fails on it instead.
+//
+// When filterExpr requires a field to equal a value and a field microindex
+// exists for that field, chunks without a hit in the index are skipped
+// without being read.
+func Delete(ctx context.Context, ark *Archive, filterExpr ast.BooleanExpr, progress chan<- string) (int64, error) {
+	f, err := filter.Compile(resolver.NewContext(), filterExpr)
+	if err != nil {
+		return 0, zqe.E(zqe.Invalid, err)
+	}
+	query, hasQuery := deleteIndexQuery(filterExpr)
+	// Collect the chunks up front since rewriting adds new chunks to the
+	// tsDirs being walked.
+	var chunks []Chunk
+	err = Walk(ctx, ark, func(chunk Chunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for _, chunk := range chunks {
+		if hasQuery {
+			hit, err := chunkIndexHit(ctx, ark, chunk, query)
+			if err != nil {
+				return deleted, err
+			}
+			if !hit {
+				continue
+			}
+		}
+		n, err := chunkDeleteLocked(ctx, ark, chunk, f, progress)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+// deleteIndexQuery returns a query against the field microindex that any
+// record matching e must hit, if such a query can be derived from e.
+func deleteIndexQuery(e ast.BooleanExpr) (IndexQuery, bool) {
+	switch e := e.(type) {
+	case *ast.CompareField:
+		if e.Comparator != "=" {
+			return IndexQuery{}, false
+		}
+		switch e.Field.(type) {
+		case *ast.Field, *ast.BinaryExpression:
+		default:
+			return IndexQuery{}, false
+		}
+		field := ast.FieldExprToString(e.Field)
+		if strings.ContainsAny(field, "[ ") {
+			return IndexQuery{}, false
+		}
+		return IndexQuery{
+			indexName: fieldMicroIndexName(field),
+			patterns:  []string{e.Value.Value},
+		}, true
+	case *ast.LogicalAnd:
+		if q, ok := deleteIndexQuery(e.Left); ok {
+			return q, true
+		}
+		return deleteIndexQuery(e.Right)
+	}
+	return IndexQuery{}, false
+}
+
+// chunkIndexHit reports whether the chunk may hold records matching query.
+// A chunk without the queried index, or whose index cannot parse the query
+// patterns, is assumed to hold matches.
+func chunkIndexHit(ctx context.Context, ark *Archive, chunk Chunk, query IndexQuery) (bool, error) {
+	finder, err := microindex.NewFinder(ctx, resolver.NewContext(), chunk.ZarDir(ark).AppendPath(query.indexName))
+	if err != nil {
+		if zqe.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	defer finder.Close()
+	keys, err := finder.ParseKeys(query.patterns)
+	if err != nil {
+		return true, nil
+	}
+	if keys == nil {
+		// The index is empty.
+		return false, nil
+	}
+	rec, err := finder.Lookup(keys)
+	if err != nil {
+		return false, err
+	}
+	return rec != nil, nil
+}
+
+// chunkScan reads the chunk's records, counting those matching f and
+// writing the others to w when w is non-nil.
+func chunkScan(ctx context.Context, ark *Archive, chunk Chunk, f filter.Filter, w zbuf.Writer) (int64, error) {
+	r, err := newDataFileReader(ctx, resolver.NewContext(), chunk.Path(ark), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	var n int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		rec, err := r.Read()
+		if err != nil {
+			return n, err
+		}
+		if rec == nil {
+			return n, nil
+		}
+		if f(rec) {
+			n++
+			continue
+		}
+		if w != nil {
+			if err := w.Write(rec); err != nil {
+				return n, err
+			}
+		}
+	}
+}
+
+// chunkDeleteLocked runs chunkDelete while holding the archive lock, so that
+// readers never see both the chunk and its rewritten replacement and
+// concurrent deletes do not rewrite the same chunk twice.
+func chunkDeleteLocked(ctx context.Context, ark *Archive, chunk Chunk, f filter.Filter, progress chan<- string) (int64, error) {
+	unlock, ok, err := ark.lockChunk(ctx, chunk, true)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	if !ok {
+		// The chunk was removed after it was listed.
+		return 0, nil
+	}
+	return chunkDelete(ctx, ark, chunk, f, progress)
+}
+
+func chunkDelete(ctx context.Context, ark *Archive, chunk Chunk, f filter.Filter, progress chan<- string) (int64, error) {
+	// The rules are found before the chunk is read, but an error finding
+	// them matters only if the chunk must be rewritten.
+	rules, rulesErr := chunkRules(ctx, ark, chunk)
+	tsDirURI := ark.DataPath.AppendPath(dataDirname, newTsDir(chunk.First).name())
+	w, err := newChunkWriter(ctx, ark, tsDirURI)
+	if err != nil {
+		return 0, err
+	}
+	n, err := chunkScan(ctx, ark, chunk, f, w)
+	switch {
+	case err != nil || n == 0:
+		w.abort()
+		return 0, err
+	case n == int64(chunk.RecordCount):
+		w.abort()
+	default:
+		if err := chunkCommit(ctx, w, rules, rulesErr, progress); err != nil {
+			return 0, err
+		}
+	}
+	if progress != nil {
+		progress <- fmt.Sprintf("%s: deleted %d of %d records", chunk.Path(ark), n, chunk.RecordCount)
+	}
+	return n, chunkRemove(ctx, ark, chunk)
+}
+
+// chunkCommit finishes the rewritten chunk being written by w, builds its
+// microindexes of rules, and commits it.
+func chunkCommit(ctx context.Context, w *chunkWriter, rules []Rule, rulesErr error, progress chan<- string) error {
+	if rulesErr != nil {
+		w.abort()
+		return rulesErr
+	}
+	if err := w.closeData(); err != nil {
+		return err
+	}
+	if err := w.index(ctx, rules, progress); err != nil {
+		os.Remove(w.indexTempPath)
+		return err
+	}
+	// The caller holds the archive lock.
+	return w.commit(ctx)
+}
+
+// chunkRules returns the rules that rebuild every microindex in the chunk's
+// zar directory: the archive's index definitions and the standard field and
+// type microindexes.  Any other file in the zar directory cannot be rebuilt
+// for a rewritten chunk, so an error is returned rather than losing it.
+func chunkRules(ctx context.Context, ark *Archive, chunk Chunk) ([]Rule, error) {
+	rules, err := indexDefRules(ctx, ark)
+	if err != nil {
+		return nil, err
+	}
+	dirents, err := ark.dataSrc.ReadDir(ctx, chunk.ZarDir(ark))
+	if err != nil {
+		if zqe.IsNotFound(err) {
+			return rules, nil
+		}
+		return nil, err
+	}
+	defined := make(map[string]bool)
+	for _, r := range rules {
+		defined[r.path] = true
+	}
+	for _, e := range dirents {
+		if e.IsDir() || defined[e.Name()] {
+			continue
+		}
+		rule, err := ruleFromIndexName(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil {
+			return nil, zqe.E(zqe.Invalid, "%s: cannot rebuild %s for the rewritten chunk (create it with \"zar index -n\" to make it rebuildable)", chunk.Path(ark), e.Name())
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+// ruleFromIndexName returns the rule that creates the standard microindex
+// with the given file name, or nil if name is not a standard microindex.
+func ruleFromIndexName(name string) (*Rule, error) {
+	if !strings.HasSuffix(name, ".zng") {
+		return nil, nil
+	}
+	base := strings.TrimSuffix(name, ".zng")
+	if typeName := strings.TrimPrefix(base, "microindex-type-"); typeName != base {
+		return NewTypeRule(typeName)
+	}
+	if fieldName := strings.TrimPrefix(base, "microindex-field-"); fieldName != base {
+		return NewFieldRule(fieldName)
+	}
+	return nil, nil
+}
+
+// chunkRemove removes the chunk's seek index, data file and zar directory.
+// The seek index is removed first so that the chunk is no longer visible
+// to readers of the archive.
+func chunkRemove(ctx context.Context, ark *Archive, chunk Chunk) error {
+	if err := ark.dataSrc.Remove(ctx, chunk.seekIndexPath(ark)); err != nil {
+		return err
+	}
+	if err := ark.dataSrc.Remove(ctx, chunk.Path(ark)); err != nil {
+		return err
+	}
+	return ark.dataSrc.RemoveAll(ctx, chunk.ZarDir(ark))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/brimsec/zq/proc/spill"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zio/zstio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"go.uber.org/multierr"
@@ -31,8 +32,12 @@ var (
 )
 
 func Import(ctx context.Context, ark *Archive, zctx *resolver.Context, r zbuf.Reader) error {
-	w := newImportWriter(ctx, ark)
-	err := zbuf.CopyWithContext(ctx, w, r)
+	rules, err := indexDefRules(ctx, ark)
+	if err != nil {
+		return err
+	}
+	w := newImportWriter(ctx, ark, rules)
+	err = zbuf.CopyWithContext(ctx, w, r)
 	if closeErr := w.close(); err == nil {
 		err = closeErr
 	}
@@ -55,15 +60,17 @@ func Import(ctx context.Context, ark *Archive, zctx *resolver.Context, r zbuf.Re
 type importWriter struct {
 	ark     *Archive
 	ctx     context.Context
+	rules   []Rule
 	writers map[tsDir]*tsDirWriter
 
 	memBuffered int64
 }
 
-func newImportWriter(ctx context.Context, ark *Archive) *importWriter {
+func newImportWriter(ctx context.Context, ark *Archive, rules []Rule) *importWriter {
 	return &importWriter{
 		ark:     ark,
 		ctx:     ctx,
+		rules:   rules,
 		writers: make(map[tsDir]*tsDirWriter),
 	}
 }
@@ -221,7 +228,7 @@ func (dw *tsDirWriter) flush() error {
 		w.abort()
 		return err
 	}
-	if err := w.close(dw.ctx); err != nil {
+	if err := w.close(dw.ctx, dw.importWriter.rules); err != nil {
 		return err
 	}
 	return nil
@@ -230,8 +237,9 @@ func (dw *tsDirWriter) flush() error {
 // chunkWriter is a zbuf.Writer that writes a stream of sorted records into an
 // archive chunk file.
 type chunkWriter struct {
+	ark             *Archive
 	dataFile        dataFile
-	dataFileWriter  *zngio.Writer
+	dataFileWriter  zbuf.WriteCloser
 	indexBuilder    *zng.Builder
 	indexTempPath   string
 	indexTempWriter *zngio.Writer
@@ -243,18 +251,31 @@ type chunkWriter struct {
 }
 
 func newChunkWriter(ctx context.Context, ark *Archive, tsDirUri iosrc.URI) (*chunkWriter, error) {
-	dataFile := newDataFile()
+	dataFile := newDataFile(ark.dataFileKind)
 	out, err := ark.dataSrc.NewWriter(ctx, tsDirUri.AppendPath(dataFile.name()))
 	if err != nil {
 		return nil, err
 	}
-	dataFileWriter := zngio.NewWriter(bufwriter.New(out), zngio.WriterOpts{
-		LZ4BlockSize:     importLZ4BlockSize,
-		StreamRecordsMax: importStreamRecordsMax,
-	})
+	var dataFileWriter zbuf.WriteCloser
+	if dataFile.kind == FileKindDataZst {
+		dataFileWriter, err = zstio.NewWriter(bufwriter.New(out), zstio.WriterOpts{
+			ColumnThresh: zstio.DefaultColumnThresh,
+			SkewThresh:   zstio.DefaultSkewThresh,
+		})
+		if err != nil {
+			out.Close()
+			return nil, err
+		}
+	} else {
+		dataFileWriter = zngio.NewWriter(bufwriter.New(out), zngio.WriterOpts{
+			LZ4BlockSize:     importLZ4BlockSize,
+			StreamRecordsMax: importStreamRecordsMax,
+		})
+	}
 	// Create the temporary index key file
 	idxTemp, err := ioutil.TempFile("", "archive-import-index-key-")
 	if err != nil {
+		dataFileWriter.Close()
 		return nil, err
 	}
 	indexTempPath := idxTemp.Name()
@@ -265,6 +286,7 @@ func newChunkWriter(ctx context.Context, ark *Archive, tsDirUri iosrc.URI) (*chu
 		{"offset", zng.TypeInt64},
 	}))
 	return &chunkWriter{
+		ark:             ark,
 		dataFile:        dataFile,
 		dataFileWriter:  dataFileWriter,
 		indexBuilder:    indexBuilder,
@@ -278,8 +300,14 @@ func newChunkWriter(ctx context.Context, ark *Archive, tsDirUri iosrc.URI) (*chu
 func (cw *chunkWriter) Write(rec *zng.Record) error {
 	// We want to index the start of stream (SOS) position of the data file by
 	// record timestamps; we don't know when we've started a new stream until
-	// after we written the first record in the stream.
-	sos := cw.dataFileWriter.LastSOS()
+	// after we written the first record in the stream.  A zst data file has
+	// no streams to seek to, so its seek index holds no entries and serves
+	// only to record the chunk's span and record count.
+	zw, isZng := cw.dataFileWriter.(*zngio.Writer)
+	var sos int64
+	if isZng {
+		sos = zw.LastSOS()
+	}
 	if err := cw.dataFileWriter.Write(rec); err != nil {
 		return err
 	}
@@ -288,6 +316,9 @@ func (cw *chunkWriter) Write(rec *zng.Record) error {
 		cw.firstTs = cw.lastTs
 	}
 	cw.rcount++
+	if !isZng {
+		return nil
+	}
 	if cw.needIndexWrite {
 		out := cw.indexBuilder.Build(zng.EncodeTime(cw.lastTs), zng.EncodeInt(sos))
 		if err := cw.indexTempWriter.Write(out); err != nil {
@@ -295,12 +326,23 @@ func (cw *chunkWriter) Write(rec *zng.Record) error {
 		}
 		cw.needIndexWrite = false
 	}
-	if cw.dataFileWriter.LastSOS() != sos {
+	if zw.LastSOS() != sos {
 		cw.needIndexWrite = true
 	}
 	return nil
 }
 
+// chunk returns the Chunk for the records written so far.
+func (cw *chunkWriter) chunk() Chunk {
+	return Chunk{
+		Id:           cw.dataFile.id,
+		First:        cw.firstTs,
+		Last:         cw.lastTs,
+		DataFileKind: cw.dataFile.kind,
+		RecordCount:  cw.rcount,
+	}
+}
+
 // abort should be called when an error occurs during write. Errors are ignored
 // because the write error will be more informative and should be returned.
 func (cw *chunkWriter) abort() {
@@ -309,16 +351,59 @@ func (cw *chunkWriter) abort() {
 	os.Remove(cw.indexTempPath)
 }
 
-func (cw *chunkWriter) close(ctx context.Context) error {
+// close finishes writing the chunk's data file, builds the microindexes
+// of rules for it, and then, holding the archive lock, commits the chunk by
+// writing its seek index.
+func (cw *chunkWriter) close(ctx context.Context, rules []Rule) error {
+	if err := cw.closeData(); err != nil {
+		return err
+	}
+	if err := cw.index(ctx, rules, nil); err != nil {
+		os.Remove(cw.indexTempPath)
+		return err
+	}
+	unlock, err := cw.ark.lock(ctx, true)
+	if err != nil {
+		os.Remove(cw.indexTempPath)
+		return err
+	}
+	defer unlock()
+	return cw.commit(ctx)
+}
+
+// closeData closes the data file and the temporary seek index key file.
+func (cw *chunkWriter) closeData() error {
 	err := cw.dataFileWriter.Close()
 	if closeErr := cw.indexTempWriter.Close(); err == nil {
 		err = closeErr
 	}
 	if err != nil {
-		return err
+		os.Remove(cw.indexTempPath)
 	}
-	// Write the time seek index into the archive, feeding it the key/offset
-	// records written to indexTempPath.
+	return err
+}
+
+// index builds the microindexes of rules for the chunk's data file, which
+// must have been closed.
+func (cw *chunkWriter) index(ctx context.Context, rules []Rule, progress chan<- string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	chunk := cw.chunk()
+	zardir := chunk.ZarDir(cw.ark)
+	if dirmkr, ok := cw.ark.dataSrc.(iosrc.DirMaker); ok {
+		if err := dirmkr.MkdirAll(zardir, 0700); err != nil {
+			return err
+		}
+	}
+	return run(ctx, zardir, rules, chunk.Path(cw.ark), progress)
+}
+
+// commit writes the time seek index into the archive, feeding it the
+// key/offset records written to indexTempPath.  The chunk becomes visible
+// to readers of the archive once its seek index exists, so the caller
+// should hold the archive lock.
+func (cw *chunkWriter) commit(ctx context.Context) error {
 	tf, err := fs.Open(cw.indexTempPath)
 	if err != nil {
 		return err
@@ -0,0 +1,261 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zqe"
+	"github.com/brimsec/zq/zql"
+)
+
+const indexDefsFilename = "zar.indexes.json"
+
+// An IndexDef is a named indexing rule stored in an archive.  Import builds
+// the microindex of every IndexDef for each chunk it adds to the archive.
+//
+// An IndexDef holds either a Pattern, which is a field name or a ":"
+// followed by a type name as accepted by NewRule, or a list of Keys and an
+// optional ZQL query computing the values stored alongside them.  If ZQL is
+// empty, the index holds the count of records for each distinct combination
+// of keys.  If Keys is empty, the keys are taken from the group-by keys of
+// the query.  The microindex of a Pattern IndexDef is the standard field or
+// type microindex; otherwise, the microindex file is named after the
+// IndexDef.
+type IndexDef struct {
+	Name      string   `json:"name"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Keys      []string `json:"keys,omitempty"`
+	ZQL       string   `json:"zql,omitempty"`
+	Framesize int      `json:"framesize,omitempty"`
+}
+
+var indexDefNameRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+
+// NewIndexDef returns an IndexDef after checking that it describes a valid
+// indexing rule.
+func NewIndexDef(name, pattern string, keys []string, zql string, framesize int) (*IndexDef, error) {
+	if !indexDefNameRegex.MatchString(name) {
+		return nil, zqe.E(zqe.Invalid, "invalid index name: %q", name)
+	}
+	if strings.HasPrefix(name, "microindex-") {
+		return nil, zqe.E(zqe.Invalid, "index name %q uses the reserved prefix \"microindex-\"", name)
+	}
+	if pattern != "" && (len(keys) != 0 || zql != "") {
+		return nil, zqe.E(zqe.Invalid, "index %s: a pattern cannot be combined with keys or zql", name)
+	}
+	if pattern == "" && len(keys) == 0 && zql == "" {
+		return nil, zqe.E(zqe.Invalid, "index %s: a pattern, keys, or zql must be specified", name)
+	}
+	d := &IndexDef{
+		Name:      name,
+		Pattern:   pattern,
+		Keys:      keys,
+		ZQL:       zql,
+		Framesize: framesize,
+	}
+	if _, err := d.Rule(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Rule returns the indexing rule for d.
+func (d *IndexDef) Rule() (*Rule, error) {
+	if d.Pattern != "" {
+		rule, err := NewRule(d.Pattern)
+		if err != nil {
+			return nil, zqe.E(zqe.Invalid, "index %s: %s", d.Name, err)
+		}
+		rule.framesize = d.framesize()
+		return rule, nil
+	}
+	keys := d.Keys
+	query := d.ZQL
+	if query == "" {
+		query = "count() by " + strings.Join(keys, ",")
+	}
+	proc, err := zql.ParseProc(query)
+	if err != nil {
+		return nil, zqe.E(zqe.Invalid, "index %s: %s", d.Name, err)
+	}
+	if len(keys) == 0 {
+		if keys = groupByKeys(proc); len(keys) == 0 {
+			return nil, zqe.E(zqe.Invalid, "index %s: keys must be specified for zql without a group-by", d.Name)
+		}
+	}
+	if !endsWithSort(proc) {
+		// Microindex keys must be sorted.
+		if proc, err = zql.ParseProc(query + " | sort " + strings.Join(keys, ",")); err != nil {
+			return nil, zqe.E(zqe.Invalid, "index %s: %s", d.Name, err)
+		}
+	}
+	return NewRuleAST("zql", proc, d.Name, keys, d.framesize())
+}
+
+func (d *IndexDef) framesize() int {
+	if d.Framesize == 0 {
+		return framesize
+	}
+	return d.Framesize
+}
+
+// groupByKeys returns the names of the keys of the last group-by proc of a
+// sequential proc.
+func groupByKeys(proc ast.Proc) []string {
+	procs := []ast.Proc{proc}
+	if seq, ok := proc.(*ast.SequentialProc); ok {
+		procs = seq.Procs
+	}
+	for i := len(procs) - 1; i >= 0; i-- {
+		if p, ok := procs[i].(*ast.GroupByProc); ok {
+			var keys []string
+			for _, k := range p.Keys {
+				keys = append(keys, k.Target)
+			}
+			return keys
+		}
+	}
+	return nil
+}
+
+func endsWithSort(proc ast.Proc) bool {
+	if seq, ok := proc.(*ast.SequentialProc); ok && len(seq.Procs) > 0 {
+		proc = seq.Procs[len(seq.Procs)-1]
+	}
+	_, ok := proc.(*ast.SortProc)
+	return ok
+}
+
+func (ark *Archive) indexDefsURI() iosrc.URI {
+	return ark.Root.AppendPath(indexDefsFilename)
+}
+
+// ReadIndexDefs returns the index definitions stored in the archive.
+func ReadIndexDefs(ctx context.Context, ark *Archive) ([]IndexDef, error) {
+	b, err := iosrc.ReadFile(ctx, ark.indexDefsURI())
+	if err != nil {
+		if zqe.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var defs []IndexDef
+	if err := json.Unmarshal(b, &defs); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+func writeIndexDefs(ark *Archive, defs []IndexDef) error {
+	return iosrc.Replace(context.Background(), ark.indexDefsURI(), func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(defs)
+	})
+}
+
+// indexDefRules returns the indexing rules of the index definitions stored
+// in the archive.
+func indexDefRules(ctx context.Context, ark *Archive) ([]Rule, error) {
+	defs, err := ReadIndexDefs(ctx, ark)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]Rule, 0, len(defs))
+	for i := range defs {
+		rule, err := defs[i].Rule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+// AddIndexDef stores def in the archive so that subsequent imports build
+// its microindex.  It does not index the chunks already in the archive,
+// which can be done with IndexDirTree.
+func AddIndexDef(ctx context.Context, ark *Archive, def IndexDef) error {
+	rule, err := def.Rule()
+	if err != nil {
+		return err
+	}
+	unlock, err := ark.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	defs, err := ReadIndexDefs(ctx, ark)
+	if err != nil {
+		return err
+	}
+	for i := range defs {
+		if defs[i].Name == def.Name {
+			return zqe.E(zqe.Conflict, "index %s already exists", def.Name)
+		}
+		other, err := defs[i].Rule()
+		if err != nil {
+			return err
+		}
+		if other.path == rule.path {
+			return zqe.E(zqe.Conflict, "index %s already creates %s", defs[i].Name, rule.path)
+		}
+	}
+	return writeIndexDefs(ark, append(defs, def))
+}
+
+// DropIndexDef removes the named index definition from the archive along
+// with its microindex in each chunk.
+func DropIndexDef(ctx context.Context, ark *Archive, name string, progress chan<- string) error {
+	unlock, err := ark.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defs, err := ReadIndexDefs(ctx, ark)
+	if err != nil {
+		unlock()
+		return err
+	}
+	var rule *Rule
+	for i := range defs {
+		if defs[i].Name == name {
+			if rule, err = defs[i].Rule(); err != nil {
+				unlock()
+				return err
+			}
+			defs = append(defs[:i], defs[i+1:]...)
+			break
+		}
+	}
+	if rule == nil {
+		unlock()
+		return zqe.E(zqe.NotFound, "index %s not found", name)
+	}
+	err = writeIndexDefs(ark, defs)
+	unlock()
+	if err != nil {
+		return err
+	}
+	return Walk(ctx, ark, func(chunk Chunk) error {
+		unlock, ok, err := ark.lockChunk(ctx, chunk, true)
+		if err != nil || !ok {
+			return err
+		}
+		defer unlock()
+		uri := rule.Path(chunk.ZarDir(ark))
+		if err := ark.dataSrc.Remove(ctx, uri); err != nil {
+			if zqe.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if progress != nil {
+			progress <- fmt.Sprintf("%s: removed", uri)
+		}
+		return nil
+	})
+}
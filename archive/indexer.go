@@ -9,7 +9,6 @@ import (
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/proc/cut"
 	"github.com/brimsec/zq/zbuf"
-	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
@@ -28,6 +27,15 @@ func fieldMicroIndexName(fieldname string) string {
 
 func IndexDirTree(ctx context.Context, ark *Archive, rules []Rule, path string, progress chan<- string) error {
 	return Walk(ctx, ark, func(chunk Chunk) error {
+		unlock, ok, err := ark.lockChunk(ctx, chunk, true)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		if !ok {
+			// The chunk was removed after it was listed.
+			return nil
+		}
 		zardir := chunk.ZarDir(ark)
 		logPath := chunk.Localize(ark, path)
 		return run(ctx, zardir, rules, logPath, progress)
@@ -35,13 +43,12 @@ func IndexDirTree(ctx context.Context, ark *Archive, rules []Rule, path string,
 }
 
 func runOne(ctx context.Context, zardir iosrc.URI, rule Rule, inputPath iosrc.URI, progress chan<- string) error {
-	rc, err := iosrc.NewReader(ctx, inputPath)
+	zctx := resolver.NewContext()
+	r, err := newDataFileReader(ctx, zctx, inputPath, nil)
 	if err != nil {
 		return err
 	}
-	defer rc.Close()
-	zctx := resolver.NewContext()
-	r := zngio.NewReader(rc, zctx)
+	defer r.Close()
 	fgi, err := NewFlowgraphIndexer(ctx, zctx, rule.Path(zardir), rule.keys, rule.framesize)
 	if err != nil {
 		return err
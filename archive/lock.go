@@ -0,0 +1,65 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/pkg/iosrc"
+)
+
+const (
+	lockFilename     = "zar.lock"
+	lockPollInterval = 10 * time.Millisecond
+)
+
+// lock acquires the archive lock, which serializes changes to the set of
+// chunks and indexes in an archive so that readers never observe a partially
+// completed change.  Writers take the lock exclusively while committing a
+// change, and readers take it shared while listing a tsDir and while reading
+// the chunks of a span.  The lock is an advisory lock on a file in the
+// archive root and is a no-op for archives whose root is not on the local
+// file system.  lock blocks until the lock is acquired or ctx is done, and
+// the returned function releases it.
+func (ark *Archive) lock(ctx context.Context, exclusive bool) (func(), error) {
+	if ark.Root.Scheme != iosrc.FileScheme {
+		return func() {}, nil
+	}
+	path := ark.Root.AppendPath(lockFilename).Filepath()
+	for {
+		l, err := fs.TryLock(path, exclusive)
+		if err == nil {
+			return func() { l.Unlock() }, nil
+		}
+		if err != fs.ErrLocked {
+			if !exclusive && (os.IsPermission(err) || os.IsNotExist(err)) {
+				// A reader without write access to the archive
+				// root proceeds without the lock.
+				return func() {}, nil
+			}
+			return nil, err
+		}
+		select {
+		case <-time.After(lockPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// lockChunk acquires the archive lock and reports whether chunk is still
+// present in the archive.  If the returned function is non-nil, it releases
+// the lock.
+func (ark *Archive) lockChunk(ctx context.Context, chunk Chunk, exclusive bool) (func(), bool, error) {
+	unlock, err := ark.lock(ctx, exclusive)
+	if err != nil {
+		return nil, false, err
+	}
+	ok, err := ark.dataSrc.Exists(ctx, chunk.seekIndexPath(ark))
+	if err != nil {
+		unlock()
+		return nil, false, err
+	}
+	return unlock, ok, nil
+}
@@ -3,18 +3,23 @@ package archive
 import (
 	"context"
 	"io"
+	"strings"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/filter"
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc/sort"
+	"github.com/brimsec/zq/proc/spill"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zst"
 )
 
 // A SpanInfo is a logical view of the records within a time span, stored
@@ -29,6 +34,30 @@ type SpanInfo struct {
 	Chunks []Chunk
 }
 
+// SpanWalk calls v with each SpanInfo of ark within filter, in time order
+// given by dir.
+func SpanWalk(ctx context.Context, ark *Archive, filter nano.Span, dir zbuf.Direction, v func(si SpanInfo) error) error {
+	if dir == ark.DataSortDirection {
+		return spanWalk(ctx, ark, filter, v)
+	}
+	// The spans must be visited in the opposite order of the archive,
+	// so collect them first.  They hold chunk metadata only.
+	var sinfos []SpanInfo
+	err := spanWalk(ctx, ark, filter, func(si SpanInfo) error {
+		sinfos = append(sinfos, si)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i := len(sinfos) - 1; i >= 0; i-- {
+		if err := v(sinfos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func spanWalk(ctx context.Context, ark *Archive, filter nano.Span, v func(si SpanInfo) error) error {
 	return tsDirVisit(ctx, ark, filter, func(_ tsDir, chunks []Chunk) error {
 		sinfos := mergeChunksToSpans(chunks, ark.DataSortDirection, filter)
@@ -60,13 +89,115 @@ type scannerCloser struct {
 	io.Closer
 }
 
-func newSpanScanner(ctx context.Context, ark *Archive, zctx *resolver.Context, f filter.Filter, filterExpr ast.BooleanExpr, si SpanInfo) (sc *scannerCloser, err error) {
+// newDataFileReader returns a reader for the zng or zst data file at uri.
+// If fields is non-nil and the file is zst, only the named fields and the
+// ts field are read and records having none of them are skipped.
+func newDataFileReader(ctx context.Context, zctx *resolver.Context, uri iosrc.URI, fields []string) (zbuf.ReadCloser, error) {
+	if !strings.HasSuffix(uri.Path, FileKind(FileKindDataZst).ext()) {
+		rc, err := iosrc.NewReader(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		return zbuf.NewReadCloser(zngio.NewReader(rc, zctx), rc), nil
+	}
+	object, err := zst.NewObjectFromPath(ctx, zctx, uri.String())
+	if err != nil {
+		return nil, err
+	}
+	var r *zst.Reader
+	if fields == nil {
+		r, err = zst.NewReader(object)
+	} else {
+		r, err = zst.NewCutter(object, append([]string{"ts"}, fields...))
+		if err == zng.ErrNoSuchField {
+			// No record has any of the fields.
+			return zbuf.NewReadCloser(zbuf.Array(nil).NewReader(), object), nil
+		}
+	}
+	if err != nil {
+		object.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// newSpanScanner returns a scanner of the records of si in time order given
+// by dir, sorting them with up to sortMemMaxBytes of memory (or
+// sort.MemMaxBytes if zero) if dir is not the order of ark.  The scanner
+// holds the archive lock until it is closed, so that its chunks cannot be
+// removed while they are being read.
+func newSpanScanner(ctx context.Context, ark *Archive, zctx *resolver.Context, f filter.Filter, filterExpr ast.BooleanExpr, fields []string, si SpanInfo, dir zbuf.Direction, sortMemMaxBytes int) (*scannerCloser, error) {
+	unlock, si, err := lockSpan(ctx, ark, si)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := newNativeSpanScanner(ctx, ark, zctx, f, filterExpr, fields, si)
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	sc.Closer = &multiCloser{[]io.Closer{sc.Closer, unlocker(unlock)}}
+	if dir == ark.DataSortDirection {
+		return sc, nil
+	}
+	if sortMemMaxBytes == 0 {
+		sortMemMaxBytes = sort.MemMaxBytes
+	}
+	sorted := spill.NewSortedReader(zbuf.PullerReader(sc.Scanner), spill.TimeCompareFn(dir), sortMemMaxBytes)
+	return &scannerCloser{
+		Scanner: &sortedScanner{Statser: sc.Scanner, reader: sorted},
+		Closer:  &multiCloser{[]io.Closer{sorted, sc.Closer}},
+	}, nil
+}
+
+// lockSpan acquires the archive lock and returns si with the chunks of its
+// tsDir that overlap its span when the lock was acquired, since chunks may
+// have been added or removed since si was listed.  The returned function
+// releases the lock.
+func lockSpan(ctx context.Context, ark *Archive, si SpanInfo) (func(), SpanInfo, error) {
+	unlock, err := ark.lock(ctx, false)
+	if err != nil {
+		return nil, SpanInfo{}, err
+	}
+	if len(si.Chunks) == 0 {
+		return unlock, si, nil
+	}
+	tsDirURI := ark.DataPath.AppendPath(dataDirname, newTsDir(si.Chunks[0].First).name())
+	dirents, err := iosrc.ReadDir(ctx, tsDirURI)
+	if err != nil {
+		unlock()
+		return nil, SpanInfo{}, err
+	}
+	return unlock, SpanInfo{Span: si.Span, Chunks: tsDirEntriesToChunks(ark, si.Span, dirents)}, nil
+}
+
+type unlocker func()
+
+func (u unlocker) Close() error {
+	u()
+	return nil
+}
+
+// sortedScanner is a scanner that returns the records of a reader sorted
+// from those of another scanner, whose stats it reports.
+type sortedScanner struct {
+	scanner.Statser
+	reader zbuf.Reader
+}
+
+func (s *sortedScanner) Pull() (zbuf.Batch, error) {
+	return zbuf.ReadBatch(s.reader, scanner.BatchSize)
+}
+
+// newNativeSpanScanner returns a scanner of the records of si in the sort
+// order of ark.
+func newNativeSpanScanner(ctx context.Context, ark *Archive, zctx *resolver.Context, f filter.Filter, filterExpr ast.BooleanExpr, fields []string, si SpanInfo) (sc *scannerCloser, err error) {
 	if len(si.Chunks) == 1 {
-		rc, err := iosrc.NewReader(ctx, si.Chunks[0].Path(ark))
+		rc, err := newDataFileReader(ctx, zctx, si.Chunks[0].Path(ark), fields)
 		if err != nil {
 			return nil, err
 		}
-		sn, err := scanner.NewScanner(ctx, zngio.NewReader(rc, zctx), f, filterExpr, si.Span)
+		sn, err := scanner.NewScanner(ctx, rc, f, filterExpr, si.Span)
 		if err != nil {
 			rc.Close()
 			return nil, err
@@ -83,12 +214,12 @@ func newSpanScanner(ctx context.Context, ark *Archive, zctx *resolver.Context, f
 	}()
 	readers := make([]zbuf.Reader, 0, len(si.Chunks))
 	for _, chunk := range si.Chunks {
-		rc, err := iosrc.NewReader(ctx, chunk.Path(ark))
+		rc, err := newDataFileReader(ctx, zctx, chunk.Path(ark), fields)
 		if err != nil {
 			return nil, err
 		}
 		closers = append(closers, rc)
-		readers = append(readers, zngio.NewReader(rc, zctx))
+		readers = append(readers, rc)
 	}
 	sn, err := scanner.NewCombiner(ctx, readers, zbuf.RecordCompare(ark.DataSortDirection), f, filterExpr, si.Span)
 	if err != nil {
@@ -101,8 +232,10 @@ func newSpanScanner(ctx context.Context, ark *Archive, zctx *resolver.Context, f
 }
 
 type multiSource struct {
-	ark      *Archive
-	altPaths []string
+	ark             *Archive
+	altPaths        []string
+	dir             zbuf.Direction
+	sortMemMaxBytes int
 }
 
 // NewMultiSource returns a driver.MultiSource for an Archive. If no alternative
@@ -119,20 +252,35 @@ func NewMultiSource(ark *Archive, altPaths []string) driver.MultiSource {
 	return &multiSource{
 		ark:      ark,
 		altPaths: altPaths,
+		dir:      ark.DataSortDirection,
+	}
+}
+
+// NewMultiSourceDir returns a driver.MultiSource for an Archive that sends a
+// source for each span in the driver.SourceFilter span, ordered by time in
+// direction dir, which need not be the sort direction of the archive.  The
+// records of each span are sorted using up to sortMemMaxBytes of memory, or
+// sort.MemMaxBytes if sortMemMaxBytes is zero, when dir is not the sort
+// direction of the archive.
+func NewMultiSourceDir(ark *Archive, dir zbuf.Direction, sortMemMaxBytes int) driver.MultiSource {
+	return &multiSource{
+		ark:             ark,
+		dir:             dir,
+		sortMemMaxBytes: sortMemMaxBytes,
 	}
 }
 
 func (m *multiSource) OrderInfo() (string, bool) {
 	if len(m.altPaths) == 0 {
-		return "ts", m.ark.DataSortDirection == zbuf.DirTimeReverse
+		return "ts", m.dir == zbuf.DirTimeReverse
 	}
 	return "", false
 }
 
 func (m *multiSource) spanWalk(ctx context.Context, zctx *resolver.Context, sf driver.SourceFilter, srcChan chan<- driver.SourceOpener) error {
-	return spanWalk(ctx, m.ark, sf.Span, func(si SpanInfo) error {
+	return SpanWalk(ctx, m.ark, sf.Span, m.dir, func(si SpanInfo) error {
 		so := func() (driver.ScannerCloser, error) {
-			return newSpanScanner(ctx, m.ark, zctx, sf.Filter, sf.FilterExpr, si)
+			return newSpanScanner(ctx, m.ark, zctx, sf.Filter, sf.FilterExpr, sf.Fields, si, m.dir, m.sortMemMaxBytes)
 		}
 		select {
 		case srcChan <- so:
@@ -21,6 +21,7 @@ type Metadata struct {
 	DataPath          string         `json:"data_path"`
 	DataSortDirection zbuf.Direction `json:"data_sort_direction"`
 	LogSizeThreshold  int64          `json:"log_size_threshold"`
+	DataFormat        string         `json:"data_format,omitempty"`
 }
 
 func (c *Metadata) Write(uri iosrc.URI) error {
@@ -57,12 +58,29 @@ func MetadataRead(ctx context.Context, uri iosrc.URI) (*Metadata, error) {
 const (
 	DefaultLogSizeThreshold  = 500 * 1024 * 1024
 	DefaultDataSortDirection = zbuf.DirTimeReverse
+	DefaultDataFormat        = "zng"
 )
 
+// dataFileKind returns the kind of data file used to store chunks in
+// the given data format, which must be "zng", "zst", or empty for the
+// default format.
+func dataFileKind(format string) (FileKind, error) {
+	switch format {
+	case "", "zng":
+		return FileKindData, nil
+	case "zst":
+		return FileKindDataZst, nil
+	}
+	return FileKindUnknown, zqe.E(zqe.Invalid, "unknown archive data format: %s", format)
+}
+
 type CreateOptions struct {
 	LogSizeThreshold *int64
 	DataPath         string
 	SortAscending    bool
+	// DataFormat is the format of the chunk files written by import,
+	// either "zng" or "zst". If empty, DefaultDataFormat is used.
+	DataFormat string
 }
 
 func (c *CreateOptions) toMetadata() *Metadata {
@@ -81,6 +99,9 @@ func (c *CreateOptions) toMetadata() *Metadata {
 	if c.SortAscending {
 		m.DataSortDirection = zbuf.DirTimeForward
 	}
+	if c.DataFormat != "" {
+		m.DataFormat = c.DataFormat
+	}
 	return m
 }
 
@@ -90,6 +111,8 @@ type Archive struct {
 	DataSortDirection zbuf.Direction
 	LogSizeThreshold  int64
 	LogFilter         []ksuid.KSUID
+	DataFormat        string
+	dataFileKind      FileKind
 	dataSrc           iosrc.Source
 }
 
@@ -99,6 +122,7 @@ func (ark *Archive) metaWrite() error {
 		LogSizeThreshold:  ark.LogSizeThreshold,
 		DataSortDirection: ark.DataSortDirection,
 		DataPath:          ark.DataPath.String(),
+		DataFormat:        ark.DataFormat,
 	}
 	return m.Write(ark.mdURI())
 }
@@ -148,12 +172,18 @@ func openArchive(ctx context.Context, root iosrc.URI, oo *OpenOptions) (*Archive
 	if err != nil {
 		return nil, err
 	}
+	kind, err := dataFileKind(m.DataFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	ark := &Archive{
 		Root:              root,
 		DataSortDirection: m.DataSortDirection,
 		LogSizeThreshold:  m.LogSizeThreshold,
 		DataPath:          dpuri,
+		DataFormat:        m.DataFormat,
+		dataFileKind:      kind,
 	}
 
 	if oo != nil && oo.DataSource != nil {
@@ -192,6 +222,9 @@ func CreateOrOpenArchiveWithContext(ctx context.Context, rpath string, co *Creat
 		return nil, err
 	}
 	if !ok {
+		if _, err := dataFileKind(co.DataFormat); err != nil {
+			return nil, err
+		}
 		src, err := iosrc.GetSource(root)
 		if err != nil {
 			return nil, err
@@ -201,10 +234,27 @@ func CreateOrOpenArchiveWithContext(ctx context.Context, rpath string, co *Creat
 				return nil, err
 			}
 		}
-		if err := co.toMetadata().Write(mdPath); err != nil {
+		if err := createMetadata(ctx, root, co); err != nil {
 			return nil, err
 		}
 	}
 
 	return openArchive(ctx, root, oo)
 }
+
+// createMetadata writes the metadata file for a new archive unless a
+// concurrent creator of the archive has already written it.
+func createMetadata(ctx context.Context, root iosrc.URI, co *CreateOptions) error {
+	ark := &Archive{Root: root}
+	unlock, err := ark.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	mdPath := root.AppendPath(metadataFilename)
+	ok, err := iosrc.Exists(ctx, mdPath)
+	if err != nil || ok {
+		return err
+	}
+	return co.toMetadata().Write(mdPath)
+}
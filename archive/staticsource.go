@@ -12,24 +12,32 @@ import (
 // a single SpanInfo (with Chunks) to be processed by a zqd worker.
 // staticSource is used only for the zqd /worker call.
 type staticSource struct {
-	ark *Archive
-	si  SpanInfo
+	ark             *Archive
+	si              SpanInfo
+	dir             zbuf.Direction
+	sortMemMaxBytes int
 }
 
-func NewStaticSource(ark *Archive, si SpanInfo) driver.MultiSource {
+// NewStaticSource returns a driver.MultiSource that sends the records of si
+// ordered by time in direction dir.  If dir is not the sort direction of the
+// archive, the records are sorted using up to sortMemMaxBytes of memory, or
+// sort.MemMaxBytes if sortMemMaxBytes is zero.
+func NewStaticSource(ark *Archive, si SpanInfo, dir zbuf.Direction, sortMemMaxBytes int) driver.MultiSource {
 	return &staticSource{
-		ark: ark,
-		si:  si,
+		ark:             ark,
+		si:              si,
+		dir:             dir,
+		sortMemMaxBytes: sortMemMaxBytes,
 	}
 }
 
 func (s *staticSource) OrderInfo() (string, bool) {
-	return "ts", s.ark.DataSortDirection == zbuf.DirTimeReverse
+	return "ts", s.dir == zbuf.DirTimeReverse
 }
 
 func (s *staticSource) SendSources(ctx context.Context, zctx *resolver.Context, sf driver.SourceFilter, srcChan chan driver.SourceOpener) error {
 	so := func() (driver.ScannerCloser, error) {
-		return newSpanScanner(ctx, s.ark, zctx, sf.Filter, sf.FilterExpr, s.si)
+		return newSpanScanner(ctx, s.ark, zctx, sf.Filter, sf.FilterExpr, sf.Fields, s.si, s.dir, s.sortMemMaxBytes)
 	}
 	select {
 	case srcChan <- so:
@@ -27,36 +27,49 @@ type FileKind string
 const (
 	FileKindUnknown FileKind = ""
 	FileKindData             = "d"
+	FileKindDataZst          = "dz"
 	FileKindSeek             = "ts"
 )
 
-// A dataFile holds archive record data. Only one kind of data file
-// currently exists, representing a file created during ingest.
+// ext returns the file name extension of data files of kind k.
+func (k FileKind) ext() string {
+	if k == FileKindDataZst {
+		return ".zst"
+	}
+	return ".zng"
+}
+
+// A dataFile holds archive record data created during ingest, stored as
+// zng (FileKindData) or as zst (FileKindDataZst).
 type dataFile struct {
 	id   ksuid.KSUID
 	kind FileKind
 }
 
-func newDataFile() dataFile {
-	return dataFile{ksuid.New(), FileKindData}
+func newDataFile(kind FileKind) dataFile {
+	return dataFile{ksuid.New(), kind}
 }
 
 func (f dataFile) name() string {
-	return fmt.Sprintf("%s-%s.zng", f.kind, f.id)
+	return fmt.Sprintf("%s-%s%s", f.kind, f.id, f.kind.ext())
 }
 
-var dataFileNameRegex = regexp.MustCompile(`d-([0-9A-Za-z]{27}).zng$`)
+var dataFileNameRegex = regexp.MustCompile(`(d|dz)-([0-9A-Za-z]{27})(\.zng|\.zst)$`)
 
 func dataFileNameMatch(s string) (f dataFile, ok bool) {
 	match := dataFileNameRegex.FindStringSubmatch(s)
 	if match == nil {
 		return
 	}
-	id, err := ksuid.Parse(match[1])
+	kind := FileKind(match[1])
+	if kind.ext() != match[3] {
+		return
+	}
+	id, err := ksuid.Parse(match[2])
 	if err != nil {
 		return
 	}
-	return dataFile{id, FileKindData}, true
+	return dataFile{id, kind}, true
 }
 
 // A seekIndexFile is a microindex whose keys are record timestamps, and whose
@@ -162,7 +175,14 @@ func tsDirVisit(ctx context.Context, ark *Archive, filterSpan nano.Span, visitor
 		return tsdirs[j].Ts < tsdirs[i].Ts
 	})
 	for _, d := range tsdirs {
+		// Hold the archive lock while listing so that changes to the
+		// tsDir's chunks are seen either completely or not at all.
+		unlock, err := ark.lock(ctx, false)
+		if err != nil {
+			return err
+		}
 		dirents, err := iosrc.ReadDir(ctx, zdDir.AppendPath(d.name()))
+		unlock()
 		if err != nil {
 			return err
 		}
@@ -237,7 +257,7 @@ func (c Chunk) Span() nano.Span {
 }
 
 func (c Chunk) LogID() LogID {
-	return LogID(path.Join(dataDirname, newTsDir(c.First).name(), fmt.Sprintf("%s-%s.zng", c.DataFileKind, c.Id)))
+	return LogID(path.Join(dataDirname, newTsDir(c.First).name(), dataFile{c.Id, c.DataFileKind}.name()))
 }
 
 // ZarDir returns a URI for a directory specific to this data file, expected
@@ -260,6 +280,12 @@ func (c Chunk) Path(ark *Archive) iosrc.URI {
 	return ark.DataPath.AppendPath(string(c.LogID()))
 }
 
+// seekIndexPath returns the URI of this chunk's seek index.
+func (c Chunk) seekIndexPath(ark *Archive) iosrc.URI {
+	sf := seekIndexFile{id: c.Id, first: c.First, last: c.Last, recordCount: c.RecordCount}
+	return ark.DataPath.AppendPath(dataDirname, newTsDir(c.First).name(), sf.name())
+}
+
 func (c Chunk) Range(ark *Archive) string {
 	return fmt.Sprintf("[%d-%d]", c.First, c.Last)
 }
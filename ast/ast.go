@@ -157,11 +157,27 @@ type CastExpression struct {
 	Type string     `json:"type"`
 }
 
+// A RecordExpression is a record literal whose fields are named by the
+// targets of Fields and computed by their expressions.
+type RecordExpression struct {
+	Node
+	Fields []ExpressionAssignment `json:"fields"`
+}
+
+// An ArrayExpression is an array literal whose elements are computed by
+// Exprs.
+type ArrayExpression struct {
+	Node
+	Exprs []Expression `json:"exprs"`
+}
+
 func (*UnaryExpression) exprNode()       {}
 func (*BinaryExpression) exprNode()      {}
 func (*ConditionalExpression) exprNode() {}
 func (*FunctionCall) exprNode()          {}
 func (*CastExpression) exprNode()        {}
+func (*RecordExpression) exprNode()      {}
+func (*ArrayExpression) exprNode()       {}
 func (*Literal) exprNode()               {}
 func (*Field) exprNode()                 {}
 
@@ -294,6 +310,37 @@ type (
 	FuseProc struct {
 		Node
 	}
+
+	// A LookupProc node represents a proc that enriches each record with
+	// the fields of the record of a table whose key equals the value of
+	// the Key expression.  The table is a file loaded into memory or, if
+	// Index is true, a microindex searched for each record.  KeyField
+	// names the key field of a file (defaulting to its first field).
+	// Miss is one of "keep", "drop", or "null" and determines whether
+	// a record without a match is passed as is, dropped, or given the
+	// table's fields with null values.
+	LookupProc struct {
+		Node
+		Key      Expression `json:"key"`
+		Table    string     `json:"table"`
+		Index    bool       `json:"index"`
+		KeyField string     `json:"key_field,omitempty"`
+		Miss     string     `json:"miss"`
+	}
+
+	// A WindowProc node represents a proc that adds to each record the
+	// results of its reducers over a frame of the records of its
+	// partition, which holds the records with the same values of Keys.
+	// The frame is the last Rows records if Rows is positive, the records
+	// within Range of the record's ts if Range is positive, or otherwise
+	// every record of the partition so far.
+	WindowProc struct {
+		Node
+		Keys     []ExpressionAssignment `json:"keys"`
+		Reducers []Reducer              `json:"reducers"`
+		Rows     int                    `json:"rows,omitempty"`
+		Range    Duration               `json:"range"`
+	}
 )
 
 type ExpressionAssignment struct {
@@ -339,6 +386,8 @@ func (*TopProc) ProcNode()        {}
 func (*PutProc) ProcNode()        {}
 func (*RenameProc) ProcNode()     {}
 func (*FuseProc) ProcNode()       {}
+func (*LookupProc) ProcNode()     {}
+func (*WindowProc) ProcNode()     {}
 
 // A Reducer is an AST node that represents a reducer function.  The Op
 // parameter indicates the specific reducer while the Field parameter indicates
@@ -113,6 +113,16 @@ func unpackProc(custom Unpacker, node joe.Interface) (Proc, error) {
 		return &RenameProc{Fields: fas}, nil
 	case "FuseProc":
 		return &FuseProc{}, nil
+	case "LookupProc":
+		keyNode, err := node.Get("key")
+		if err != nil {
+			return nil, errors.New("LookupProc missing key")
+		}
+		key, err := UnpackExpression(keyNode)
+		if err != nil {
+			return nil, err
+		}
+		return &LookupProc{Key: key}, nil
 	case "UniqProc":
 		return &UniqProc{}, nil
 	case "GroupByProc":
@@ -127,6 +137,18 @@ func unpackProc(custom Unpacker, node joe.Interface) (Proc, error) {
 			return nil, err
 		}
 		return &GroupByProc{Reducers: reducers, Keys: keys}, nil
+	case "WindowProc":
+		a, _ := node.Get("keys")
+		keys, err := unpackExpressionAssignments(a)
+		if err != nil {
+			return nil, err
+		}
+		a, _ = node.Get("reducers")
+		reducers, err := unpackReducers(a)
+		if err != nil {
+			return nil, err
+		}
+		return &WindowProc{Reducers: reducers, Keys: keys}, nil
 	case "TopProc":
 		a, _ := node.Get("fields")
 		fields, err := unpackExprs(a)
@@ -299,6 +321,26 @@ func UnpackExpression(node joe.Interface) (Expression, error) {
 			return nil, err
 		}
 		return &CastExpression{Expr: expr}, nil
+	case "RecordExpr":
+		fieldsNode, err := node.Get("fields")
+		if err != nil {
+			return nil, errors.New("RecordExpr missing fields")
+		}
+		fields, err := unpackExpressionAssignments(fieldsNode)
+		if err != nil {
+			return nil, err
+		}
+		return &RecordExpression{Fields: fields}, nil
+	case "ArrayExpr":
+		exprsNode, err := node.Get("exprs")
+		if err != nil {
+			return nil, errors.New("ArrayExpr missing exprs")
+		}
+		exprs, err := unpackExprs(exprsNode)
+		if err != nil {
+			return nil, err
+		}
+		return &ArrayExpression{Exprs: exprs}, nil
 	case "Literal":
 		return &Literal{}, nil
 	case "Field":
@@ -27,9 +27,10 @@ func (f *Flags) Options() zio.ReaderOpts {
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
-	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,zst,ndjson,zeek,zjson,tzng,parquet]")
+	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,zst,ndjson,zeek,zjson,tzng,parquet,pcap,csv]")
 	fs.BoolVar(&f.Zng.Validate, "validate", true, "validate the input format when reading ZNG streams")
 	fs.StringVar(&f.jsonTypesFile, "j", "", "path to json types file")
+	fs.BoolVar(&f.Pcap.Flows, "pcapflows", false, "read pcap input as one record per flow rather than per packet")
 	fs.StringVar(&f.JSON.PathRegexp, "pathregexp", ndjsonio.DefaultPathRegexp,
 		"regexp for extracting _path from json log name (when -inferpath=true)")
 }
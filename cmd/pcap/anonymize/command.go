@@ -0,0 +1,120 @@
+package anonymize
+
+import (
+	"context"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/brimsec/zq/cmd/pcap/root"
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/mccanne/charm"
+)
+
+var Anonymize = &charm.Spec{
+	Name:  "anonymize",
+	Usage: "anonymize [options]",
+	Short: "anonymize the IP addresses of a pcap",
+	Long: `
+The anonymize command reads a pcap or pcap-ng file and writes its packets
+with their IPv4 and IPv6 addresses replaced, so a capture may be shared
+without revealing the hosts it involves.
+
+Addresses are mapped with a prefix-preserving scheme in the style of
+Crypto-PAn: addresses that share a prefix of n bits are mapped to addresses
+that share a prefix of n bits, so hosts in the same subnet remain in the same
+(anonymous) subnet.  The mapping is determined by the -key passphrase, so
+captures anonymized with the same key have consistent addresses.  Without
+-key, a random key is used and the mapping cannot be reproduced.
+
+The IPv4 header checksum and the TCP, UDP, and ICMPv6 checksums are updated
+to match the new addresses.  If -s is given, each packet is then truncated
+to that many bytes, which removes the payload past the headers when small
+enough (e.g., -s 96).  Addresses within payloads, such as those of DNS
+answers, ICMP errors, and tunneled packets, are not changed.
+
+The output is written as pcap-ng unless -f pcap is given.  A pcap may hold
+only one link type, so -f pcap fails for a pcap-ng input with interfaces of
+differing link types.  As with the merge command, interface statistics and
+options are not preserved.
+`,
+	New: New,
+}
+
+func init() {
+	root.Pcap.Add(Anonymize)
+}
+
+type Command struct {
+	outputFile string
+	inputFile  string
+	format     string
+	key        string
+	snaplen    int
+	*root.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.outputFile, "w", "-", "output file to create or stdout if -")
+	f.StringVar(&c.inputFile, "r", "-", "input file to read from or stdin if -")
+	f.StringVar(&c.format, "f", "pcapng", "output format [pcap,pcapng]")
+	f.StringVar(&c.key, "key", "", "passphrase determining the address mapping (random if not given)")
+	f.IntVar(&c.snaplen, "s", 0, "truncate packets to this many bytes (0 for no truncation)")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	defer c.Cleanup()
+	if err := c.Init(); err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return fmt.Errorf("pcap anonymize: unexpected arguments (use -r for the input file)")
+	}
+	if c.snaplen < 0 {
+		return fmt.Errorf("pcap anonymize: snap length must not be negative")
+	}
+	if c.format != "pcap" && c.format != "pcapng" {
+		return fmt.Errorf("pcap anonymize: unknown output format %q", c.format)
+	}
+	key := []byte(c.key)
+	if c.key == "" {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return err
+		}
+	}
+	in := os.Stdin
+	if c.inputFile != "-" {
+		var err error
+		in, err = fs.Open(c.inputFile)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+	}
+	reader, err := pcapio.NewReader(in)
+	if err != nil {
+		return err
+	}
+	out := os.Stdout
+	if c.outputFile != "-" {
+		out, err = fs.OpenFile(c.outputFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	var w pcapio.Writer
+	if c.format == "pcap" {
+		w = pcapio.NewPcapWriter(out)
+	} else if w, err = pcapio.NewNgWriter(out); err != nil {
+		return err
+	}
+	opts := pcap.AnonymizeOpts{Key: key, Snaplen: c.snaplen}
+	return pcap.Anonymize(context.TODO(), w, reader, opts)
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 
+	_ "github.com/brimsec/zq/cmd/pcap/anonymize"
 	_ "github.com/brimsec/zq/cmd/pcap/cut"
 	_ "github.com/brimsec/zq/cmd/pcap/index"
 	_ "github.com/brimsec/zq/cmd/pcap/info"
+	_ "github.com/brimsec/zq/cmd/pcap/merge"
 	"github.com/brimsec/zq/cmd/pcap/root"
 	_ "github.com/brimsec/zq/cmd/pcap/slice"
 	_ "github.com/brimsec/zq/cmd/pcap/ts"
@@ -0,0 +1,90 @@
+package merge
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/brimsec/zq/cmd/pcap/root"
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/mccanne/charm"
+)
+
+var Merge = &charm.Spec{
+	Name:  "merge",
+	Usage: "merge [options] file [file ...]",
+	Short: "merge pcaps into a single time-ordered pcap-ng",
+	Long: `
+The merge command reads one or more pcap or pcap-ng files and writes their
+packets as a single pcap-ng file ordered by packet timestamp.  This is useful
+for combining the captures written by a sensor that rotates its pcaps.
+
+Each input file is expected to be in time order, as is typical of a capture,
+and only one packet per input is held in memory at a time, so any number of
+large pcaps may be merged.  If an input is not in time order, the output
+is not either.
+
+Packets are copied as captured, but the output is a new file rather than a
+copy of the input blocks, so interface statistics and options (such as interface
+names) are not preserved.  The output has one interface for each link type
+found in the inputs so captures with differing link types (e.g., Ethernet and
+Linux cooked captures) may be merged.
+`,
+	New: New,
+}
+
+func init() {
+	root.Pcap.Add(Merge)
+}
+
+type Command struct {
+	outputFile string
+	*root.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.outputFile, "w", "-", "output file to create or stdout if -")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	defer c.Cleanup()
+	if err := c.Init(); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("pcap merge: no input files specified")
+	}
+	var readers []pcapio.Reader
+	for _, path := range args {
+		f, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, err := pcapio.NewReader(f)
+		if err != nil {
+			return err
+		}
+		readers = append(readers, r)
+	}
+	out := os.Stdout
+	if c.outputFile != "-" {
+		var err error
+		out, err = fs.OpenFile(c.outputFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	w := bufio.NewWriter(out)
+	if err := pcap.Merge(context.TODO(), w, readers); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -20,11 +20,12 @@ import (
 var Slice = &charm.Spec{
 	Name:  "slice",
 	Usage: "slice [options] [ ip:port ip:port ]",
-	Short: "extract a pcap using a time range and/or flow filter",
+	Short: "extract a pcap using a time range, flow filter, and/or BPF filter",
 	Long: `
 The slice command takes an (optional) index file,
-an (optional) time range (specified with -from and -to), and
-an (optional) flow filter as arguments and produces
+an (optional) time range (specified with -from and -to),
+an (optional) flow filter as arguments, and an (optional)
+BPF filter (specified with -bpf) and produces
 an extracted pcap file.
 
 The output pcap file is created by copying the relevant segments of the
@@ -46,6 +47,23 @@ If a flow filter is specified in the format "ip:port ip:port",
 along with a protocol ("tcp", "udp", or "icmp" specified with -p), then
 only packets from that flow are matched.
 
+If a BPF filter expression is specified with -bpf, e.g.,
+"udp port 53 and host 8.8.8.8", then only packets matching the
+expression are matched.  The expression uses the syntax of tcpdump
+(see pcap-filter(7)) but is evaluated without libpcap, and host names
+are not resolved.  A BPF filter may be combined with a flow filter.
+
+If -stream is specified, the TCP connections among the matched packets
+are reassembled, with retransmitted and out-of-order segments put
+in order, and their payload is written instead of a pcap.  With
+"-stream client" or "-stream server", the bytes sent by the client
+or the server are written, which is most useful with a flow filter
+that matches a single connection.  With "-stream follow", a text view
+of the payload sent in both directions is written in the style of
+tshark's "follow,tcp,ascii" output.  The client of a connection is
+the sender of its SYN or, if the SYN was not matched, the endpoint with
+the higher port.
+
 The time format for -from and -to is currently float seconds since 1970-01-01.
 We will support more flexible time formats in the future.
 `,
@@ -63,6 +81,8 @@ type Command struct {
 	from       string
 	to         string
 	proto      string
+	bpf        string
+	stream     string
 	*root.Command
 }
 
@@ -74,6 +94,8 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.from, "from", "", "beginning of time range")
 	f.StringVar(&c.to, "to", "", "end of time range")
 	f.StringVar(&c.proto, "p", "tcp", "transport protocol [tcp,udp,icmp]")
+	f.StringVar(&c.bpf, "bpf", "", "BPF filter expression")
+	f.StringVar(&c.stream, "stream", "", "write reassembled TCP payload [client,server,follow]")
 	return c, nil
 }
 
@@ -120,6 +142,31 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	if c.stream != "" {
+		if err := pcap.ValidStreamFormat(c.stream); err != nil {
+			return err
+		}
+	}
+	var search *pcap.Search
+	if filter {
+		switch c.proto {
+		default:
+			return fmt.Errorf("unknown protocol: %s", c.proto)
+		case "tcp":
+			search = pcap.NewTCPSearch(span, flow)
+		case "udp":
+			search = pcap.NewUDPSearch(span, flow)
+		case "icmp":
+			search = pcap.NewICMPSearch(span, flow.S0.IP, flow.S1.IP)
+		}
+	} else {
+		search = pcap.NewRangeSearch(span)
+	}
+	if c.bpf != "" {
+		if err := search.AddBPF(c.bpf); err != nil {
+			return err
+		}
+	}
 	in := os.Stdin
 	if c.inputFile != "-" {
 		in, err = fs.Open(c.inputFile)
@@ -157,20 +204,16 @@ func (c *Command) Run(args []string) error {
 		}()
 		out = w
 	}
-	var search *pcap.Search
-	if filter {
-		switch c.proto {
-		default:
-			return fmt.Errorf("unknown protocol: %s", c.proto)
-		case "tcp":
-			search = pcap.NewTCPSearch(span, flow)
-		case "udp":
-			search = pcap.NewUDPSearch(span, flow)
-		case "icmp":
-			search = pcap.NewICMPSearch(span, flow.S0.IP, flow.S1.IP)
-		}
-	} else {
-		search = pcap.NewRangeSearch(span)
+	if c.stream == "" {
+		return search.Run(context.TODO(), out, pcapReader)
+	}
+	searchReader, err := search.Reader(context.TODO(), pcapReader)
+	if err != nil {
+		return err
+	}
+	matches, err := pcapio.NewReader(searchReader)
+	if err != nil {
+		return err
 	}
-	return search.Run(context.TODO(), out, pcapReader)
+	return pcap.WriteStream(context.TODO(), out, matches, c.stream)
 }
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"syscall"
 
 	"github.com/brimsec/zq/cli"
@@ -52,9 +53,13 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 	c.NoFancy = !terminal.IsTerminal(int(os.Stdout.Fd()))
 
 	defaultHost := "localhost:9867"
-	f.StringVar(&c.Host, "h", defaultHost, "<host[:port]>")
+	f.StringVar(&c.Host, "h", defaultHost, "<host[:port]>, http[s]://<host[:port]>, or unix:<socket path>")
+	f.StringVar(&c.tlsCAFile, "tlsca", "", "path to PEM file of certificate authorities that sign the server certificate")
+	f.StringVar(&c.tlsCertFile, "tlscert", "", "path to PEM client certificate file for a server that verifies clients")
+	f.StringVar(&c.tlsKeyFile, "tlskey", "", "path to PEM private key file for -tlscert")
 	f.StringVar(&c.Spacename, "s", c.Spacename, "<space>")
 	f.Var(&c.spaceID, "id", "<space_id>")
+	f.StringVar(&c.token, "token", "", "bearer token for a zqd requiring authentication (default $ZQD_TOKEN)")
 	f.BoolVar(&c.NoFancy, "nofancy", c.NoFancy, "disable fancy CLI output (true if stdout is not a tty)")
 	c.cli.SetFlags(f)
 
@@ -62,13 +67,17 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 }
 
 type Command struct {
-	client    *api.Connection
-	Host      string
-	Spacename string
-	NoFancy   bool
-	ctx       *signalCtx
-	spaceID   api.SpaceID
-	cli       cli.Flags
+	client      *api.Connection
+	Host        string
+	Spacename   string
+	NoFancy     bool
+	ctx         *signalCtx
+	spaceID     api.SpaceID
+	token       string
+	tlsCAFile   string
+	tlsCertFile string
+	tlsKeyFile  string
+	cli         cli.Flags
 }
 
 func (c *Command) Context() context.Context {
@@ -78,11 +87,60 @@ func (c *Command) Context() context.Context {
 // Client returns a central api.Connection instance.
 func (c *Command) Client() *api.Connection {
 	if c.client == nil {
-		c.client = api.NewConnectionTo("http://" + c.Host)
+		client, err := c.connect()
+		if err != nil {
+			// Client has no error return, so exit here as cli.Flags
+			// does for its own setup failures.
+			Errorf("%s\n", err)
+			os.Exit(1)
+		}
+		c.client = client
+		token := c.token
+		if token == "" {
+			// The token is read from the environment here rather than
+			// used as the flag default so that it isn't shown by -h.
+			token = os.Getenv("ZQD_TOKEN")
+		}
+		if token != "" {
+			c.client.SetAuthToken(token)
+		}
 	}
 	return c.client
 }
 
+// connect returns a connection to the server named by c.Host.  A host
+// without a scheme is reached over https if any TLS flag is given and
+// over http otherwise.
+func (c *Command) connect() (*api.Connection, error) {
+	var client *api.Connection
+	useTLS := c.tlsCAFile != "" || c.tlsCertFile != "" || c.tlsKeyFile != ""
+	switch {
+	case strings.HasPrefix(c.Host, "unix:"):
+		path := strings.TrimPrefix(strings.TrimPrefix(c.Host, "unix:"), "//")
+		client = api.NewUnixConnection(path)
+	case strings.HasPrefix(c.Host, "https://"):
+		useTLS = true
+		client = api.NewConnectionTo(c.Host)
+	case strings.HasPrefix(c.Host, "http://"):
+		if useTLS {
+			return nil, errors.New("TLS flags cannot be used with an http:// host")
+		}
+		client = api.NewConnectionTo(c.Host)
+	case useTLS:
+		client = api.NewConnectionTo("https://" + c.Host)
+	default:
+		client = api.NewConnectionTo("http://" + c.Host)
+	}
+	if useTLS {
+		conf, err := api.ClientTLSConfig(c.tlsCAFile, c.tlsCertFile, c.tlsKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		client.SetTLSConfig(conf)
+	}
+	return client, nil
+}
+
 func (c *Command) SpaceID() (api.SpaceID, error) {
 	if c.spaceID != "" {
 		return c.spaceID, nil
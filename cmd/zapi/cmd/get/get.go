@@ -32,7 +32,9 @@ var Get = &charm.Spec{
 zapi get issues search requests to the zqd search service.
 
 The -from and -to options specify a time range.  If not provided, the entire
-space is searched.
+space is searched.  Results are returned in descending time order unless
+-forward is specified, in which case they are returned in ascending time
+order starting from the -from time.
 
 By default, the service streams results in native zng and the zapi client
 converts the results to the format specified by -f.
@@ -48,6 +50,10 @@ Everything is flow-controlled end to end, so if the client host, network,
 or disk becomes a bottleneck, the service will naturally clock itself
 at the rate determined by the client.
 
+The -timeout, -maxread, and -maxoutput options limit the running time of the
+search, the number of records it reads, and the size of its results.  They
+may tighten but not loosen the limits of the server.
+
 The -debug option can be useful for debugging.  In this case, the server
 response is written unmodified in its entirety to the output.
 `,
@@ -65,12 +71,14 @@ type Command struct {
 	encoding    string
 	from        tsflag
 	to          tsflag
-	dir         string
+	forward     bool
 	stats       bool
 	warnings    bool
 	debug       bool
 	final       *api.SearchStats
 	chunkInfo   string
+	timeout     time.Duration
+	limits      api.SearchLimits
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -83,7 +91,11 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.warnings, "W", true, "display warnings on stderr")
 	f.BoolVar(&c.debug, "debug", false, "dump raw HTTP response straight to output")
 	f.Var(&c.from, "from", "search from timestamp in RFC3339Nano format (e.g. 2006-01-02T15:04:05.999999999Z07:00)")
+	f.BoolVar(&c.forward, "forward", false, "return results in ascending time order")
 	f.Var(&c.to, "to", "search to timestamp in RFC3339Nano format (e.g. 2006-01-02T15:04:05.999999999Z07:00)")
+	f.DurationVar(&c.timeout, "timeout", 0, "maximum running time of the search")
+	f.Int64Var(&c.limits.MaxRecordsRead, "maxread", 0, "maximum number of records the search may read")
+	f.Int64Var(&c.limits.MaxOutputBytes, "maxoutput", 0, "maximum size in bytes of the search results")
 	f.StringVar(&c.chunkInfo, "chunk", "", "dash separated list of ksuid,first_ts,last_ts,dataFileKind")
 	c.outputFlags.SetFlags(f)
 	return c, nil
@@ -111,6 +123,13 @@ func (c *Command) Run(args []string) error {
 			return fmt.Errorf("parse error: %s", err)
 		}
 		req.Span = nano.NewSpanTs(nano.Ts(c.from), nano.Ts(c.to))
+		if c.forward {
+			req.Dir = 1
+		}
+		c.limits.Timeout = int64(c.timeout)
+		if c.limits != (api.SearchLimits{}) {
+			req.Limits = &c.limits
+		}
 		params := map[string]string{"format": c.encoding}
 		r, err = client.SearchRaw(c.Context(), *req, params)
 		if err != nil {
@@ -119,6 +138,9 @@ func (c *Command) Run(args []string) error {
 	} else {
 		req, err := parseExprWithChunk(id, expr, c.chunkInfo)
 		req.Span = nano.NewSpanTs(nano.Ts(c.from), nano.Ts(c.to))
+		if c.forward {
+			req.Dir = 1
+		}
 		params := map[string]string{"format": c.encoding}
 		if err != nil {
 			return fmt.Errorf("parse plus chunk error: %s", err)
@@ -14,7 +14,7 @@ import (
 
 var Create = &charm.Spec{
 	Name:  "create",
-	Usage: "create [options] [-z zql] [ pattern [ pattern ...]]",
+	Usage: "create [options] [-n name] [-z zql] [ pattern [ pattern ...]]",
 	Short: "create index on a space",
 	Long: `
 "zapi index create" creates index files in a zar archive using one or more indexing
@@ -35,6 +35,19 @@ requires specifying the key and output file name. For example:
 Multiple keys may be specified with multiple -k arguments, in which case the first key is the primary search key, the second key is the secondary search key, and so forth.  For example,
 
 zapi index create -k id.orig_h -k count -o custom -z "count() by _path, id.orig_h | sort id.orig_h,count"
+
+With -n, the rule is saved in the space as a named index definition that
+is also applied to all data subsequently posted to the space.  A named
+definition is either a single pattern or one or more keys with optional zql.
+If -z is omitted, the index holds the count of records for each distinct
+combination of keys.  If -k is omitted, the keys are the group-by keys of
+the zql.  The microindex file is named after the definition.  For example,
+
+    zapi index create -n conns -k id.orig_h -k id.resp_p
+    zapi index find -x conns 10.0.0.1 80
+
+Named definitions are listed with "zapi index ls" and removed with
+"zapi index drop".
 `,
 	New: NewCreate,
 }
@@ -50,6 +63,8 @@ type CreateCmd struct {
 	outputFile string
 	keys       arrayFlag
 	zql        string
+	name       string
+	framesize  int
 }
 
 func NewCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -58,17 +73,34 @@ func NewCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.inputFile, "i", "", "input file relative to each zar directory ('' means archive log file in the parent of the zar directory)")
 	f.StringVar(&c.outputFile, "o", "index.zng", "name of microindex output file (for custom indexes)")
 	f.StringVar(&c.zql, "z", "", "zql for custom indexes")
+	f.StringVar(&c.name, "n", "", "save the rule as a named index definition")
+	f.IntVar(&c.framesize, "f", 0, "minimum frame size used in microindex file of a named index")
 	return c, nil
 }
 
 func (c *CreateCmd) Run(args []string) error {
-	if len(args) == 0 && c.zql == "" {
+	if len(args) == 0 && c.zql == "" && (c.name == "" || len(c.keys) == 0) {
 		return errors.New("zapi index create: one or more indexing patterns must be specified")
 	}
 	id, err := c.SpaceID()
 	if err != nil {
 		return err
 	}
+	if c.name != "" {
+		if len(args) > 1 {
+			return errors.New("zapi index create: a named index takes a single pattern")
+		}
+		def := api.IndexDef{
+			Name:      c.name,
+			Keys:      c.keys,
+			ZQL:       c.zql,
+			Framesize: c.framesize,
+		}
+		if len(args) == 1 {
+			def.Pattern = args[0]
+		}
+		return c.Client().IndexDefPost(c.Context(), id, def)
+	}
 	req := api.IndexPostRequest{
 		Patterns:   args,
 		InputFile:  c.inputFile,
@@ -0,0 +1,39 @@
+package idx
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Drop = &charm.Spec{
+	Name:  "drop",
+	Usage: "drop name",
+	Short: "remove a named index definition from a space",
+	Long: `
+"zapi index drop" removes a named index definition created with
+"zapi index create -n" from a space along with its microindex files.
+`,
+	New: NewDrop,
+}
+
+type DropCmd struct {
+	*cmd.Command
+}
+
+func NewDrop(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &DropCmd{Command: parent.(*IndexCmd).Command}, nil
+}
+
+func (c *DropCmd) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zapi index drop: a single index name must be specified")
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	return c.Client().IndexDefDelete(c.Context(), id, args[0])
+}
@@ -9,7 +9,7 @@ import (
 
 var Index = &charm.Spec{
 	Name:  "index",
-	Usage: "index [find|create]",
+	Usage: "index [find|create|ls|drop]",
 	Short: "query and create search indexes",
 	Long:  "",
 	New:   New,
@@ -19,6 +19,8 @@ func init() {
 	cmd.CLI.Add(Index)
 	Index.Add(Find)
 	Index.Add(Create)
+	Index.Add(Ls)
+	Index.Add(Drop)
 }
 
 type IndexCmd struct {
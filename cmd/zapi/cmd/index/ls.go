@@ -0,0 +1,60 @@
+package idx
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "ls",
+	Short: "list named index definitions of a space",
+	Long: `
+"zapi index ls" lists the index definitions created with
+"zapi index create -n".  Each line holds the name of a definition followed
+by its pattern or by its keys and zql, if given.
+`,
+	New: NewLs,
+}
+
+type LsCmd struct {
+	*cmd.Command
+}
+
+func NewLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &LsCmd{Command: parent.(*IndexCmd).Command}, nil
+}
+
+func (c *LsCmd) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zapi index ls: too many arguments")
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	defs, err := c.Client().IndexDefList(c.Context(), id)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if def.Pattern != "" {
+			fmt.Printf("%s\tpattern %s\n", def.Name, def.Pattern)
+			continue
+		}
+		line := def.Name
+		if len(def.Keys) != 0 {
+			line += fmt.Sprintf("\tkeys %s", strings.Join(def.Keys, ","))
+		}
+		if def.ZQL != "" {
+			line += fmt.Sprintf("\tzql %q", def.ZQL)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package kill
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Kill = &charm.Spec{
+	Name:  "kill",
+	Usage: "kill id [id ...]",
+	Short: "cancel running searches",
+	Long: `
+The kill command cancels the searches with the given IDs, as listed by the
+ps command.  A canceled search ends with an error.  Canceling another user's
+search requires admin permission on its space.`,
+	New: New,
+}
+
+func init() {
+	cmd.CLI.Add(Kill)
+}
+
+type Command struct {
+	*cmd.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*cmd.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) == 0 {
+		return errors.New("kill command requires one or more search IDs")
+	}
+	ids := make([]int64, 0, len(args))
+	for _, arg := range args {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid search ID: %s", arg)
+		}
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		if err := c.Client().SearchCancel(c.Context(), id); err != nil {
+			return err
+		}
+		fmt.Printf("%d: search canceled\n", id)
+	}
+	return nil
+}
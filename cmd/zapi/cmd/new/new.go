@@ -31,6 +31,7 @@ type Command struct {
 	kind     storage.Kind
 	datapath string
 	thresh   units.Bytes
+	format   string
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -42,6 +43,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.Var(&c.kind, "k", "kind of storage for this space")
 	f.StringVar(&c.datapath, "d", "", "specific directory for storage data")
 	f.Var(&c.thresh, "thresh", "target size of chopped files, as '10MB', '4GiB', etc.")
+	f.StringVar(&c.format, "format", "", "format of chunk files for archive storage [zng,zst]")
 	return c, nil
 }
 
@@ -62,6 +64,7 @@ func (c *Command) Run(args []string) error {
 			Archive: &storage.ArchiveConfig{
 				CreateOptions: &storage.ArchiveCreateOptions{
 					LogSizeThreshold: (*int64)(&c.thresh),
+					DataFormat:       c.format,
 				},
 			},
 		},
@@ -0,0 +1,76 @@
+package ps
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/cmd/zapi/format"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/mccanne/charm"
+)
+
+var Ps = &charm.Spec{
+	Name:  "ps",
+	Usage: "ps",
+	Short: "list running searches",
+	Long: `
+The ps command lists the searches running on the connected zqd along with
+their elapsed time and progress.  A search listed by ps may be canceled with
+the kill command.`,
+	New: New,
+}
+
+func init() {
+	cmd.CLI.Add(Ps)
+}
+
+type Command struct {
+	*cmd.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*cmd.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) > 0 {
+		return errors.New("ps command takes no arguments")
+	}
+	client := c.Client()
+	searches, err := client.SearchList(c.Context())
+	if err != nil {
+		return err
+	}
+	if len(searches) == 0 {
+		fmt.Println("no searches running")
+		return nil
+	}
+	spaces, err := client.SpaceList(c.Context())
+	if err != nil {
+		return err
+	}
+	names := make(map[api.SpaceID]string)
+	for _, sp := range spaces {
+		names[sp.ID] = sp.Name
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tSPACE\tUSER\tELAPSED\tBYTES READ\tRECORDS READ\tRECORDS MATCHED")
+	for _, s := range searches {
+		space, ok := names[s.Space]
+		if !ok {
+			space = string(s.Space)
+		}
+		user := s.User
+		if user == "" {
+			user = "-"
+		}
+		elapsed := time.Duration(s.Elapsed).Round(time.Millisecond)
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%d\t%d\n", s.ID, s.Kind, space, user, elapsed, format.Bytes(s.BytesRead), s.RecordsRead, s.RecordsMatched)
+	}
+	return w.Flush()
+}
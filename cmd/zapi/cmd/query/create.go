@@ -0,0 +1,72 @@
+package query
+
+import (
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zql"
+	"github.com/mccanne/charm"
+)
+
+var Create = &charm.Spec{
+	Name:  "create",
+	Usage: "create [-d description] [-p name=value ...] name zql",
+	Short: "save a query in a space",
+	Long: `
+"zapi query create" saves a named zql query in a space.  Each -p option
+gives the default value of a parameter of the query.
+`,
+	New: NewCreate,
+}
+
+type CreateCmd struct {
+	*cmd.Command
+	description string
+	params      paramsFlag
+}
+
+func NewCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &CreateCmd{Command: parent.(*QueryCmd).Command}
+	f.StringVar(&c.description, "d", "", "description of the query")
+	f.Var(&c.params, "p", "default parameter value as name=value (can be specified multiple times)")
+	return c, nil
+}
+
+func (c *CreateCmd) Run(args []string) error {
+	q, err := savedQuery("create", args, c.description, c.params)
+	if err != nil {
+		return err
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	return c.Client().QueryPost(c.Context(), id, *q)
+}
+
+func savedQuery(cmdname string, args []string, description string, params []string) (*api.SavedQuery, error) {
+	if len(args) < 2 {
+		return nil, errors.New("zapi query " + cmdname + ": a name and a query must be specified")
+	}
+	defaults, err := parseParams(params)
+	if err != nil {
+		return nil, err
+	}
+	q := &api.SavedQuery{
+		Name:        args[0],
+		Query:       strings.Join(args[1:], " "),
+		Description: description,
+		Params:      defaults,
+	}
+	if !strings.Contains(q.Query, "${") {
+		// Catch syntax errors before the query is saved.  Queries with
+		// parameters are checked when they are run.
+		if _, err := zql.ParseProc(q.Query); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
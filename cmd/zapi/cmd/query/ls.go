@@ -0,0 +1,62 @@
+package query
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "ls [-l]",
+	Short: "list the saved queries of a space",
+	Long: `
+"zapi query ls" lists the names of the saved queries of a space.  With -l,
+each name is followed by the query, its parameter defaults, and its
+description.
+`,
+	New: NewLs,
+}
+
+type LsCmd struct {
+	*cmd.Command
+	long bool
+}
+
+func NewLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &LsCmd{Command: parent.(*QueryCmd).Command}
+	f.BoolVar(&c.long, "l", false, "long output")
+	return c, nil
+}
+
+func (c *LsCmd) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zapi query ls: too many arguments")
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	queries, err := c.Client().QueryList(c.Context(), id)
+	if err != nil {
+		return err
+	}
+	for _, q := range queries {
+		if !c.long {
+			fmt.Println(q.Name)
+			continue
+		}
+		line := fmt.Sprintf("%s\t%q", q.Name, q.Query)
+		if len(q.Params) != 0 {
+			line += "\t" + formatParams(q.Params)
+		}
+		if q.Description != "" {
+			line += "\t# " + q.Description
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Query = &charm.Spec{
+	Name:  "query",
+	Usage: "query [create|update|ls|rm|run]",
+	Short: "manage and run saved queries",
+	Long: `
+A saved query is a named zql query stored in a space.  The query may refer
+to parameters as ${name}, which are replaced by values given when the query
+is run.  For example,
+
+    zapi query create -p port=80 conns 'id.resp_p=${port} | count() by id.orig_h'
+    zapi query run conns port=443
+`,
+	New: New,
+}
+
+func init() {
+	cmd.CLI.Add(Query)
+	Query.Add(Create)
+	Query.Add(Update)
+	Query.Add(Ls)
+	Query.Add(Rm)
+	Query.Add(Run)
+}
+
+type QueryCmd struct {
+	*cmd.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &QueryCmd{Command: parent.(*cmd.Command)}, nil
+}
+
+func (c *QueryCmd) Run(args []string) error {
+	return charm.ErrNoRun
+}
+
+// parseParams parses arguments of the form name=value.
+func parseParams(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	params := make(map[string]string)
+	for _, arg := range args {
+		i := strings.IndexByte(arg, '=')
+		if i < 1 {
+			return nil, fmt.Errorf("parameter must be of the form name=value: %s", arg)
+		}
+		params[arg[:i]] = arg[i+1:]
+	}
+	return params, nil
+}
+
+type paramsFlag []string
+
+func (p *paramsFlag) String() string {
+	return strings.Join(*p, ", ")
+}
+
+func (p *paramsFlag) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func formatParams(params map[string]string) string {
+	var s []string
+	for k, v := range params {
+		s = append(s, k+"="+v)
+	}
+	sort.Strings(s)
+	return strings.Join(s, " ")
+}
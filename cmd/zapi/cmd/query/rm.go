@@ -0,0 +1,35 @@
+package query
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Rm = &charm.Spec{
+	Name:  "rm",
+	Usage: "rm name",
+	Short: "remove a saved query from a space",
+	New:   NewRm,
+}
+
+type RmCmd struct {
+	*cmd.Command
+}
+
+func NewRm(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &RmCmd{Command: parent.(*QueryCmd).Command}, nil
+}
+
+func (c *RmCmd) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zapi query rm: a single query name must be specified")
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	return c.Client().QueryDelete(c.Context(), id, args[0])
+}
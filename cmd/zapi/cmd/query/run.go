@@ -0,0 +1,77 @@
+package query
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/brimsec/zq/cli/outputflags"
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/mccanne/charm"
+)
+
+var Run = &charm.Spec{
+	Name:  "run",
+	Usage: "run [options] name [param=value ...]",
+	Short: "run a saved query",
+	Long: `
+"zapi query run" runs the saved query named name over the whole space and
+writes its results in the format specified by -f.  Each param=value
+argument gives the value of a parameter of the query, overriding its
+default.
+`,
+	New: NewRun,
+}
+
+type RunCmd struct {
+	*cmd.Command
+	outputFlags outputflags.Flags
+}
+
+func NewRun(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &RunCmd{Command: parent.(*QueryCmd).Command}
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *RunCmd) Run(args []string) error {
+	defer c.Cleanup()
+	if err := c.Init(&c.outputFlags); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("zapi query run: a query name must be specified")
+	}
+	params, err := parseParams(args[1:])
+	if err != nil {
+		return err
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	stream, err := c.Client().QueryRun(c.Context(), id, args[0], api.QueryRunRequest{Params: params}, nil)
+	if err != nil {
+		return err
+	}
+	stream.SetOnCtrl(func(ctrl interface{}) {
+		if w, ok := ctrl.(*api.SearchWarning); ok {
+			fmt.Fprintln(os.Stderr, w.Warning)
+		}
+	})
+	writer, err := c.outputFlags.Open()
+	if err != nil {
+		return err
+	}
+	if err := zbuf.Copy(writer, stream); err != nil {
+		writer.Close()
+		if c.Context().Err() != nil {
+			return errors.New("query aborted")
+		}
+		return err
+	}
+	return writer.Close()
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"flag"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Update = &charm.Spec{
+	Name:  "update",
+	Usage: "update [-d description] [-p name=value ...] name zql",
+	Short: "replace a saved query",
+	Long: `
+"zapi query update" replaces the saved query named name, including its
+description and parameter defaults.
+`,
+	New: NewUpdate,
+}
+
+type UpdateCmd struct {
+	*cmd.Command
+	description string
+	params      paramsFlag
+}
+
+func NewUpdate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &UpdateCmd{Command: parent.(*QueryCmd).Command}
+	f.StringVar(&c.description, "d", "", "description of the query")
+	f.Var(&c.params, "p", "default parameter value as name=value (can be specified multiple times)")
+	return c, nil
+}
+
+func (c *UpdateCmd) Run(args []string) error {
+	q, err := savedQuery("update", args, c.description, c.params)
+	if err != nil {
+		return err
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	return c.Client().QueryPut(c.Context(), id, q.Name, *q)
+}
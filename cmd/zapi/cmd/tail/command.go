@@ -0,0 +1,91 @@
+package tail
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/brimsec/zq/cli/outputflags"
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zql"
+	"github.com/mccanne/charm"
+)
+
+var Tail = &charm.Spec{
+	Name:  "tail",
+	Usage: "tail [options] [filter]",
+	Short: "stream records as they are ingested",
+	Long: `
+zapi tail streams the records matching a zql filter that are ingested into
+a space from the time the command starts until it is interrupted or the
+space is deleted.  If no filter is given, all records are streamed.
+
+Records are streamed as they are written by a log post.  Records from a
+pcap post are streamed when the ingest of the pcap completes.  If the client
+falls behind, records are dropped and a warning is written to stderr.
+`,
+	New: New,
+}
+
+func init() {
+	cmd.CLI.Add(Tail)
+}
+
+type Command struct {
+	*cmd.Command
+	outputFlags outputflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*cmd.Command)}
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	defer c.Cleanup()
+	if err := c.Init(&c.outputFlags); err != nil {
+		return err
+	}
+	expr := "*"
+	if len(args) > 0 {
+		expr = strings.Join(args, " ")
+	}
+	parsed, err := zql.ParseProc(expr)
+	if err != nil {
+		return fmt.Errorf("parse error: %s", err)
+	}
+	proc, err := json.Marshal(parsed)
+	if err != nil {
+		return err
+	}
+	id, err := c.SpaceID()
+	if err != nil {
+		return err
+	}
+	stream, err := c.Client().Tail(c.Context(), id, api.TailRequest{Proc: proc}, nil)
+	if err != nil {
+		return err
+	}
+	stream.SetOnCtrl(func(ctrl interface{}) {
+		if w, ok := ctrl.(*api.SearchWarning); ok {
+			fmt.Fprintln(os.Stderr, w.Warning)
+		}
+	})
+	writer, err := c.outputFlags.Open()
+	if err != nil {
+		return err
+	}
+	err = zbuf.CopyWithContext(c.Context(), writer, stream)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if c.Context().Err() != nil {
+		return nil
+	}
+	return err
+}
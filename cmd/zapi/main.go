@@ -8,12 +8,16 @@ import (
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/get"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/index"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/info"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/kill"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/new"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/newsubspace"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/post"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/ps"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/query"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rename"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/repl"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rm"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/tail"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/version"
 )
 
@@ -0,0 +1,103 @@
+package zardelete
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/signalctx"
+	"github.com/brimsec/zq/zql"
+	"github.com/mccanne/charm"
+)
+
+var Delete = &charm.Spec{
+	Name:  "delete",
+	Usage: "delete [-R root] [-q] filter",
+	Short: "delete records matching a filter from an archive",
+	Long: `
+"zar delete" removes all records matching the given ZQL filter from an
+archive. Each chunk holding matching records is rewritten without them and
+its field and type indexes and the indexes defined with "zar index -n" are
+regenerated. A chunk holding any other file in its zar directory, such as a
+custom index created without "-n", cannot be rewritten without losing that
+file, so "zar delete" stops with an error when it reaches such a chunk.
+Define the index with "zar index -n" or remove the file and try again.
+
+When the filter requires a field to equal a value, as in
+
+	zar delete -R /path/to/logs id.orig_h=10.0.0.1
+
+chunks whose index for that field has no such value are skipped without
+being read.
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Delete)
+}
+
+type Command struct {
+	*root.Command
+	root  string
+	quiet bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
+	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zar delete: a single filter must be specified")
+	}
+	if c.root == "" {
+		return errors.New("zar delete: no archive root specified with -R or ZAR_ROOT")
+	}
+	query, err := zql.ParseProc(args[0])
+	if err != nil {
+		return err
+	}
+	fp, ok := query.(*ast.FilterProc)
+	if !ok {
+		return errors.New("zar delete: query must be a filter")
+	}
+
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var progress chan string
+	if !c.quiet {
+		wg.Add(1)
+		progress = make(chan string)
+		go func() {
+			for line := range progress {
+				fmt.Println(line)
+			}
+			wg.Done()
+		}()
+	}
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	n, err := archive.Delete(ctx, ark, fp.Filter, progress)
+	if progress != nil {
+		close(progress)
+		wg.Wait()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d records deleted\n", n)
+	return nil
+}
@@ -0,0 +1,114 @@
+package zarexport
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/signalctx"
+	"github.com/mccanne/charm"
+)
+
+var Export = &charm.Spec{
+	Name:  "export",
+	Usage: "export [-R root] [-from time] [-to time] [-q] bundle",
+	Short: "write the chunks of an archive to a portable bundle",
+	Long: `
+"zar export" writes the data files, microindexes, and metadata of an archive
+to a single bundle file, which may be a local path or an S3 URI.  The bundle
+can be merged into another archive with "zar restore".
+
+If -from or -to is given, only chunks whose span overlaps the time range
+[from, to) are exported.  Chunks are exported whole, so the bundle may hold
+records outside of the range.  Times are given as RFC 3339 timestamps
+(e.g., 2020-05-01T00:00:00Z) or as float seconds since 1970-01-01.
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Export)
+}
+
+type Command struct {
+	*root.Command
+	root  string
+	from  string
+	to    string
+	quiet bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
+	f.StringVar(&c.from, "from", "", "beginning of time range")
+	f.StringVar(&c.to, "to", "", "end of time range")
+	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
+	return c, nil
+}
+
+func parseTime(s string, def nano.Ts) (nano.Ts, error) {
+	if s == "" {
+		return def, nil
+	}
+	if ts, err := nano.ParseRFC3339Nano([]byte(s)); err == nil {
+		return ts, nil
+	}
+	return nano.ParseTs(s)
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zar export: a single bundle location must be specified")
+	}
+	if c.root == "" {
+		return errors.New("zar export: no archive root specified with -R or ZAR_ROOT")
+	}
+	from, err := parseTime(c.from, nano.MinTs)
+	if err != nil {
+		return err
+	}
+	to, err := parseTime(c.to, nano.MaxTs)
+	if err != nil {
+		return err
+	}
+	uri, err := iosrc.ParseURI(args[0])
+	if err != nil {
+		return err
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var progress chan string
+	if !c.quiet {
+		wg.Add(1)
+		progress = make(chan string)
+		go func() {
+			for line := range progress {
+				fmt.Println(line)
+			}
+			wg.Done()
+		}()
+	}
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	n, err := archive.Export(ctx, ark, nano.NewSpanTs(from, to), uri, progress)
+	if progress != nil {
+		close(progress)
+		wg.Wait()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d chunks exported\n", n)
+	return nil
+}
@@ -29,6 +29,11 @@ The input data is sorted and partitioned by time into approximately equal
 sized ZNG files, called "chunks". The path of each chunk is a subdirectory in
 the specified root location (-R or ZAR_ROOT), where the subdirectory name is
 derived from the timestamp of the first zng record in that chunk.
+
+When a new archive is created, the -format flag selects whether its chunks
+are stored as ZNG (the default) or as columnar ZST files. Queries against a
+ZST archive read only the columns they need when the fields a query
+references can be determined in advance, as with "count() by _path".
 `,
 	New: New,
 }
@@ -41,6 +46,7 @@ type Command struct {
 	*root.Command
 	root          string
 	dataPath      string
+	dataFormat    string
 	thresh        units.Bytes
 	importBufSize units.Bytes
 	empty         bool
@@ -52,6 +58,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*root.Command)}
 	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
 	f.StringVar(&c.dataPath, "data", "", "location for storing data files (defaults to root)")
+	f.StringVar(&c.dataFormat, "format", archive.DefaultDataFormat, "format of chunk files for a new archive [zng,zst]")
 	c.thresh = archive.DefaultLogSizeThreshold
 	f.Var(&c.thresh, "s", "target size of chunk files, as '10MB' or '4GiB', etc.")
 	c.importBufSize = units.Bytes(archive.ImportBufSize)
@@ -74,7 +81,7 @@ func (c *Command) Run(args []string) error {
 	}
 	archive.ImportBufSize = int64(c.importBufSize)
 
-	co := &archive.CreateOptions{DataPath: c.dataPath}
+	co := &archive.CreateOptions{DataPath: c.dataPath, DataFormat: c.dataFormat}
 	thresh := int64(c.thresh)
 	co.LogSizeThreshold = &thresh
 
@@ -1,6 +1,7 @@
 package index
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -18,7 +19,7 @@ import (
 
 var Index = &charm.Spec{
 	Name:  "index",
-	Usage: "index [-R root] [options] [-z zql] [ pattern [ pattern ...]]",
+	Usage: "index [-R root] [options] [-n name] [-z zql] [ pattern [ pattern ...]]",
 	Short: "create index files for zng files",
 	Long: `
 "zar index" creates index files in a zar archive using one or more indexing
@@ -36,12 +37,30 @@ For custom indexes, zql can be used instead of a pattern. This
 requires specifying the key and output file name. For example:
 
        zar index -k id.orig_h -o custom -z "count() by _path, id.orig_h | sort id.orig_h"
+
+With -n, the rule is saved in the archive as a named index definition and,
+in addition to being applied to the existing logs, is applied to every log
+subsequently added by "zar import".  A named definition is either a single
+pattern or a list of keys given by -k with optional zql given by -z.  If -z
+is omitted, the index holds the count of records for each distinct
+combination of keys.  If -k is omitted, the keys are the group-by keys of
+the zql.  The keys need not be sorted by the zql, and the microindex file is
+named after the definition.  For example,
+
+	zar index -n conns -k id.orig_h,id.resp_p
+	zar index -n bytes -z "sum(orig_bytes) by id.orig_h,id.resp_p"
+	zar find -x conns 10.0.0.1 80
+
+The definitions of an archive are listed with "zar index ls" and removed,
+along with their microindex files, with "zar index drop".
 `,
 	New: New,
 }
 
 func init() {
 	root.Zar.Add(Index)
+	Index.Add(Ls)
+	Index.Add(Drop)
 }
 
 type Command struct {
@@ -52,6 +71,7 @@ type Command struct {
 	outputFile string
 	framesize  int
 	keys       string
+	name       string
 	zql        string
 	procFlags  procflags.Flags
 }
@@ -59,12 +79,13 @@ type Command struct {
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*root.Command)}
 	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
-	f.StringVar(&c.keys, "k", "key", "one or more comma-separated key fields")
+	f.StringVar(&c.keys, "k", "", "one or more comma-separated key fields (default \"key\" for custom indexes)")
 	f.IntVar(&c.framesize, "f", 32*1024, "minimum frame size used in microindex file")
 	f.StringVar(&c.inputFile, "i", "_", "input file relative to each zar directory ('_' means archive log file in the parent of the zar directory)")
 	f.StringVar(&c.outputFile, "o", "index.zng", "name of microindex output file (for custom indexes)")
 	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
 	f.StringVar(&c.zql, "z", "", "zql for custom indexes")
+	f.StringVar(&c.name, "n", "", "save the rule as a named index definition applied by import")
 	c.procFlags.SetFlags(f)
 	return c, nil
 }
@@ -74,7 +95,7 @@ func (c *Command) Run(args []string) error {
 	if err := c.Init(&c.procFlags); err != nil {
 		return err
 	}
-	if len(args) == 0 && c.zql == "" {
+	if len(args) == 0 && c.zql == "" && (c.name == "" || c.keys == "") {
 		return errors.New("zar index: one or more indexing patterns must be specified")
 	}
 	if c.root == "" {
@@ -89,21 +110,34 @@ func (c *Command) Run(args []string) error {
 		return err
 	}
 
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	var keys []string
+	if c.keys != "" {
+		keys = strings.Split(c.keys, ",")
+	}
 	var rules []archive.Rule
-	keys := strings.Split(c.keys, ",")
-	if c.zql != "" {
-		rule, err := archive.NewZqlRule(c.zql, c.outputFile, keys, c.framesize)
+	if c.name != "" {
+		rule, err := c.define(ctx, ark, args, keys)
 		if err != nil {
 			return errors.New("zar index: " + err.Error())
 		}
 		rules = append(rules, *rule)
-	}
-	for _, pattern := range args {
-		rule, err := archive.NewRule(pattern)
-		if err != nil {
-			return errors.New("zar index: " + err.Error())
+	} else {
+		if c.zql != "" {
+			rule, err := archive.NewZqlRule(c.zql, c.outputFile, keys, c.framesize)
+			if err != nil {
+				return errors.New("zar index: " + err.Error())
+			}
+			rules = append(rules, *rule)
+		}
+		for _, pattern := range args {
+			rule, err := archive.NewRule(pattern)
+			if err != nil {
+				return errors.New("zar index: " + err.Error())
+			}
+			rules = append(rules, *rule)
 		}
-		rules = append(rules, *rule)
 	}
 	var wg sync.WaitGroup
 	var progress chan string
@@ -117,8 +151,6 @@ func (c *Command) Run(args []string) error {
 			wg.Done()
 		}()
 	}
-	ctx, cancel := signalctx.New(os.Interrupt)
-	defer cancel()
 	err = archive.IndexDirTree(ctx, ark, rules, c.inputFile, progress)
 	if progress != nil {
 		close(progress)
@@ -126,3 +158,25 @@ func (c *Command) Run(args []string) error {
 	}
 	return err
 }
+
+// define saves a named index definition in the archive and returns its rule.
+func (c *Command) define(ctx context.Context, ark *archive.Archive, args, keys []string) (*archive.Rule, error) {
+	if c.inputFile != "_" {
+		return nil, errors.New("a named index cannot be used with -i")
+	}
+	var pattern string
+	switch {
+	case len(args) > 1:
+		return nil, errors.New("a named index takes a single pattern")
+	case len(args) == 1:
+		pattern = args[0]
+	}
+	def, err := archive.NewIndexDef(c.name, pattern, keys, c.zql, c.framesize)
+	if err != nil {
+		return nil, err
+	}
+	if err := archive.AddIndexDef(ctx, ark, *def); err != nil {
+		return nil, err
+	}
+	return def.Rule()
+}
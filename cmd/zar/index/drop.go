@@ -0,0 +1,72 @@
+package index
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/pkg/signalctx"
+	"github.com/mccanne/charm"
+)
+
+var Drop = &charm.Spec{
+	Name:  "drop",
+	Usage: "index drop [-R root] [-q] name",
+	Short: "remove a named index definition from an archive",
+	Long: `
+"zar index drop" removes the named index definition created with
+"zar index -n" from an archive, so that it is no longer applied by
+"zar import", and removes its microindex file from each log.
+`,
+	New: NewDrop,
+}
+
+type DropCommand struct {
+	*Command
+	root  string
+	quiet bool
+}
+
+func NewDrop(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &DropCommand{Command: parent.(*Command)}
+	f.StringVar(&c.root, "R", c.Command.root, "root location of zar archive to walk")
+	f.BoolVar(&c.quiet, "q", c.Command.quiet, "don't print progress on stdout")
+	return c, nil
+}
+
+func (c *DropCommand) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zar index drop: a single index name must be specified")
+	}
+	if c.root == "" {
+		return errors.New("zar index drop: a directory must be specified with -R or ZAR_ROOT")
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var progress chan string
+	if !c.quiet {
+		wg.Add(1)
+		progress = make(chan string)
+		go func() {
+			for line := range progress {
+				fmt.Println(line)
+			}
+			wg.Done()
+		}()
+	}
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	err = archive.DropIndexDef(ctx, ark, args[0], progress)
+	if progress != nil {
+		close(progress)
+		wg.Wait()
+	}
+	return err
+}
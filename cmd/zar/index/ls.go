@@ -0,0 +1,67 @@
+package index
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/mccanne/charm"
+)
+
+var Ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "index ls [-R root]",
+	Short: "list the named index definitions of an archive",
+	Long: `
+"zar index ls" lists the index definitions created with "zar index -n".
+Each line holds the name of a definition followed by its pattern or by its
+keys and zql, if given.
+`,
+	New: NewLs,
+}
+
+type LsCommand struct {
+	*Command
+	root string
+}
+
+func NewLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &LsCommand{Command: parent.(*Command)}
+	f.StringVar(&c.root, "R", c.Command.root, "root location of zar archive to walk")
+	return c, nil
+}
+
+func (c *LsCommand) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zar index ls: too many arguments")
+	}
+	if c.root == "" {
+		return errors.New("zar index ls: a directory must be specified with -R or ZAR_ROOT")
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+	defs, err := archive.ReadIndexDefs(context.TODO(), ark)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if def.Pattern != "" {
+			fmt.Printf("%s\tpattern %s\n", def.Name, def.Pattern)
+			continue
+		}
+		line := def.Name
+		if len(def.Keys) != 0 {
+			line += fmt.Sprintf("\tkeys %s", strings.Join(def.Keys, ","))
+		}
+		if def.ZQL != "" {
+			line += fmt.Sprintf("\tzql %q", def.ZQL)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
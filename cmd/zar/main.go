@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	_ "github.com/brimsec/zq/cmd/zar/delete"
+	_ "github.com/brimsec/zq/cmd/zar/export"
 	_ "github.com/brimsec/zq/cmd/zar/find"
 	_ "github.com/brimsec/zq/cmd/zar/import"
 	_ "github.com/brimsec/zq/cmd/zar/index"
 	_ "github.com/brimsec/zq/cmd/zar/ls"
 	_ "github.com/brimsec/zq/cmd/zar/map"
+	_ "github.com/brimsec/zq/cmd/zar/restore"
 	_ "github.com/brimsec/zq/cmd/zar/rm"
 	_ "github.com/brimsec/zq/cmd/zar/rmdirs"
 	"github.com/brimsec/zq/cmd/zar/root"
@@ -0,0 +1,92 @@
+package zarrestore
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/signalctx"
+	"github.com/mccanne/charm"
+)
+
+var Restore = &charm.Spec{
+	Name:  "restore",
+	Usage: "restore [-R root] [-q] bundle",
+	Short: "merge a bundle written by zar export into an archive",
+	Long: `
+"zar restore" adds the chunks of a bundle written by "zar export" to an
+existing archive.  The bundle may be a local path or an S3 URI.  Chunks
+already present in the archive are skipped, so restoring a bundle more than
+once has no further effect.  The restored chunks become visible to queries
+only after the whole bundle has been read.
+
+The archive must have the same data sort direction as the archive the
+bundle was exported from.  To restore a bundle into a new archive, first
+create it with "zar import -empty".
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Restore)
+}
+
+type Command struct {
+	*root.Command
+	root  string
+	quiet bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
+	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zar restore: a single bundle location must be specified")
+	}
+	if c.root == "" {
+		return errors.New("zar restore: no archive root specified with -R or ZAR_ROOT")
+	}
+	uri, err := iosrc.ParseURI(args[0])
+	if err != nil {
+		return err
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var progress chan string
+	if !c.quiet {
+		wg.Add(1)
+		progress = make(chan string)
+		go func() {
+			for line := range progress {
+				fmt.Println(line)
+			}
+			wg.Done()
+		}()
+	}
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	n, err := archive.Restore(ctx, ark, uri, progress)
+	if progress != nil {
+		close(progress)
+		wg.Wait()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d chunks restored\n", n)
+	return nil
+}
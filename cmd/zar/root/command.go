@@ -24,6 +24,13 @@ keyed records and supports very fast lookup of keys. When the key represents
 a value in the associated chunk file, micro-indexes can be used to to make
 searching an archive very fast.
 
+Commands that change a filesystem archive, such as "zar import", "zar index",
+and "zar delete", take an advisory lock on the file zar.lock in the archive
+root, and commands that read the archive take it shared while reading chunks,
+so readers never see a partially completed change. The lock is a no-op for
+archives at non-file URIs, such as S3, so concurrent changes to such an
+archive must be coordinated by other means.
+
 See the zar README in the zq github repo for more information:
 https://github.com/brimsec/zq/blob/master/cmd/zar/README.md
 `,
@@ -14,6 +14,8 @@ import (
 	"os/exec"
 	"os/signal"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/brimsec/zq/cli"
 	"github.com/brimsec/zq/cmd/zqd/logger"
@@ -23,7 +25,10 @@ import (
 	"github.com/brimsec/zq/pkg/rlimit"
 	"github.com/brimsec/zq/proc/sort"
 	"github.com/brimsec/zq/zqd"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
 	"github.com/brimsec/zq/zqd/pcapanalyzer"
+	"github.com/brimsec/zq/zql"
 	"github.com/mccanne/charm"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -50,6 +55,10 @@ func init() {
 type Command struct {
 	*root.Command
 	listenAddr         string
+	unixSocket         string
+	tlsCertFile        string
+	tlsKeyFile         string
+	tlsClientCAFile    string
 	conf               zqd.Config
 	pprof              bool
 	prom               bool
@@ -61,6 +70,11 @@ type Command struct {
 	logger             *zap.Logger
 	devMode            bool
 	portFile           string
+	workers            string
+	workerToken        string
+	workerTLSCAFile    string
+	workerTLSCertFile  string
+	workerTLSKeyFile   string
 	// brimfd is a file descriptor passed through by brim desktop. If set zqd
 	// will exit if the fd is closed.
 	brimfd int
@@ -70,6 +84,10 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*root.Command)}
 	c.conf.Version = cli.Version
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
+	f.StringVar(&c.unixSocket, "unix", "", "path of a unix domain socket to listen on instead of [addr]:port")
+	f.StringVar(&c.tlsCertFile, "tlscert", "", "path to PEM certificate file (serves https when given with -tlskey)")
+	f.StringVar(&c.tlsKeyFile, "tlskey", "", "path to PEM private key file for -tlscert")
+	f.StringVar(&c.tlsClientCAFile, "tlsclientca", "", "path to PEM file of certificate authorities that must sign client certificates")
 	f.StringVar(&c.conf.Root, "data", ".", "data location")
 	f.StringVar(&c.suricataRunnerPath, "suricatarunner", "", "path to command that generates suricata eve.json from pcap data")
 	f.StringVar(&c.zeekRunnerPath, "zeekrunner", "", "path to command that generates zeek logs from pcap data")
@@ -78,6 +96,11 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.configfile, "config", "", "path to a zqd config file")
 	f.Var(&c.logLevel, "loglevel", "level for log output (defaults to info)")
 	f.BoolVar(&c.devMode, "dev", false, "runs zqd in development mode")
+	f.StringVar(&c.workers, "workers", "", "comma-separated URLs of worker zqd instances serving the same data directory across which archive searches are distributed")
+	f.StringVar(&c.workerToken, "workertoken", "", "bearer token for workers requiring authentication (default $ZQD_WORKER_TOKEN)")
+	f.StringVar(&c.workerTLSCAFile, "workertlsca", "", "path to PEM file of certificate authorities that sign worker certificates")
+	f.StringVar(&c.workerTLSCertFile, "workertlscert", "", "path to PEM client certificate file for workers that verify clients")
+	f.StringVar(&c.workerTLSKeyFile, "workertlskey", "", "path to PEM private key file for -workertlscert")
 	f.StringVar(&c.portFile, "portfile", "", "write port of http listener to file")
 
 	// hidden
@@ -97,23 +120,35 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		c.logger.Warn("Raising open files limit failed", zap.Error(err))
 	}
+	var promreg *prometheus.Registry
+	if c.prom {
+		promreg = prometheus.NewRegistry()
+		promreg.MustRegister(prometheus.NewGoCollector())
+		promreg.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+		c.conf.Registerer = promreg
+	}
 	core, err := zqd.NewCore(c.conf)
 	if err != nil {
 		return err
 	}
+	defer core.Shutdown()
 	c.logger.Info("Starting",
 		zap.String("datadir", c.conf.Root),
 		zap.Uint64("open_files_limit", openFilesLimit),
+		zap.Bool("auth_enabled", c.conf.Auth != nil),
 		zap.Bool("pprof_routes", c.pprof),
 		zap.Bool("suricata_supported", core.HasSuricata()),
 		zap.Bool("zeek_supported", core.HasZeek()),
+		zap.Strings("pcap_analyzers", c.analyzerNames()),
+		zap.Strings("workers", c.conf.Workers),
+		zap.Strings("lookup_dirs", c.conf.LookupDirs),
 	)
 	h := zqd.NewHandler(core, c.logger)
 	if c.pprof {
 		h = pprofHandlers(h)
 	}
 	if c.prom {
-		h = prometheusHandlers(h)
+		h = prometheusHandlers(h, promreg)
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -129,8 +164,10 @@ func (c *Command) Run(args []string) error {
 		c.logger.Info("Signal received", zap.Stringer("signal", sig))
 		cancel()
 	}()
-	srv := httpd.New(c.listenAddr, h)
-	srv.SetLogger(c.logger.Named("httpd"))
+	srv, err := c.newServer(h)
+	if err != nil {
+		return err
+	}
 	if err := srv.Start(ctx); err != nil {
 		return err
 	}
@@ -142,6 +179,32 @@ func (c *Command) Run(args []string) error {
 	return srv.Wait()
 }
 
+func (c *Command) newServer(h http.Handler) (*httpd.Server, error) {
+	var srv *httpd.Server
+	if c.unixSocket != "" {
+		if c.portFile != "" {
+			return nil, errors.New("flag -portfile cannot be used with -unix")
+		}
+		srv = httpd.NewUnix(c.unixSocket, h)
+	} else {
+		srv = httpd.New(c.listenAddr, h)
+	}
+	srv.SetLogger(c.logger.Named("httpd"))
+	switch {
+	case c.tlsCertFile != "" && c.tlsKeyFile != "":
+		conf, err := httpd.TLSConfig(c.tlsCertFile, c.tlsKeyFile, c.tlsClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		srv.SetTLSConfig(conf)
+	case c.tlsCertFile != "" || c.tlsKeyFile != "":
+		return nil, errors.New("flags -tlscert and -tlskey must be used together")
+	case c.tlsClientCAFile != "":
+		return nil, errors.New("flag -tlsclientca requires -tlscert and -tlskey")
+	}
+	return srv, nil
+}
+
 func (c *Command) init() error {
 	if err := c.loadConfigFile(); err != nil {
 		return err
@@ -149,12 +212,42 @@ func (c *Command) init() error {
 	if err := c.initLogger(); err != nil {
 		return err
 	}
+	if err := c.initWorkers(); err != nil {
+		return err
+	}
 	if err := c.initZeek(); err != nil {
 		return err
 	}
 	return c.initSuricata()
 }
 
+func (c *Command) initWorkers() error {
+	if c.workers == "" {
+		return nil
+	}
+	c.conf.Workers = strings.Split(c.workers, ",")
+	c.conf.WorkerToken = c.workerToken
+	if c.conf.WorkerToken == "" {
+		c.conf.WorkerToken = os.Getenv("ZQD_WORKER_TOKEN")
+	}
+	if c.workerTLSCAFile != "" || c.workerTLSCertFile != "" || c.workerTLSKeyFile != "" {
+		conf, err := api.ClientTLSConfig(c.workerTLSCAFile, c.workerTLSCertFile, c.workerTLSKeyFile)
+		if err != nil {
+			return err
+		}
+		c.conf.WorkerTLS = conf
+	}
+	return nil
+}
+
+func (c *Command) analyzerNames() []string {
+	var names []string
+	for _, a := range c.conf.Analyzers {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
 func (c *Command) watchBrimFd(ctx context.Context) (context.Context, error) {
 	if runtime.GOOS == "windows" {
 		return nil, errors.New("flag -brimfd not applicable to windows")
@@ -181,14 +274,9 @@ func pprofHandlers(h http.Handler) http.Handler {
 	return mux
 }
 
-// XXX Eventually this function should take prometheus.Registry as an argument.
-// For now since we only care about retrieving go stats, create registry
-// here.
-func prometheusHandlers(h http.Handler) http.Handler {
+func prometheusHandlers(h http.Handler, promreg *prometheus.Registry) http.Handler {
 	mux := http.NewServeMux()
 	mux.Handle("/", h)
-	promreg := prometheus.NewRegistry()
-	promreg.MustRegister(prometheus.NewGoCollector())
 	promhandler := promhttp.HandlerFor(promreg, promhttp.HandlerOpts{})
 	mux.Handle("/metrics", promhandler)
 	return mux
@@ -203,14 +291,90 @@ func prometheusHandlers(h http.Handler) http.Handler {
 //     level: info
 //     mode: truncate
 // sort_mem_max_bytes: 268432640
+// search_limits:
+//   timeout: 5m
+//   max_records_read: 100000000
+//   max_output_bytes: 1073741824
+//   groupby_limit: 1000000
+// max_searches: 8
+// max_queued_searches: 32
+// auth:
+//   tokens:
+//   - user: alice
+//     token: 8f2b1e0c7d
+//     permissions:
+//       "*": admin
+//   - user: bob
+//     token: 3c9a4d5e6f
+//     permissions:
+//       sp_1g2Vz0eB2kIaUbW4xKfJqAVEPwu: read
+//   jwt:
+//     secret: 0123456789abcdef
+//     issuer: https://auth.example.com
+// pcap_analyzers:
+// - name: p0f
+//   cmd: /usr/local/bin/p0frunner
+//   args: ["-j"]
+//   format: ndjson
+//   glob: "*.json"
+//   zql: "rename ts=timestamp"
+// lookup_dirs:
+// - /var/lib/zqd/lookup
+
+type searchLimitsConfig struct {
+	Timeout        time.Duration `yaml:"timeout"`
+	MaxRecordsRead int64         `yaml:"max_records_read"`
+	MaxOutputBytes int64         `yaml:"max_output_bytes"`
+	GroupByLimit   int64         `yaml:"groupby_limit"`
+}
+
+// pcapAnalyzerConfig describes a pcap analyzer: a command that reads a
+// pcap on stdin and writes logs of the given format to its working
+// directory.
+type pcapAnalyzerConfig struct {
+	Name   string   `yaml:"name"`
+	Cmd    string   `yaml:"cmd"`
+	Args   []string `yaml:"args,omitempty"`
+	Format string   `yaml:"format,omitempty"`
+	Glob   string   `yaml:"glob,omitempty"`
+	ZQL    string   `yaml:"zql,omitempty"`
+}
+
+func (p pcapAnalyzerConfig) analyzer() (pcapanalyzer.Analyzer, error) {
+	if p.Cmd == "" {
+		return pcapanalyzer.Analyzer{}, fmt.Errorf("pcap analyzer %q: no cmd", p.Name)
+	}
+	ln, err := pcapanalyzer.LauncherFromCommand(p.Cmd, p.Args...)
+	if err != nil {
+		return pcapanalyzer.Analyzer{}, err
+	}
+	a := pcapanalyzer.Analyzer{
+		Name:     p.Name,
+		Launcher: ln,
+		Format:   p.Format,
+		Glob:     p.Glob,
+	}
+	if p.ZQL != "" {
+		if a.Proc, err = zql.ParseProc(p.ZQL); err != nil {
+			return pcapanalyzer.Analyzer{}, fmt.Errorf("pcap analyzer %q: %w", p.Name, err)
+		}
+	}
+	return a, nil
+}
 
 func (c *Command) loadConfigFile() error {
 	if c.configfile == "" {
 		return nil
 	}
 	conf := &struct {
-		Logger          logger.Config `yaml:"logger"`
-		SortMemMaxBytes *int          `yaml:"sort_mem_max_bytes,omitempty"`
+		Logger            logger.Config        `yaml:"logger"`
+		SortMemMaxBytes   *int                 `yaml:"sort_mem_max_bytes,omitempty"`
+		SearchLimits      *searchLimitsConfig  `yaml:"search_limits,omitempty"`
+		MaxSearches       int                  `yaml:"max_searches,omitempty"`
+		MaxQueuedSearches int                  `yaml:"max_queued_searches,omitempty"`
+		Auth              *auth.Config         `yaml:"auth,omitempty"`
+		PcapAnalyzers     []pcapAnalyzerConfig `yaml:"pcap_analyzers,omitempty"`
+		LookupDirs        []string             `yaml:"lookup_dirs,omitempty"`
 	}{}
 	b, err := ioutil.ReadFile(c.configfile)
 	if err != nil {
@@ -224,6 +388,39 @@ func (c *Command) loadConfigFile() error {
 		}
 		sort.MemMaxBytes = *v
 	}
+	if v := conf.SearchLimits; v != nil {
+		c.conf.SearchLimits = api.SearchLimits{
+			Timeout:        int64(v.Timeout),
+			MaxRecordsRead: v.MaxRecordsRead,
+			MaxOutputBytes: v.MaxOutputBytes,
+			GroupByLimit:   v.GroupByLimit,
+		}
+	}
+	if conf.MaxSearches < 0 || conf.MaxQueuedSearches < 0 {
+		return fmt.Errorf("%s: max_searches and max_queued_searches must not be negative", c.configfile)
+	}
+	c.conf.MaxSearches = conf.MaxSearches
+	c.conf.MaxQueuedSearches = conf.MaxQueuedSearches
+	c.conf.LookupDirs = conf.LookupDirs
+	if conf.Auth != nil && err == nil {
+		a, err := auth.New(*conf.Auth)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.configfile, err)
+		}
+		c.conf.Auth = a
+	}
+	if err == nil {
+		for _, p := range conf.PcapAnalyzers {
+			a, err := p.analyzer()
+			if err != nil {
+				return fmt.Errorf("%s: %w", c.configfile, err)
+			}
+			c.conf.Analyzers = append(c.conf.Analyzers, a)
+		}
+		if err := pcapanalyzer.CheckAnalyzers(c.conf.Analyzers); err != nil {
+			return fmt.Errorf("%s: %w", c.configfile, err)
+		}
+	}
 
 	return err
 }
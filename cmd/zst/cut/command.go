@@ -16,11 +16,13 @@ import (
 
 var Cut = &charm.Spec{
 	Name:  "cut",
-	Usage: "cut [flags] -k field-expr path",
-	Short: "cut a column from a zst file",
+	Usage: "cut [flags] -k field-expr[,field-expr...] path",
+	Short: "cut columns from a zst file",
 	Long: `
-The cut command cuts a single column from a zst file and writes the column
-to the output in the format of choice.
+The cut command cuts one or more columns from a zst file and writes the
+columns to the output in the format of choice.  The -k flag takes a
+comma-separated list of dotted field expressions.  Records having none of
+the fields are skipped.
 
 This command is most useful for test, debug, and demo, as more efficient
 and complete "cuts" on zst files will eventually be available from zq
@@ -46,7 +48,7 @@ type Command struct {
 
 func newCommand(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*root.Command)}
-	f.StringVar(&c.fieldExpr, "k", "", "dotted field expression of field to cut")
+	f.StringVar(&c.fieldExpr, "k", "", "comma-separated dotted field expressions of fields to cut")
 	c.outputFlags.SetFlags(f)
 	return c, nil
 }
@@ -62,7 +64,7 @@ func (c *Command) Run(args []string) error {
 	if c.fieldExpr == "" {
 		return errors.New("zst cut: must specify field to cut with -k")
 	}
-	fields := strings.Split(c.fieldExpr, ".")
+	fields := strings.Split(c.fieldExpr, ",")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	path := args[0]
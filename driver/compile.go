@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/brimsec/zq/ast"
@@ -25,6 +27,8 @@ type Config struct {
 	Span              nano.Span
 	StatsTick         <-chan time.Time
 	Warnings          chan string
+	SortMemMaxBytes   int
+	GroupByLimit      int
 }
 
 func compile(ctx context.Context, program ast.Proc, zctx *resolver.Context, msrc MultiSource, mcfg MultiConfig) (*muxOutput, error) {
@@ -49,6 +53,7 @@ func compile(ctx context.Context, program ast.Proc, zctx *resolver.Context, msrc
 	}
 	var filterExpr ast.BooleanExpr
 	filterExpr, program = liftFilter(program)
+	fields := sourceFields(filterExpr, program, sortKey)
 
 	var isParallel bool
 	if mcfg.Parallelism > 1 {
@@ -63,8 +68,11 @@ func compile(ctx context.Context, program ast.Proc, zctx *resolver.Context, msrc
 		TypeContext: zctx,
 		Logger:      mcfg.Logger,
 		Warnings:    mcfg.Warnings,
+
+		SortMemMaxBytes: mcfg.SortMemMaxBytes,
+		GroupByLimit:    mcfg.GroupByLimit,
 	}
-	sources, pgroup, err := createParallelGroup(pctx, filterExpr, msrc, mcfg)
+	sources, pgroup, err := createParallelGroup(pctx, filterExpr, fields, msrc, mcfg)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +84,35 @@ func compile(ctx context.Context, program ast.Proc, zctx *resolver.Context, msrc
 	return newMuxOutput(pctx, leaves, pgroup), nil
 }
 
+// sourceFields returns the sorted names of the fields that a source must
+// provide for the lifted filter and the rest of the flowgraph to produce
+// their output, including the source's sort key, or nil if all fields are
+// needed.
+func sourceFields(filterExpr ast.BooleanExpr, program ast.Proc, sortKey string) []string {
+	colset := computeColumns(program)
+	if colset == nil {
+		return nil
+	}
+	if filterExpr != nil {
+		fields := booleanExpressionFields(filterExpr)
+		if fields == nil {
+			return nil
+		}
+		for _, field := range fields {
+			colset[field] = struct{}{}
+		}
+	}
+	if sortKey != "" {
+		colset[sortKey] = struct{}{}
+	}
+	fields := make([]string, 0, len(colset))
+	for field := range colset {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
 func ensureSequentialProc(p ast.Proc) *ast.SequentialProc {
 	if p, ok := p.(*ast.SequentialProc); ok {
 		return p
@@ -187,7 +224,7 @@ func setGroupByProcInputSortDir(p ast.Proc, inputSortField string, inputSortDir
 		return false
 	case *ast.PutProc:
 		for _, c := range p.Clauses {
-			if c.Target == inputSortField {
+			if overlaps(c.Target, inputSortField) {
 				return false
 			}
 		}
@@ -214,6 +251,9 @@ func expressionFields(e ast.Expression) []string {
 	case *ast.UnaryExpression:
 		return expressionFields(e.Operand)
 	case *ast.BinaryExpression:
+		if field, ok := dottedField(e); ok {
+			return []string{field}
+		}
 		return append(expressionFields(e.LHS), expressionFields(e.RHS)...)
 	case *ast.ConditionalExpression:
 		fields := expressionFields(e.Condition)
@@ -228,6 +268,18 @@ func expressionFields(e ast.Expression) []string {
 		return fields
 	case *ast.CastExpression:
 		return expressionFields(e.Expr)
+	case *ast.RecordExpression:
+		fields := []string{}
+		for _, f := range e.Fields {
+			fields = append(fields, expressionFields(f.Expr)...)
+		}
+		return fields
+	case *ast.ArrayExpression:
+		fields := []string{}
+		for _, elem := range e.Exprs {
+			fields = append(fields, expressionFields(elem)...)
+		}
+		return fields
 	case *ast.Literal:
 		return []string{}
 	case *ast.Field:
@@ -237,6 +289,36 @@ func expressionFields(e ast.Expression) []string {
 	}
 }
 
+// overlaps returns true if an assignment to the dotted field target changes
+// the value of field, i.e., if the two are the same or one is nested within
+// the other.
+func overlaps(target, field string) bool {
+	return target == field || strings.HasPrefix(field, target+".") || strings.HasPrefix(target, field+".")
+}
+
+// dottedField returns the dotted name of the field referenced by e if e is a
+// field or a chain of "." expressions on fields, as in "id.orig_h".
+func dottedField(e ast.Expression) (string, bool) {
+	switch e := e.(type) {
+	case *ast.Field:
+		return e.Field, true
+	case *ast.BinaryExpression:
+		if e.Operator != "." {
+			return "", false
+		}
+		lhs, ok := dottedField(e.LHS)
+		if !ok {
+			return "", false
+		}
+		rhs, ok := e.RHS.(*ast.Field)
+		if !ok {
+			return "", false
+		}
+		return lhs + "." + rhs.Field, true
+	}
+	return "", false
+}
+
 // booleanExpressionFields returns a slice with all fields referenced
 // in a boolean expression. Fields will be repeated if they appear
 // repeatedly.  If all fields are referenced, nil is returned.
@@ -266,6 +348,8 @@ func booleanExpressionFields(e ast.BooleanExpr) []string {
 		return nil
 	case *ast.CompareField:
 		return expressionFields(e.Field)
+	case *ast.BinaryExpression:
+		return expressionFields(e)
 	default:
 		panic("boolean expression type not handled")
 	}
@@ -281,7 +365,11 @@ func booleanExpressionFields(e ast.BooleanExpr) []string {
 // of the columns to be read at the source. If the return value is a
 // nil map, all columns must be read.
 func computeColumns(p ast.Proc) map[string]struct{} {
-	cols, _ := computeColumnsR(p, map[string]struct{}{})
+	cols, done := computeColumnsR(p, map[string]struct{}{})
+	if !done {
+		// The flowgraph ended without a boundary proc.
+		return nil
+	}
 	return cols
 }
 
@@ -308,13 +396,17 @@ func computeColumnsR(p ast.Proc, colset map[string]struct{}) (map[string]struct{
 			return colset, false
 		}
 		for _, f := range p.Fields {
-			colset[ast.FieldExprToString(f.Source)] = struct{}{}
+			for _, field := range expressionFields(f.Source) {
+				colset[field] = struct{}{}
+			}
 		}
 		return colset, true
 	case *ast.GroupByProc:
 		for _, r := range p.Reducers {
 			if r.Field != nil {
-				colset[ast.FieldExprToString(r.Field)] = struct{}{}
+				for _, field := range expressionFields(r.Field) {
+					colset[field] = struct{}{}
+				}
 			}
 		}
 		for _, key := range p.Keys {
@@ -358,6 +450,11 @@ func computeColumnsR(p ast.Proc, colset map[string]struct{}) (map[string]struct{
 			}
 		}
 		return colset, false
+	case *ast.LookupProc:
+		for _, field := range expressionFields(p.Key) {
+			colset[field] = struct{}{}
+		}
+		return colset, false
 	case *ast.RenameProc:
 		for _, f := range p.Fields {
 			colset[ast.FieldExprToString(f.Source)] = struct{}{}
@@ -369,12 +466,15 @@ func computeColumnsR(p ast.Proc, colset map[string]struct{}) (map[string]struct{
 			// be used.
 			return nil, true
 		}
-		for k := range p.Fields {
-			colset[ast.FieldExprToString(p.Fields[k])] = struct{}{}
+		for _, e := range p.Fields {
+			for _, field := range expressionFields(e) {
+				colset[field] = struct{}{}
+			}
 		}
 		return colset, false
 	default:
-		panic("proc type not handled")
+		// Assume procs not handled above need all columns.
+		return nil, true
 	}
 }
 
@@ -421,7 +521,7 @@ func buildSplitFlowgraph(branch, tail []ast.Proc, mergeField string, reverse boo
 
 func decomposable(rs []ast.Reducer) bool {
 	for _, r := range rs {
-		cr, err := rcompile.Compile(r)
+		cr, err := rcompile.Compile(resolver.NewContext(), r)
 		if err != nil {
 			return false
 		}
@@ -483,7 +583,7 @@ func parallelizeFlowgraph(seq *ast.SequentialProc, N int, inputSortField string,
 				continue
 			}
 			for _, c := range p.Clauses {
-				if c.Target == inputSortField {
+				if overlaps(c.Target, inputSortField) {
 					return buildSplitFlowgraph(seq.Procs[0:i], seq.Procs[i:], inputSortField, inputSortReversed, N), true
 				}
 			}
@@ -534,7 +634,7 @@ func parallelizeFlowgraph(seq *ast.SequentialProc, N int, inputSortField string,
 			}
 			// put one head/tail on each parallel branch and one after the merge.
 			return buildSplitFlowgraph(seq.Procs[0:i+1], seq.Procs[i:], inputSortField, inputSortReversed, N), true
-		case *ast.UniqProc, *ast.FuseProc:
+		case *ast.UniqProc, *ast.FuseProc, *ast.LookupProc, *ast.WindowProc:
 			if inputSortField == "" {
 				// Unknown order: we can't parallelize because we can't maintain this unknown order at the merge point.
 				return seq, false
@@ -70,6 +70,22 @@ func TestComputeColumns(t *testing.T) {
 			"*>1 | every 1s count(y) by foo=String.replace(x, y, z) | (head 1; tail 1)",
 			nil,
 		},
+		{
+			"head 1",
+			nil,
+		},
+		{
+			"count() by id.orig_h | sort -r count",
+			[]string{"id.orig_h"},
+		},
+		{
+			"sort a.b | cut a, c",
+			[]string{"a", "a.b", "c"},
+		},
+		{
+			"top 1 x",
+			nil,
+		},
 	}
 
 	for _, tc := range tests {
@@ -137,6 +153,14 @@ func TestExpressionFields(t *testing.T) {
 			"x = 0 ? y : z",
 			[]string{"x", "y", "z"},
 		},
+		{
+			"id.orig_h",
+			[]string{"id.orig_h"},
+		},
+		{
+			"a[b].c",
+			[]string{"a", "b", "c"},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.expr, func(t *testing.T) {
@@ -49,7 +49,11 @@ type ScannerCloser interface {
 type SourceFilter struct {
 	Filter     filter.Filter
 	FilterExpr ast.BooleanExpr
-	Span       nano.Span
+	// Fields, if non-nil, lists the only fields of each record needed
+	// to run the query. A MultiSource may use it to avoid reading other
+	// fields, e.g., from a columnar format.
+	Fields []string
+	Span   nano.Span
 }
 
 type MultiConfig struct {
@@ -59,6 +63,10 @@ type MultiConfig struct {
 	Span        nano.Span
 	StatsTick   <-chan time.Time
 	Warnings    chan string
+	// SortMemMaxBytes and GroupByLimit bound the memory used by the sort
+	// and groupby procs.  See proc.Context.
+	SortMemMaxBytes int
+	GroupByLimit    int
 }
 
 type oneSource struct {
@@ -106,6 +114,9 @@ func rdrToMulti(r zbuf.Reader, cfg Config) (MultiSource, MultiConfig) {
 		Span:        cfg.Span,
 		StatsTick:   cfg.StatsTick,
 		Warnings:    cfg.Warnings,
+
+		SortMemMaxBytes: cfg.SortMemMaxBytes,
+		GroupByLimit:    cfg.GroupByLimit,
 	}
 	return msrc, mcfg
 }
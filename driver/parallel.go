@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type parallelHead struct {
@@ -147,7 +148,7 @@ func newCompareFn(field string, reversed bool) (zbuf.RecordCmpFn, error) {
 		Node:  ast.Node{Op: "Field"},
 		Field: field,
 	}
-	res, err := expr.CompileExpr(fieldRead)
+	res, err := expr.CompileExpr(resolver.NewContext(), fieldRead)
 	if err != nil {
 		return nil, err
 	}
@@ -157,11 +158,11 @@ func newCompareFn(field string, reversed bool) (zbuf.RecordCmpFn, error) {
 	}, nil
 }
 
-func createParallelGroup(pctx *proc.Context, filterExpr ast.BooleanExpr, msrc MultiSource, mcfg MultiConfig) ([]proc.Interface, *parallelGroup, error) {
+func createParallelGroup(pctx *proc.Context, filterExpr ast.BooleanExpr, fields []string, msrc MultiSource, mcfg MultiConfig) ([]proc.Interface, *parallelGroup, error) {
 	var filt filter.Filter
 	if filterExpr != nil {
 		var err error
-		if filt, err = filter.Compile(filterExpr); err != nil {
+		if filt, err = filter.Compile(pctx.TypeContext, filterExpr); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -170,6 +171,7 @@ func createParallelGroup(pctx *proc.Context, filterExpr ast.BooleanExpr, msrc Mu
 		filter: SourceFilter{
 			Filter:     filt,
 			FilterExpr: filterExpr,
+			Fields:     fields,
 			Span:       mcfg.Span,
 		},
 		msrc:       msrc,
@@ -0,0 +1,127 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+var ErrMixedTypes = errors.New("array elements have mixed types")
+
+// RecordExpr evaluates a record literal.  The type of the record is looked
+// up in the type context from the types of its field values, so it may
+// vary from record to record.
+type RecordExpr struct {
+	zctx    *resolver.Context
+	names   []string
+	exprs   []Evaluator
+	columns []zng.Column
+	typ     *zng.TypeRecord
+	bytes   zcode.Bytes
+}
+
+func compileRecordExpr(zctx *resolver.Context, node ast.RecordExpression) (Evaluator, error) {
+	if zctx == nil {
+		return nil, errors.New("record expression requires a type context")
+	}
+	names := make([]string, 0, len(node.Fields))
+	exprs := make([]Evaluator, 0, len(node.Fields))
+	seen := make(map[string]bool)
+	for _, f := range node.Fields {
+		if seen[f.Target] {
+			return nil, fmt.Errorf("record expression: duplicate field %q", f.Target)
+		}
+		seen[f.Target] = true
+		e, err := compileExpr(zctx, f.Expr, true)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, f.Target)
+		exprs = append(exprs, e)
+	}
+	return &RecordExpr{
+		zctx:    zctx,
+		names:   names,
+		exprs:   exprs,
+		columns: make([]zng.Column, len(names)),
+	}, nil
+}
+
+func (r *RecordExpr) Eval(rec *zng.Record) (zng.Value, error) {
+	var changed bool
+	b := r.bytes[:0]
+	for k, e := range r.exprs {
+		zv, err := e.Eval(rec)
+		if err != nil {
+			return zng.Value{}, err
+		}
+		typ := zv.Type
+		if typ == nil {
+			typ = zng.TypeNull
+		}
+		if r.columns[k].Type != typ {
+			r.columns[k] = zng.Column{Name: r.names[k], Type: typ}
+			changed = true
+		}
+		b = zcode.AppendAs(b, zng.IsContainerType(typ), zv.Bytes)
+	}
+	r.bytes = b
+	if changed {
+		typ, err := r.zctx.LookupTypeRecord(r.columns)
+		if err != nil {
+			return zng.Value{}, err
+		}
+		r.typ = typ
+	}
+	return zng.Value{r.typ, b}, nil
+}
+
+// ArrayExpr evaluates an array literal.  Its elements must have the same
+// type, except that any of them may be null.
+type ArrayExpr struct {
+	zctx  *resolver.Context
+	exprs []Evaluator
+	bytes zcode.Bytes
+}
+
+func compileArrayExpr(zctx *resolver.Context, node ast.ArrayExpression) (Evaluator, error) {
+	if zctx == nil {
+		return nil, errors.New("array expression requires a type context")
+	}
+	exprs, err := CompileExprs(zctx, node.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	return &ArrayExpr{
+		zctx:  zctx,
+		exprs: exprs,
+	}, nil
+}
+
+func (a *ArrayExpr) Eval(rec *zng.Record) (zng.Value, error) {
+	var inner zng.Type
+	b := a.bytes[:0]
+	for _, e := range a.exprs {
+		zv, err := e.Eval(rec)
+		if err != nil {
+			return zng.Value{}, err
+		}
+		if zv.Type != nil && zv.Type != zng.TypeNull {
+			if inner == nil {
+				inner = zv.Type
+			} else if inner != zv.Type {
+				return zng.Value{}, ErrMixedTypes
+			}
+		}
+		b = zcode.AppendAs(b, zng.IsContainerType(zv.Type), zv.Bytes)
+	}
+	a.bytes = b
+	if inner == nil {
+		inner = zng.TypeNull
+	}
+	return zng.Value{a.zctx.LookupTypeArray(inner), b}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/brimsec/zq/reglob"
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type Evaluator interface {
@@ -56,28 +57,28 @@ var ErrBadCast = errors.New("bad cast")
 // TBD: string values and net.IP address do not need to be copied because they
 // are allocated by go libraries and temporary buffers are not used.  This will
 // change down the road when we implement no-allocation string and IP conversion.
-func CompileExpr(node ast.Expression) (Evaluator, error) {
-	return compileExpr(node, true)
+func CompileExpr(zctx *resolver.Context, node ast.Expression) (Evaluator, error) {
+	return compileExpr(zctx, node, true)
 }
 
-func compileExpr(node ast.Expression, root bool) (Evaluator, error) {
+func compileExpr(zctx *resolver.Context, node ast.Expression, root bool) (Evaluator, error) {
 	switch n := node.(type) {
 	case *ast.Literal:
 		return NewLiteral(*n)
 	case *ast.Field:
 		return newFieldNode(n.Field, nil, root), nil
 	case *ast.UnaryExpression:
-		return compileUnary(*n)
+		return compileUnary(zctx, *n)
 
 	case *ast.BinaryExpression:
 		if n.Operator == "." {
-			return compileDotExpr(n.LHS, n.RHS)
+			return compileDotExpr(zctx, n.LHS, n.RHS)
 		}
-		lhs, err := compileExpr(n.LHS, true)
+		lhs, err := compileExpr(zctx, n.LHS, true)
 		if err != nil {
 			return nil, err
 		}
-		rhs, err := compileExpr(n.RHS, true)
+		rhs, err := compileExpr(zctx, n.RHS, true)
 		if err != nil {
 			return nil, err
 		}
@@ -101,23 +102,29 @@ func compileExpr(node ast.Expression, root bool) (Evaluator, error) {
 		}
 
 	case *ast.ConditionalExpression:
-		return compileConditional(*n)
+		return compileConditional(zctx, *n)
 
 	case *ast.FunctionCall:
-		return compileCall(*n)
+		return compileCall(zctx, *n)
 
 	case *ast.CastExpression:
-		return compileCast(*n)
+		return compileCast(zctx, *n)
+
+	case *ast.RecordExpression:
+		return compileRecordExpr(zctx, *n)
+
+	case *ast.ArrayExpression:
+		return compileArrayExpr(zctx, *n)
 
 	default:
 		return nil, fmt.Errorf("invalid expression type %T", node)
 	}
 }
 
-func CompileExprs(nodes []ast.Expression) ([]Evaluator, error) {
+func CompileExprs(zctx *resolver.Context, nodes []ast.Expression) ([]Evaluator, error) {
 	var exprs []Evaluator
 	for k := range nodes {
-		e, err := compileExpr(nodes[k], true)
+		e, err := compileExpr(zctx, nodes[k], true)
 		if err != nil {
 			return nil, err
 		}
@@ -130,11 +137,11 @@ type Not struct {
 	expr Evaluator
 }
 
-func compileUnary(node ast.UnaryExpression) (Evaluator, error) {
+func compileUnary(zctx *resolver.Context, node ast.UnaryExpression) (Evaluator, error) {
 	if node.Operator != "!" {
 		return nil, fmt.Errorf("unknown unary operator %s\n", node.Operator)
 	}
-	e, err := compileExpr(node.Operand, true)
+	e, err := compileExpr(zctx, node.Operand, true)
 	if err != nil {
 		return nil, err
 	}
@@ -766,17 +773,17 @@ type Conditional struct {
 	elseExpr  Evaluator
 }
 
-func compileConditional(node ast.ConditionalExpression) (Evaluator, error) {
+func compileConditional(zctx *resolver.Context, node ast.ConditionalExpression) (Evaluator, error) {
 	var err error
-	predicate, err := compileExpr(node.Condition, true)
+	predicate, err := compileExpr(zctx, node.Condition, true)
 	if err != nil {
 		return nil, err
 	}
-	thenExpr, err := compileExpr(node.Then, true)
+	thenExpr, err := compileExpr(zctx, node.Then, true)
 	if err != nil {
 		return nil, err
 	}
-	elseExpr, err := compileExpr(node.Else, true)
+	elseExpr, err := compileExpr(zctx, node.Else, true)
 	if err != nil {
 		return nil, err
 	}
@@ -801,12 +808,12 @@ func (c *Conditional) Eval(rec *zng.Record) (zng.Value, error) {
 	return c.elseExpr.Eval(rec)
 }
 
-func compileDotExpr(lhs, rhs ast.Expression) (*FieldExpr, error) {
-	record, err := compileExpr(lhs, true)
+func compileDotExpr(zctx *resolver.Context, lhs, rhs ast.Expression) (*FieldExpr, error) {
+	record, err := compileExpr(zctx, lhs, true)
 	if err != nil {
 		return nil, err
 	}
-	field, err := compileExpr(rhs, false)
+	field, err := compileExpr(zctx, rhs, false)
 	if err != nil {
 		return nil, err
 	}
@@ -819,7 +826,7 @@ type Call struct {
 	args     *Args
 }
 
-func compileCall(node ast.FunctionCall) (Evaluator, error) {
+func compileCall(zctx *resolver.Context, node ast.FunctionCall) (Evaluator, error) {
 	fn, ok := allFns[node.Function]
 	if !ok {
 		return nil, fmt.Errorf("%s: %w", node.Function, ErrNoSuchFunction)
@@ -833,7 +840,7 @@ func compileCall(node ast.FunctionCall) (Evaluator, error) {
 	}
 	exprs := make([]Evaluator, 0, nargs)
 	for _, expr := range node.Args {
-		e, err := compileExpr(expr, true)
+		e, err := compileExpr(zctx, expr, true)
 		if err != nil {
 			return nil, err
 		}
@@ -858,8 +865,8 @@ func (c *Call) Eval(rec *zng.Record) (zng.Value, error) {
 	return c.function(c.args)
 }
 
-func compileCast(node ast.CastExpression) (Evaluator, error) {
-	expr, err := compileExpr(node.Expr, true)
+func compileCast(zctx *resolver.Context, node ast.CastExpression) (Evaluator, error) {
+	expr, err := compileExpr(zctx, node.Expr, true)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func compileExpr(s string) (expr.Evaluator, error) {
 		return nil, errors.New("expected Expression")
 	}
 
-	return expr.CompileExpr(node)
+	return expr.CompileExpr(resolver.NewContext(), node)
 }
 
 // Compile and evaluate a zql expression against a provided Record.
@@ -738,3 +738,31 @@ func a(t *testing.T) {
 	testSuccessful(t, "1589126400 :time", nil, ts)
 	testError(t, `"1234" :time`, nil, expr.ErrBadCast, "cannot cast string to time")
 }
+
+func TestContainerLiterals(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[x:int64,s:string,a:ip]
+0:[1;boo;10.1.1.1;]`)
+	require.NoError(t, err)
+
+	testContainer := func(e, typ, val string) {
+		t.Run(e, func(t *testing.T) {
+			result, err := evaluate(e, record)
+			require.NoError(t, err)
+			assert.Equal(t, typ, result.Type.String(), "result type is correct")
+			assert.Equal(t, val, result.Format(zng.OutFormatZNG), "result value is correct")
+		})
+	}
+	testContainer("{a: x, b: s}", "record[a:int64,b:string]", "[1;boo;]")
+	testContainer("{r: {a: a}, n: null}", "record[r:record[a:ip],n:null]", "[[10.1.1.1;];-;]")
+	testContainer("[x, x+1, null]", "array[int64]", "[1;2;-;]")
+	testContainer("[{a: x}, {a: 2}]", "array[record[a:int64]]", "[[1;];[2;];]")
+	testContainer("[null]", "array[null]", "[-;]")
+
+	testSuccessful(t, "len([s, s])", record, zint64(2))
+	testSuccessful(t, "{a: x, b: s}.b", record, zstring("boo"))
+
+	testError(t, `[x, s]`, record, expr.ErrMixedTypes, "array with mixed types")
+	_, err = compileExpr("{a: x, a: s}")
+	assert.Error(t, err, "record with duplicate field")
+}
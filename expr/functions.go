@@ -50,6 +50,8 @@ var allFns = map[string]struct {
 	"Math.pow":   {2, 2, mathPow},
 	"Math.sqrt":  {1, 1, mathSqrt},
 
+	"Net.mask": {2, 2, netMask},
+
 	"String.byteLen":     {1, 1, stringByteLen},
 	"String.formatFloat": {1, 1, stringFormatFloat},
 	"String.formatInt":   {1, 1, stringFormatInt},
@@ -428,6 +430,35 @@ func stringParseIp(args *Args) (zng.Value, error) {
 	return zng.Value{zng.TypeIP, zng.EncodeIP(a)}, nil
 }
 
+func netMask(args *Args) (zng.Value, error) {
+	zv := args.vals[0]
+	if zv.Type.ID() != zng.IdIP {
+		return err("Net.mask", ErrBadArgument)
+	}
+	bits, ok := CoerceToInt(args.vals[1])
+	if !ok {
+		return err("Net.mask", ErrBadArgument)
+	}
+	if zv.Bytes == nil {
+		return zng.Value{zng.TypeNet, nil}, nil
+	}
+	ip, e := zng.DecodeIP(zv.Bytes)
+	if e != nil {
+		return zng.Value{}, e
+	}
+	size := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		size = 32
+	}
+	if bits < 0 || bits > int64(size) {
+		return err("Net.mask", ErrBadArgument)
+	}
+	// XXX GC
+	mask := net.CIDRMask(int(bits), size)
+	return zng.Value{zng.TypeNet, zng.EncodeNet(&net.IPNet{IP: ip.Mask(mask), Mask: mask})}, nil
+}
+
 func isStringy(v zng.Value) bool {
 	return zng.IsStringy(v.Type.ID())
 }
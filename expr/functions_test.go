@@ -263,3 +263,14 @@ func TestTime(t *testing.T) {
 	testError(t, "Time.fromNanoseconds(123, 456)", nil, expr.ErrTooManyArgs, "Time.fromNanoseconds() with too many args")
 	testError(t, `Time.fromNanoseconds("1234")`, nil, expr.ErrBadArgument, "Time.fromNanoseconds() with wrong argument type")
 }
+
+func TestNetMask(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.1.0.0/16")
+	_, v6, _ := net.ParseCIDR("2001:db8::/32")
+	testSuccessful(t, "Net.mask(10.1.2.3, 16)", nil, zng.Value{zng.TypeNet, zng.EncodeNet(v4)})
+	testSuccessful(t, "Net.mask(2001:db8:1::1, 32)", nil, zng.Value{zng.TypeNet, zng.EncodeNet(v6)})
+
+	testError(t, "Net.mask(10.1.2.3)", nil, expr.ErrTooFewArgs, "Net.mask() with too few args")
+	testError(t, `Net.mask("10.1.2.3", 16)`, nil, expr.ErrBadArgument, "Net.mask() with non-ip arg")
+	testError(t, "Net.mask(10.1.2.3, 33)", nil, expr.ErrBadArgument, "Net.mask() with too many bits")
+}
@@ -94,12 +94,13 @@ func NewWriterWithContext(ctx context.Context, zctx *resolver.Context, path stri
 	if err != nil {
 		return nil, err
 	}
-	writer, err := iosrc.NewWriter(ctx, uri)
+	writer, err := newOutputWriter(ctx, uri)
 	if err != nil {
 		return nil, err
 	}
 	tmp, err := ioutil.TempDir("", "microindex-*")
 	if err != nil {
+		abortOutput(writer)
 		return nil, err
 	}
 	w := &Writer{
@@ -137,6 +138,10 @@ func (w *Writer) Abort() error {
 	// Delete the temp files comprising the index hierarchy.
 	defer os.RemoveAll(w.tmpdir)
 	err := w.closeTree()
+	if _, ok := w.iow.(iosrc.Replacer); ok {
+		abortOutput(w.iow)
+		return err
+	}
 	if closeErr := w.iow.Close(); err == nil {
 		err = closeErr
 	}
@@ -147,13 +152,36 @@ func (w *Writer) Abort() error {
 	return err
 }
 
+// newOutputWriter returns a writer for the microindex at uri.  If the
+// source for uri supports atomic replacement, the microindex appears at uri
+// only once it is completely written.
+func newOutputWriter(ctx context.Context, uri iosrc.URI) (io.WriteCloser, error) {
+	src, err := iosrc.GetSource(uri)
+	if err != nil {
+		return nil, err
+	}
+	if replacerAble, ok := src.(iosrc.ReplacerAble); ok {
+		return replacerAble.NewReplacer(ctx, uri)
+	}
+	return src.NewWriter(ctx, uri)
+}
+
+// abortOutput closes w without completing a replacement.
+func abortOutput(w io.WriteCloser) {
+	if r, ok := w.(iosrc.Replacer); ok {
+		r.Abort()
+		return
+	}
+	w.Close()
+}
+
 func (w *Writer) Close() error {
 	// No matter what, delete the temp files comprising the index hierarchy.
 	defer os.RemoveAll(w.tmpdir)
 	// First, close the parent if it exists (which will recursively close
 	// all the parents to the root) while leaving the base layer open.
 	if err := w.closeTree(); err != nil {
-		w.iow.Close()
+		abortOutput(w.iow)
 		return err
 	}
 	if w.writer == nil {
@@ -171,12 +199,13 @@ func (w *Writer) Close() error {
 	// to the base.  Note that sum of the sizes of the parents is much smaller
 	// than the base so this will go fast compared to the entire indexing job.
 	if err := w.writer.closeFrame(); err != nil {
+		abortOutput(w.iow)
 		return err
 	}
 	// The hierarchy is now flushed and closed.  Assemble the file into
 	// a single microindex and remove the temporary btree files.
 	if err := w.finalize(); err != nil {
-		w.writer.buffer.Close()
+		abortOutput(w.iow)
 		return err
 	}
 	// Finally, close the base layer.
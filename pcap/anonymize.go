@@ -0,0 +1,278 @@
+package pcap
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"net"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// AddrAnonymizer maps IP addresses to anonymous addresses such that two
+// addresses sharing a prefix of n bits are mapped to addresses that also
+// share a prefix of n bits, so the structure of the subnets in a capture
+// is preserved.  The mapping is determined by a key and is the same for
+// every AddrAnonymizer with the same key.
+type AddrAnonymizer struct {
+	key   []byte
+	cache map[string]net.IP
+}
+
+// NewAddrAnonymizer returns an AddrAnonymizer whose mapping is determined
+// by key.
+func NewAddrAnonymizer(key []byte) *AddrAnonymizer {
+	sum := sha256.Sum256(key)
+	return &AddrAnonymizer{
+		key:   sum[:],
+		cache: make(map[string]net.IP),
+	}
+}
+
+// Map returns the anonymous address of ip, which has the same length as ip.
+// A 4-byte IPv4 address is mapped to an IPv4 address and a 16-byte address,
+// including an IPv4-mapped IPv6 address, to a 16-byte address all of whose
+// bits are anonymized.
+func (a *AddrAnonymizer) Map(ip net.IP) net.IP {
+	if out, ok := a.cache[string(ip)]; ok {
+		return out
+	}
+	// Each bit of the output is the bit of the input flipped by a
+	// pseudo-random function of the bits preceding it, which preserves
+	// prefixes as in Crypto-PAn.
+	out := make(net.IP, len(ip))
+	prefix := make([]byte, len(ip)+1)
+	mac := hmac.New(sha256.New, a.key)
+	for i := 0; i < len(ip)*8; i++ {
+		byteno, mask := i/8, byte(0x80>>uint(i%8))
+		prefix[0] = byte(i)
+		mac.Reset()
+		mac.Write(prefix)
+		flip := mac.Sum(nil)[0] & 1
+		bit := ip[byteno] & mask
+		if flip != 0 {
+			bit ^= mask
+		}
+		out[byteno] |= bit
+		prefix[byteno+1] |= ip[byteno] & mask
+	}
+	a.cache[string(ip)] = out
+	return out
+}
+
+// AnonymizeOpts are the options of Anonymize.
+type AnonymizeOpts struct {
+	// Key determines the mapping of addresses.
+	Key []byte
+	// Snaplen, if positive, is the number of bytes of each packet kept.
+	Snaplen int
+}
+
+// Anonymize writes the packets read from r to w with their IPv4 and IPv6
+// addresses replaced by those of an AddrAnonymizer with key opts.Key.  The
+// IPv4 header checksum and the TCP, UDP, and ICMPv6 checksums are updated
+// to match the new addresses.  If opts.Snaplen is positive, packets are
+// then truncated to that many bytes, keeping their original lengths.
+// The sender and target protocol addresses of ARP packets are mapped too.
+// Other packets that are not IP, and the addresses held in the payload of
+// a packet (e.g., in an ICMP error, a DNS answer, or a tunnel), are not
+// changed.
+func Anonymize(ctx context.Context, w pcapio.Writer, r pcapio.Reader, opts AnonymizeOpts) error {
+	anon := NewAddrAnonymizer(opts.Key)
+	ifaces := make(map[layers.LinkType]int)
+	var data []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		block, typ, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if block == nil {
+			break
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		pkt, ts, linkType, err := r.Packet(block)
+		if pkt == nil {
+			return err
+		}
+		length, err := r.PacketLength(block)
+		if err != nil {
+			return err
+		}
+		// See mergeInput.next.
+		if length < len(pkt) {
+			length = len(pkt)
+		}
+		data = append(data[:0], pkt...)
+		anonymizePacket(anon, data, linkType)
+		if opts.Snaplen > 0 && len(data) > opts.Snaplen {
+			data = data[:opts.Snaplen]
+		}
+		ifno, ok := ifaces[linkType]
+		if !ok {
+			ifno, err = w.AddInterface(linkType, uint32(opts.Snaplen))
+			if err != nil {
+				return err
+			}
+			ifaces[linkType] = ifno
+		}
+		if err := w.WritePacket(ifno, ts, data, length); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// anonymizePacket rewrites the addresses of the IP header or ARP message of
+// data in place and updates the checksums that cover them.
+func anonymizePacket(anon *AddrAnonymizer, data []byte, linkType layers.LinkType) {
+	packet := gopacket.NewPacket(data, linkType, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	var netOff, transportOff int
+	var network, transport gopacket.Layer
+	off := 0
+loop:
+	for _, l := range packet.Layers() {
+		switch l := l.(type) {
+		case *layers.ARP:
+			anonymizeARP(anon, data[off:], l)
+			return
+		case *layers.IPv4, *layers.IPv6:
+			if network != nil {
+				// The addresses of a tunneled packet are left
+				// as they are, so stop at its header.
+				break loop
+			}
+			network, netOff = l, off
+		case *layers.TCP, *layers.UDP, *layers.ICMPv6:
+			if network != nil && transport == nil {
+				transport, transportOff = l, off
+			}
+		}
+		off += len(l.LayerContents())
+	}
+	if network == nil {
+		return
+	}
+	var addrs []byte
+	var end int
+	switch ip := network.(type) {
+	case *layers.IPv4:
+		hdr := data[netOff : netOff+int(ip.IHL)*4]
+		addrs = hdr[12:20]
+		end = netOff + int(ip.Length)
+		if ip.Flags&layers.IPv4MoreFragments != 0 {
+			// The segment continues in another fragment.
+			end = -1
+		}
+	case *layers.IPv6:
+		addrs = data[netOff+8 : netOff+40]
+		end = netOff + 40 + int(ip.Length)
+	}
+	old := append([]byte(nil), addrs...)
+	n := len(addrs) / 2
+	copy(addrs[:n], anon.Map(net.IP(addrs[:n])))
+	copy(addrs[n:], anon.Map(net.IP(addrs[n:])))
+	if ip, ok := network.(*layers.IPv4); ok {
+		hdr := data[netOff : netOff+int(ip.IHL)*4]
+		binary.BigEndian.PutUint16(hdr[10:12], 0)
+		binary.BigEndian.PutUint16(hdr[10:12], ^checksum(0, hdr))
+	}
+	if transport == nil {
+		return
+	}
+	var sumOff int
+	var proto byte
+	switch transport.(type) {
+	case *layers.TCP:
+		sumOff, proto = transportOff+16, byte(layers.IPProtocolTCP)
+	case *layers.UDP:
+		sumOff, proto = transportOff+6, byte(layers.IPProtocolUDP)
+	case *layers.ICMPv6:
+		sumOff, proto = transportOff+2, byte(layers.IPProtocolICMPv6)
+	}
+	if sumOff+2 > len(data) {
+		return
+	}
+	field := data[sumOff : sumOff+2]
+	if proto == byte(layers.IPProtocolUDP) && n == 4 && binary.BigEndian.Uint16(field) == 0 {
+		// A zero UDP checksum over IPv4 means there is none.
+		return
+	}
+	if end >= transportOff && end <= len(data) {
+		// The segment was captured in full so compute its checksum.
+		segment := data[transportOff:end]
+		binary.BigEndian.PutUint16(field, 0)
+		sum := checksum(0, addrs)
+		var pseudo [4]byte
+		binary.BigEndian.PutUint16(pseudo[:2], uint16(proto))
+		binary.BigEndian.PutUint16(pseudo[2:], uint16(len(segment)))
+		sum = checksum(sum, pseudo[:])
+		sum = ^checksum(sum, segment)
+		if sum == 0 && proto == byte(layers.IPProtocolUDP) {
+			sum = 0xffff
+		}
+		binary.BigEndian.PutUint16(field, sum)
+		return
+	}
+	// Otherwise, update the checksum for the change of addresses as
+	// in RFC 1624.
+	sum := ^binary.BigEndian.Uint16(field)
+	var neg [2]byte
+	for i := 0; i < len(old); i += 2 {
+		binary.BigEndian.PutUint16(neg[:], ^binary.BigEndian.Uint16(old[i:]))
+		sum = checksum(sum, neg[:])
+	}
+	sum = checksum(sum, addrs)
+	binary.BigEndian.PutUint16(field, ^sum)
+}
+
+// anonymizeARP rewrites the IPv4 or IPv6 sender and target protocol
+// addresses of arp, the ARP message at the start of data, in place.
+func anonymizeARP(anon *AddrAnonymizer, data []byte, arp *layers.ARP) {
+	if arp.Protocol != layers.EthernetTypeIPv4 && arp.Protocol != layers.EthernetTypeIPv6 {
+		return
+	}
+	hlen, plen := int(arp.HwAddressSize), int(arp.ProtAddressSize)
+	if plen != net.IPv4len && plen != net.IPv6len {
+		return
+	}
+	// The fixed header of 8 bytes is followed by the sender hardware
+	// and protocol addresses and then the target's.
+	for _, off := range []int{8 + hlen, 8 + 2*hlen + plen} {
+		if off+plen > len(data) {
+			return
+		}
+		addr := data[off : off+plen]
+		copy(addr, anon.Map(net.IP(addr)))
+	}
+}
+
+// checksum adds the 16-bit words of b to the ones' complement sum, sum,
+// and returns the result without complementing it.  Since b always has
+// an even length or ends the checksummed data, an odd final byte is
+// padded with zero.
+func checksum(sum uint16, b []byte) uint16 {
+	s := uint32(sum)
+	for len(b) >= 2 {
+		s += uint32(binary.BigEndian.Uint16(b))
+		b = b[2:]
+	}
+	if len(b) == 1 {
+		s += uint32(b[0]) << 8
+	}
+	for s > 0xffff {
+		s = (s & 0xffff) + s>>16
+	}
+	return uint16(s)
+}
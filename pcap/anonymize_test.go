@@ -0,0 +1,171 @@
+package pcap_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddrAnonymizer(t *testing.T) {
+	a := pcap.NewAddrAnonymizer([]byte("secret"))
+	ip4 := func(s string) net.IP { return net.ParseIP(s).To4() }
+	x := a.Map(ip4("10.1.2.3"))
+	y := a.Map(ip4("10.1.2.200"))
+	z := a.Map(ip4("192.168.0.1"))
+	require.Len(t, x, 4)
+	assert.NotEqual(t, ip4("10.1.2.3"), x)
+	// 10.1.2.3 and 10.1.2.200 share a 24-bit prefix.
+	assert.Equal(t, x[:3], y[:3])
+	assert.NotEqual(t, x[3], y[3])
+	// 10.1.2.3 and 192.168.0.1 differ in their first bit.
+	assert.NotEqual(t, x[0]&0x80, z[0]&0x80)
+	assert.Equal(t, x, pcap.NewAddrAnonymizer([]byte("secret")).Map(ip4("10.1.2.3")))
+	assert.NotEqual(t, x, pcap.NewAddrAnonymizer([]byte("other")).Map(ip4("10.1.2.3")))
+
+	v6 := a.Map(net.ParseIP("2001:db8::1"))
+	require.Len(t, v6, 16)
+	assert.Equal(t, v6[:8], a.Map(net.ParseIP("2001:db8::2"))[:8])
+
+	// An IPv4-mapped IPv6 address, as found in an IPv6 header, is mapped
+	// as a 16-byte address and keeps none of its IPv4 address.
+	mapped := net.ParseIP("::ffff:10.1.2.3")
+	require.Len(t, mapped, 16)
+	m := a.Map(mapped)
+	require.Len(t, m, 16)
+	assert.NotEqual(t, ip4("10.1.2.3"), m[12:])
+	assert.NotEqual(t, x, m[12:])
+	assert.Equal(t, m, a.Map(net.ParseIP("::ffff:10.1.2.3")))
+}
+
+type anonPacket struct {
+	data   []byte
+	length int
+}
+
+func anonymize(t *testing.T, segments []segment, opts pcap.AnonymizeOpts) []anonPacket {
+	r, err := pcapio.NewReader(bytes.NewReader(writePcap(t, segments)))
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, pcap.Anonymize(context.Background(), pcapio.NewPcapWriter(&buf), r, opts))
+	out, err := pcapio.NewReader(&buf)
+	require.NoError(t, err)
+	var packets []anonPacket
+	for {
+		block, typ, err := out.Read()
+		if err == io.EOF || block == nil {
+			return packets
+		}
+		require.NoError(t, err)
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		pkt, _, _, err := out.Packet(block)
+		require.NoError(t, err)
+		length, err := out.PacketLength(block)
+		require.NoError(t, err)
+		packets = append(packets, anonPacket{append([]byte(nil), pkt...), length})
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	segments := []segment{
+		{true, layers.TCP{SYN: true, Seq: 100}, ""},
+		{false, layers.TCP{SYN: true, ACK: true, Seq: 500, Ack: 101}, ""},
+		{true, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501}, "some secret payload"},
+	}
+	key := []byte("secret")
+	anon := pcap.NewAddrAnonymizer(key)
+	client, server := anon.Map(net.IPv4(10, 0, 0, 1).To4()), anon.Map(net.IPv4(10, 0, 0, 2).To4())
+	packets := anonymize(t, segments, pcap.AnonymizeOpts{Key: key})
+	require.Len(t, packets, 3)
+	for i, p := range packets {
+		packet := gopacket.NewPacket(p.data, layers.LinkTypeEthernet, gopacket.Default)
+		ip := packet.Layer(layers.LayerTypeIPv4).(*layers.IPv4)
+		tcp := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+		src, dst := client, server
+		if !segments[i].fromClient {
+			src, dst = server, client
+		}
+		assert.Equal(t, src, ip.SrcIP)
+		assert.Equal(t, dst, ip.DstIP)
+		assert.Equal(t, segments[i].payload, string(tcp.Payload))
+		// Serializing the decoded layers with computed checksums
+		// should reproduce the packet.
+		require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+		buf := gopacket.NewSerializeBuffer()
+		opts := gopacket.SerializeOptions{ComputeChecksums: true}
+		ether := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+		require.NoError(t, gopacket.SerializeLayers(buf, opts, ether, ip, tcp, gopacket.Payload(tcp.Payload)))
+		assert.Equal(t, p.data, buf.Bytes())
+	}
+
+	// A packet truncated to 54 bytes keeps its Ethernet, IP, and TCP
+	// headers, with the same checksums as in full, and its original
+	// length.
+	full := packets
+	packets = anonymize(t, segments, pcap.AnonymizeOpts{Key: key, Snaplen: 54})
+	require.Len(t, packets, 3)
+	assert.Equal(t, full[2].data[:54], packets[2].data)
+	assert.Len(t, packets[2].data, 54)
+	assert.Equal(t, 54+len(segments[2].payload), packets[2].length)
+	packet := gopacket.NewPacket(packets[2].data, layers.LinkTypeEthernet, gopacket.Default)
+	assert.Equal(t, client, packet.Layer(layers.LayerTypeIPv4).(*layers.IPv4).SrcIP)
+}
+
+func TestAnonymizeARP(t *testing.T) {
+	sender, target := net.IPv4(10, 0, 0, 1).To4(), net.IPv4(10, 0, 0, 2).To4()
+	mac := net.HardwareAddr{0, 1, 2, 3, 4, 5}
+	ether := &layers.Ethernet{SrcMAC: mac, DstMAC: layers.EthernetBroadcast, EthernetType: layers.EthernetTypeARP}
+	arp := &layers.ARP{
+		AddrType:          layers.LinkTypeEthernet,
+		Protocol:          layers.EthernetTypeIPv4,
+		HwAddressSize:     6,
+		ProtAddressSize:   4,
+		Operation:         layers.ARPRequest,
+		SourceHwAddress:   mac,
+		SourceProtAddress: sender,
+		DstHwAddress:      make([]byte, 6),
+		DstProtAddress:    target,
+	}
+	pkt := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(pkt, gopacket.SerializeOptions{}, ether, arp))
+	var in bytes.Buffer
+	w := pcapio.NewPcapWriter(&in)
+	ifno, err := w.AddInterface(layers.LinkTypeEthernet, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.WritePacket(ifno, 1e9, pkt.Bytes(), len(pkt.Bytes())))
+	require.NoError(t, w.Flush())
+
+	r, err := pcapio.NewReader(&in)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	key := []byte("secret")
+	require.NoError(t, pcap.Anonymize(context.Background(), pcapio.NewPcapWriter(&out), r, pcap.AnonymizeOpts{Key: key}))
+	r, err = pcapio.NewReader(&out)
+	require.NoError(t, err)
+	var data []byte
+	for data == nil {
+		block, typ, err := r.Read()
+		require.NoError(t, err)
+		require.NotNil(t, block)
+		if typ == pcapio.TypePacket {
+			data, _, _, err = r.Packet(block)
+			require.NoError(t, err)
+		}
+	}
+	packet := gopacket.NewPacket(data, layers.LinkTypeEthernet, gopacket.Default)
+	decoded := packet.Layer(layers.LayerTypeARP).(*layers.ARP)
+	anon := pcap.NewAddrAnonymizer(key)
+	assert.Equal(t, []byte(anon.Map(sender)), decoded.SourceProtAddress)
+	assert.Equal(t, []byte(anon.Map(target)), decoded.DstProtAddress)
+	assert.Equal(t, []byte(mac), decoded.SourceHwAddress)
+}
@@ -0,0 +1,52 @@
+// Package bpf compiles packet filter expressions written in the syntax of
+// tcpdump and libpcap (see pcap-filter(7)) into Go functions that match
+// decoded packets.  Unlike libpcap, it does not generate BPF bytecode and
+// does not depend on cgo.
+//
+// Filters are built from primitives such as "host 10.0.0.1",
+// "src net 192.168.0.0/16", "udp dst port 53", "portrange 6000-6010",
+// "ip proto \icmp", "vlan 100", and "less 128", combined with "and", "or",
+// "not", and parentheses.  Relations over packet bytes and lengths, such as
+// "tcp[tcpflags] & tcp-syn != 0" or "len > 1000", are also supported.  Host
+// names are not resolved, so hosts and networks must be given as addresses.
+package bpf
+
+import (
+	"github.com/google/gopacket"
+)
+
+// Filter reports whether a packet matches a filter expression.
+type Filter func(gopacket.Packet) bool
+
+// Compile compiles the filter expression expr.  An empty expression matches
+// every packet.
+func Compile(expr string) (Filter, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return func(gopacket.Packet) bool { return true }, nil
+	}
+	p := &parser{toks: toks}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return f, nil
+}
+
+func and(left, right Filter) Filter {
+	return func(p gopacket.Packet) bool { return left(p) && right(p) }
+}
+
+func or(left, right Filter) Filter {
+	return func(p gopacket.Packet) bool { return left(p) || right(p) }
+}
+
+func not(f Filter) Filter {
+	return func(p gopacket.Packet) bool { return !f(p) }
+}
@@ -0,0 +1,162 @@
+package bpf
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	macA = net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	macB = net.HardwareAddr{0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb}
+)
+
+func newPacket(t *testing.T, ls ...gopacket.SerializableLayer) gopacket.Packet {
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	for _, l := range ls {
+		if tl, ok := l.(interface {
+			SetNetworkLayerForChecksum(gopacket.NetworkLayer) error
+		}); ok {
+			for _, nl := range ls {
+				if nl, ok := nl.(gopacket.NetworkLayer); ok {
+					require.NoError(t, tl.SetNetworkLayerForChecksum(nl))
+				}
+			}
+		}
+	}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, ls...))
+	return gopacket.NewPacket(buf.Bytes(), layers.LinkTypeEthernet, gopacket.Default)
+}
+
+func testPackets(t *testing.T) map[string]gopacket.Packet {
+	ether := func(typ layers.EthernetType) *layers.Ethernet {
+		return &layers.Ethernet{SrcMAC: macA, DstMAC: macB, EthernetType: typ}
+	}
+	ip4 := func(src, dst string, proto layers.IPProtocol) *layers.IPv4 {
+		return &layers.IPv4{Version: 4, TTL: 64, Protocol: proto, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+	}
+	return map[string]gopacket.Packet{
+		"syn": newPacket(t,
+			ether(layers.EthernetTypeIPv4),
+			ip4("10.0.0.1", "192.168.1.10", layers.IPProtocolTCP),
+			&layers.TCP{SrcPort: 51000, DstPort: 443, SYN: true}),
+		"dns": newPacket(t,
+			ether(layers.EthernetTypeIPv4),
+			ip4("10.0.0.2", "8.8.8.8", layers.IPProtocolUDP),
+			&layers.UDP{SrcPort: 40000, DstPort: 53},
+			gopacket.Payload(make([]byte, 40))),
+		"dns6": newPacket(t,
+			ether(layers.EthernetTypeIPv6),
+			&layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP, SrcIP: net.ParseIP("fe80::1"), DstIP: net.ParseIP("2001:4860:4860::8888")},
+			&layers.UDP{SrcPort: 40001, DstPort: 53}),
+		"ping": newPacket(t,
+			ether(layers.EthernetTypeDot1Q),
+			&layers.Dot1Q{VLANIdentifier: 100, Type: layers.EthernetTypeIPv4},
+			ip4("10.0.0.1", "10.0.1.1", layers.IPProtocolICMPv4),
+			&layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)}),
+		"arp": newPacket(t,
+			&layers.Ethernet{SrcMAC: macA, DstMAC: layers.EthernetBroadcast, EthernetType: layers.EthernetTypeARP},
+			&layers.ARP{AddrType: layers.LinkTypeEthernet, Protocol: layers.EthernetTypeIPv4, HwAddressSize: 6, ProtAddressSize: 4, Operation: layers.ARPRequest,
+				SourceHwAddress: macA, SourceProtAddress: []byte{10, 0, 0, 1}, DstHwAddress: make([]byte, 6), DstProtAddress: []byte{10, 0, 0, 254}}),
+	}
+}
+
+func TestCompile(t *testing.T) {
+	pkts := testPackets(t)
+	cases := []struct {
+		expr    string
+		matches []string
+	}{
+		{"", []string{"arp", "dns", "dns6", "ping", "syn"}},
+		{"tcp", []string{"syn"}},
+		{"udp", []string{"dns", "dns6"}},
+		{"ip", []string{"dns", "ping", "syn"}},
+		{"ip6", []string{"dns6"}},
+		{"arp", []string{"arp"}},
+		{"icmp", []string{"ping"}},
+		{"host 10.0.0.1", []string{"arp", "ping", "syn"}},
+		{"ip host 10.0.0.1", []string{"ping", "syn"}},
+		{"src host 10.0.0.1", []string{"arp", "ping", "syn"}},
+		{"dst host 10.0.0.1", nil},
+		{"host 10.0.0.1 or 8.8.8.8", []string{"arp", "dns", "ping", "syn"}},
+		{"udp port 53 and dst host 8.8.8.8", []string{"dns"}},
+		{"udp and dst port domain", []string{"dns", "dns6"}},
+		{"host 2001:4860:4860::8888", []string{"dns6"}},
+		{"net 10.0.0.0/24", []string{"arp", "dns", "ping", "syn"}},
+		{"dst net 10.0.1", []string{"ping"}},
+		{"dst net 192.168.0.0 mask 255.255.0.0", []string{"syn"}},
+		{"src 10.0.0.0/8 and dst 10.0.0.0/8", []string{"arp", "ping"}},
+		{"src and dst net 10.0.0.0/8", []string{"arp", "ping"}},
+		{"fe80::/10", []string{"dns6"}},
+		{"port 443", []string{"syn"}},
+		{"tcp dst port 443", []string{"syn"}},
+		{"udp port 443", nil},
+		{"portrange 50000-52000", []string{"syn"}},
+		{"port 53 or 443", []string{"dns", "dns6", "syn"}},
+		{"not port 53", []string{"arp", "ping", "syn"}},
+		{"!(udp || tcp) && ip", []string{"ping"}},
+		{"vlan", []string{"ping"}},
+		{"vlan 100", []string{"ping"}},
+		{"vlan 200", nil},
+		{"ether host 00:11:22:33:44:55", []string{"arp", "dns", "dns6", "ping", "syn"}},
+		{"ether dst 66:77:88:99:aa:bb and not ip6", []string{"dns", "ping", "syn"}},
+		{"ether broadcast", []string{"arp"}},
+		{"ether proto \\arp", []string{"arp"}},
+		{"ip proto \\udp", []string{"dns"}},
+		{"proto 17", []string{"dns", "dns6"}},
+		{"ip6 proto udp", []string{"dns6"}},
+		{"tcp[tcpflags] & tcp-syn != 0", []string{"syn"}},
+		{"tcp[13] & (tcp-syn|tcp-ack) == tcp-syn", []string{"syn"}},
+		{"ip[9] = 17", []string{"dns"}},
+		{"ip[16:4] = 0x08080808", []string{"dns"}},
+		{"icmp[icmptype] = icmp-echo", []string{"ping"}},
+		{"udp[2:2] = 53 and ip6", []string{"dns6"}},
+		{"ether[12:2] = 0x8100", []string{"ping"}},
+		{"len > 70", []string{"dns"}},
+		{"(len - 14) * 2 > 130", []string{"dns"}},
+		{"greater 80", []string{"dns"}},
+		{"less 60", []string{"arp", "ping", "syn"}},
+		{"(tcp or icmp) and host 10.0.0.1", []string{"ping", "syn"}},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			f, err := Compile(c.expr)
+			require.NoError(t, err)
+			var matches []string
+			for _, name := range []string{"arp", "dns", "dns6", "ping", "syn"} {
+				if f(pkts[name]) {
+					matches = append(matches, name)
+				}
+			}
+			assert.Equal(t, c.matches, matches)
+		})
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	cases := []struct {
+		expr string
+		err  string
+	}{
+		{"host", "expected address or number at end of filter"},
+		{"host example.com", `invalid host address "example.com" at position 5`},
+		{"tcp host 10.0.0.1", `"tcp" qualifier cannot be applied to host at position 9`},
+		{"port 53 and", "expected filter primitive at end of filter"},
+		{"(tcp or udp", `expected ")" at end of filter`},
+		{"tcp udp", `unexpected "udp" at position 4`},
+		{"ip[1:3] = 0", "accessor size must be 1, 2, or 4 at position 5"},
+		{"host 10.0.0.1 $", `illegal character '$' at position 14`},
+		{"vlan 5000", `invalid VLAN ID "5000" at position 5`},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			_, err := Compile(c.expr)
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package bpf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// token is a lexical token of a filter expression.  Words hold identifiers,
+// keywords, numbers, and addresses.  All other tokens are operators and
+// punctuation.
+type token struct {
+	word bool
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	return t.text
+}
+
+var operators = []string{
+	"&&", "||", "==", "!=", "<=", ">=", "<<", ">>",
+	"!", "=", "<", ">", "&", "|", "^", "+", "-", "*", "/", "%",
+	"(", ")", "[", "]", ":",
+}
+
+func isWordChar(c byte, brackets int) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	case c == '_', c == '.', c == '-':
+		return true
+	case c == ':':
+		// Colons appear in IPv6 and MAC addresses but separate the
+		// offset and size of a packet accessor.
+		return brackets == 0
+	}
+	return false
+}
+
+func isWordStart(c byte, brackets int) bool {
+	return c != '-' && isWordChar(c, brackets)
+}
+
+// lex splits a filter expression into tokens.
+func lex(s string) ([]token, error) {
+	var toks []token
+	var brackets int
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			i++
+			continue
+		}
+		// A backslash escapes a keyword used as a protocol name, as in
+		// "ip proto \tcp".
+		start := i
+		if c == '\\' && i+1 < len(s) && isWordStart(s[i+1], brackets) {
+			i++
+			start = i
+		}
+		if isWordStart(s[i], brackets) {
+			for i < len(s) && isWordChar(s[i], brackets) {
+				i++
+			}
+			// Include the prefix length of an address, as in 10.0.0.0/8.
+			if i+1 < len(s) && s[i] == '/' && s[i+1] >= '0' && s[i+1] <= '9' && strings.ContainsAny(s[start:i], ".:") {
+				i++
+				for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+					i++
+				}
+			}
+			toks = append(toks, token{word: true, text: s[start:i], pos: start})
+			continue
+		}
+		op := matchOperator(s[i:])
+		if op == "" {
+			return nil, fmt.Errorf("illegal character %q at position %d", c, i)
+		}
+		switch op {
+		case "[":
+			brackets++
+		case "]":
+			brackets--
+		}
+		toks = append(toks, token{text: op, pos: i})
+		i += len(op)
+	}
+	return toks, nil
+}
+
+func matchOperator(s string) string {
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return ""
+}
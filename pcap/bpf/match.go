@@ -0,0 +1,393 @@
+package bpf
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// accessorLayers maps the protocol names that may be used in packet
+// accessors to their layer types.  The link layer is the start of the
+// packet.
+var accessorLayers = map[string]gopacket.LayerType{
+	"ether": gopacket.LayerTypeZero,
+	"link":  gopacket.LayerTypeZero,
+	"ip":    layers.LayerTypeIPv4,
+	"ip6":   layers.LayerTypeIPv6,
+	"arp":   layers.LayerTypeARP,
+	"tcp":   layers.LayerTypeTCP,
+	"udp":   layers.LayerTypeUDP,
+	"icmp":  layers.LayerTypeICMPv4,
+	"icmp6": layers.LayerTypeICMPv6,
+	"sctp":  layers.LayerTypeSCTP,
+}
+
+// constants are the named offsets and values that may be used in
+// arithmetic expressions.
+var constants = map[string]uint32{
+	"tcpflags":           13,
+	"tcp-fin":            0x01,
+	"tcp-syn":            0x02,
+	"tcp-rst":            0x04,
+	"tcp-push":           0x08,
+	"tcp-ack":            0x10,
+	"tcp-urg":            0x20,
+	"tcp-ece":            0x40,
+	"tcp-cwr":            0x80,
+	"icmptype":           0,
+	"icmpcode":           1,
+	"icmp-echoreply":     0,
+	"icmp-unreach":       3,
+	"icmp-sourcequench":  4,
+	"icmp-redirect":      5,
+	"icmp-echo":          8,
+	"icmp-routeradvert":  9,
+	"icmp-routersolicit": 10,
+	"icmp-timxceed":      11,
+	"icmp-paramprob":     12,
+	"icmp-tstamp":        13,
+	"icmp-tstampreply":   14,
+	"icmp-ireq":          15,
+	"icmp-ireqreply":     16,
+	"icmp-maskreq":       17,
+	"icmp-maskreply":     18,
+	"icmp6type":          0,
+	"icmp6code":          1,
+	"icmp6-echo":         128,
+	"icmp6-echoreply":    129,
+}
+
+// ipProtocols maps the protocol names accepted by "ip proto" to IP
+// protocol numbers.
+var ipProtocols = map[string]uint32{
+	"icmp":  1,
+	"igmp":  2,
+	"tcp":   6,
+	"udp":   17,
+	"gre":   47,
+	"esp":   50,
+	"ah":    51,
+	"icmp6": 58,
+	"ospf":  89,
+	"pim":   103,
+	"vrrp":  112,
+	"sctp":  132,
+}
+
+// etherTypes maps the protocol names accepted by "ether proto" to
+// EtherType values.
+var etherTypes = map[string]uint32{
+	"ip":   0x0800,
+	"arp":  0x0806,
+	"rarp": 0x8035,
+	"ip6":  0x86dd,
+}
+
+func packetLength(pkt gopacket.Packet) int {
+	if md := pkt.Metadata(); md != nil && md.Length > 0 {
+		return md.Length
+	}
+	return len(pkt.Data())
+}
+
+// layerBytes returns the bytes of pkt from the start of the header of the
+// layer named proto, or nil if pkt has no such layer.
+func layerBytes(pkt gopacket.Packet, proto string) []byte {
+	typ := accessorLayers[proto]
+	data := pkt.Data()
+	if typ == gopacket.LayerTypeZero {
+		return data
+	}
+	var off int
+	for _, l := range pkt.Layers() {
+		if l.LayerType() == typ {
+			if off > len(data) {
+				return nil
+			}
+			return data[off:]
+		}
+		off += len(l.LayerContents())
+	}
+	return nil
+}
+
+// addresses returns the source and destination network addresses of pkt
+// for the protocol qualifier proto.
+func addresses(pkt gopacket.Packet, proto string) (net.IP, net.IP, bool) {
+	switch proto {
+	case "", "ip", "ip6":
+		switch l := pkt.NetworkLayer().(type) {
+		case *layers.IPv4:
+			if proto != "ip6" {
+				return l.SrcIP, l.DstIP, true
+			}
+		case *layers.IPv6:
+			if proto != "ip" {
+				return l.SrcIP, l.DstIP, true
+			}
+		}
+		if proto != "" {
+			return nil, nil, false
+		}
+		fallthrough
+	case "arp":
+		if arp, ok := pkt.Layer(layers.LayerTypeARP).(*layers.ARP); ok {
+			return net.IP(arp.SourceProtAddress), net.IP(arp.DstProtAddress), true
+		}
+	}
+	return nil, nil, false
+}
+
+// ports returns the source and destination ports of pkt for the protocol
+// qualifier proto.
+func ports(pkt gopacket.Packet, proto string) (uint16, uint16, bool) {
+	switch proto {
+	case "ip", "ip6":
+		if _, _, ok := addresses(pkt, proto); !ok {
+			return 0, 0, false
+		}
+	}
+	switch l := pkt.TransportLayer().(type) {
+	case *layers.TCP:
+		if proto == "" || proto == "ip" || proto == "ip6" || proto == "tcp" {
+			return uint16(l.SrcPort), uint16(l.DstPort), true
+		}
+	case *layers.UDP:
+		if proto == "" || proto == "ip" || proto == "ip6" || proto == "udp" {
+			return uint16(l.SrcPort), uint16(l.DstPort), true
+		}
+	case *layers.SCTP:
+		if proto == "" || proto == "ip" || proto == "ip6" || proto == "sctp" {
+			return uint16(l.SrcPort), uint16(l.DstPort), true
+		}
+	}
+	return 0, 0, false
+}
+
+// matchDir combines whether the source and destination of a packet match
+// as directed by the direction qualifier dir.
+func matchDir(dir string, src, dst bool) bool {
+	switch dir {
+	case "src":
+		return src
+	case "dst":
+		return dst
+	case "src and dst":
+		return src && dst
+	}
+	return src || dst
+}
+
+func compileHost(q qualifiers, id string) (Filter, error) {
+	if q.proto == "ether" {
+		mac, err := net.ParseMAC(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAC address %q", id)
+		}
+		return func(pkt gopacket.Packet) bool {
+			eth, ok := pkt.LinkLayer().(*layers.Ethernet)
+			return ok && matchDir(q.dir, bytes.Equal(eth.SrcMAC, mac), bytes.Equal(eth.DstMAC, mac))
+		}, nil
+	}
+	if err := checkNetworkProto(q); err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(id)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid host address %q", id)
+	}
+	return func(pkt gopacket.Packet) bool {
+		src, dst, ok := addresses(pkt, q.proto)
+		return ok && matchDir(q.dir, ip.Equal(src), ip.Equal(dst))
+	}, nil
+}
+
+func compileNet(q qualifiers, id, mask string) (Filter, error) {
+	if err := checkNetworkProto(q); err != nil {
+		return nil, err
+	}
+	ipnet, err := parseNet(id, mask)
+	if err != nil {
+		return nil, err
+	}
+	return func(pkt gopacket.Packet) bool {
+		src, dst, ok := addresses(pkt, q.proto)
+		return ok && matchDir(q.dir, ipnet.Contains(src), ipnet.Contains(dst))
+	}, nil
+}
+
+func checkNetworkProto(q qualifiers) error {
+	switch q.proto {
+	case "", "ip", "ip6", "arp":
+		return nil
+	}
+	return fmt.Errorf("%q qualifier cannot be applied to %s", q.proto, q.typ)
+}
+
+// parseNet parses a network given as an address with a prefix length, a
+// partial IPv4 address such as 10.1, or an address with a netmask.
+func parseNet(id, mask string) (*net.IPNet, error) {
+	if mask != "" {
+		ip, m := net.ParseIP(id).To4(), net.ParseIP(mask).To4()
+		if ip == nil || m == nil {
+			return nil, fmt.Errorf("invalid network %q mask %q", id, mask)
+		}
+		return &net.IPNet{IP: ip.Mask(net.IPMask(m)), Mask: net.IPMask(m)}, nil
+	}
+	if strings.Contains(id, "/") {
+		_, ipnet, err := net.ParseCIDR(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q", id)
+		}
+		return ipnet, nil
+	}
+	if ip := net.ParseIP(id); ip != nil {
+		bits := 8 * len(ip)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 32
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	parts := strings.Split(id, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid network %q", id)
+	}
+	ip := make(net.IP, 4)
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q", id)
+		}
+		ip[i] = byte(n)
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(parts), 32)}, nil
+}
+
+func compilePort(q qualifiers, lo, hi string) (Filter, error) {
+	switch q.proto {
+	case "", "ip", "ip6", "tcp", "udp", "sctp":
+	default:
+		return nil, fmt.Errorf("%q qualifier cannot be applied to %s", q.proto, q.typ)
+	}
+	low, err := parsePort(q.proto, lo)
+	if err != nil {
+		return nil, err
+	}
+	high, err := parsePort(q.proto, hi)
+	if err != nil {
+		return nil, err
+	}
+	if low > high {
+		low, high = high, low
+	}
+	in := func(port uint16) bool { return port >= low && port <= high }
+	return func(pkt gopacket.Packet) bool {
+		src, dst, ok := ports(pkt, q.proto)
+		return ok && matchDir(q.dir, in(src), in(dst))
+	}, nil
+}
+
+func parsePort(proto, s string) (uint16, error) {
+	if n, err := strconv.ParseUint(s, 10, 16); err == nil {
+		return uint16(n), nil
+	}
+	network := "tcp"
+	if proto == "udp" {
+		network = "udp"
+	}
+	n, err := net.LookupPort(network, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return uint16(n), nil
+}
+
+// compileProto compiles "proto" primitives such as "ip proto 17" and
+// "ether proto \arp".  With no qualifier, the protocol is an IPv4 or IPv6
+// protocol.
+func compileProto(qualifier, id string) (Filter, error) {
+	if qualifier == "ether" {
+		typ, ok := etherTypes[id]
+		if !ok {
+			n, err := parseNumber(id)
+			if err != nil || n > 0xffff {
+				return nil, fmt.Errorf("invalid EtherType %q", id)
+			}
+			typ = n
+		}
+		return func(pkt gopacket.Packet) bool {
+			eth, ok := pkt.LinkLayer().(*layers.Ethernet)
+			return ok && uint32(eth.EthernetType) == typ
+		}, nil
+	}
+	proto, ok := ipProtocols[id]
+	if !ok {
+		n, err := parseNumber(id)
+		if err != nil || n > 0xff {
+			return nil, fmt.Errorf("invalid IP protocol %q", id)
+		}
+		proto = n
+	}
+	switch qualifier {
+	case "", "ip", "ip6":
+	default:
+		return nil, fmt.Errorf("%q qualifier cannot be applied to proto", qualifier)
+	}
+	return func(pkt gopacket.Packet) bool {
+		switch l := pkt.NetworkLayer().(type) {
+		case *layers.IPv4:
+			return qualifier != "ip6" && uint32(l.Protocol) == proto
+		case *layers.IPv6:
+			return qualifier != "ip" && uint32(l.NextHeader) == proto
+		}
+		return false
+	}, nil
+}
+
+// matchProtocol returns a Filter matching packets that contain a layer of
+// the protocol named proto.
+func matchProtocol(proto string) Filter {
+	typ := accessorLayers[proto]
+	if typ == gopacket.LayerTypeZero {
+		return func(pkt gopacket.Packet) bool {
+			_, ok := pkt.LinkLayer().(*layers.Ethernet)
+			return ok
+		}
+	}
+	return func(pkt gopacket.Packet) bool {
+		return pkt.Layer(typ) != nil
+	}
+}
+
+func matchBroadcast() Filter {
+	return func(pkt gopacket.Packet) bool {
+		eth, ok := pkt.LinkLayer().(*layers.Ethernet)
+		return ok && bytes.Equal(eth.DstMAC, layers.EthernetBroadcast)
+	}
+}
+
+func matchMulticast(qualifier string) Filter {
+	return func(pkt gopacket.Packet) bool {
+		switch qualifier {
+		case "ip", "ip6":
+			_, dst, ok := addresses(pkt, qualifier)
+			return ok && dst.IsMulticast()
+		}
+		eth, ok := pkt.LinkLayer().(*layers.Ethernet)
+		return ok && len(eth.DstMAC) > 0 && eth.DstMAC[0]&1 != 0
+	}
+}
+
+// matchVLAN returns a Filter matching packets with an 802.1Q header and,
+// if id is not negative, with VLAN identifier id.
+func matchVLAN(id int) Filter {
+	return func(pkt gopacket.Packet) bool {
+		vlan, ok := pkt.Layer(layers.LayerTypeDot1Q).(*layers.Dot1Q)
+		return ok && (id < 0 || int(vlan.VLANIdentifier) == id)
+	}
+}
@@ -0,0 +1,569 @@
+package bpf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+)
+
+type parser struct {
+	toks []token
+	pos  int
+	// last holds the qualifiers of the previous primitive, which apply
+	// to a bare address or number, as in "host 10.0.0.1 or 10.0.0.2".
+	last *qualifiers
+}
+
+// qualifiers are the protocol, direction, and type qualifiers of a
+// primitive such as "tcp src port 80".
+type qualifiers struct {
+	proto string
+	dir   string
+	typ   string
+}
+
+type parseError struct {
+	pos int
+	msg string
+}
+
+func (e *parseError) Error() string {
+	return e.msg
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if p.done() {
+		msg += " at end of filter"
+	} else {
+		msg += fmt.Sprintf(" at position %d", p.toks[p.pos].pos)
+	}
+	return &parseError{pos: p.pos, msg: msg}
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.toks)
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.toks[p.pos].text
+}
+
+func (p *parser) peekAt(n int) string {
+	if p.pos+n >= len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos+n].text
+}
+
+// peekWord returns the next token if it is a word.
+func (p *parser) peekWord() string {
+	if p.done() || !p.toks[p.pos].word {
+		return ""
+	}
+	return p.toks[p.pos].text
+}
+
+func (p *parser) accept(texts ...string) bool {
+	tok := p.peek()
+	for _, text := range texts {
+		if tok == text {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		return p.errorf("expected %q", text)
+	}
+	return nil
+}
+
+// word consumes the next token, which must be a word.
+func (p *parser) word(what string) (string, error) {
+	w := p.peekWord()
+	if w == "" {
+		return "", p.errorf("expected %s", what)
+	}
+	p.pos++
+	return w, nil
+}
+
+func (p *parser) parseOr() (Filter, error) {
+	f, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("or", "||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		f = or(f, right)
+	}
+	return f, nil
+}
+
+func (p *parser) parseAnd() (Filter, error) {
+	f, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("and", "&&") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		f = and(f, right)
+	}
+	return f, nil
+}
+
+func (p *parser) parseNot() (Filter, error) {
+	if p.accept("not", "!") {
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return not(f), nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a relation, a parenthesized expression, or a
+// primitive.  A parenthesis may begin either a relation or an expression,
+// so a relation is tried first and the parser backs up if it fails.
+func (p *parser) parsePrimary() (Filter, error) {
+	start := p.pos
+	f, relErr := p.parseRelation()
+	if relErr == nil {
+		return f, nil
+	}
+	p.pos = start
+	var err error
+	if p.accept("(") {
+		f, err = p.parseOr()
+		if err == nil {
+			err = p.expect(")")
+		}
+	} else {
+		f, err = p.parsePrimitive()
+	}
+	// If both parses fail, or the relation failed beyond the end of the
+	// primitive, report the error of whichever parse got further.
+	relPos := -1
+	if re := new(parseError); errors.As(relErr, &re) {
+		relPos = re.pos
+	}
+	if err != nil {
+		if pe := new(parseError); errors.As(err, &pe) && relPos > pe.pos {
+			return nil, relErr
+		}
+		return nil, err
+	}
+	if relPos > p.pos {
+		return nil, relErr
+	}
+	return f, nil
+}
+
+var relations = map[string]func(a, b uint32) bool{
+	"=":  func(a, b uint32) bool { return a == b },
+	"==": func(a, b uint32) bool { return a == b },
+	"!=": func(a, b uint32) bool { return a != b },
+	"<":  func(a, b uint32) bool { return a < b },
+	"<=": func(a, b uint32) bool { return a <= b },
+	">":  func(a, b uint32) bool { return a > b },
+	">=": func(a, b uint32) bool { return a >= b },
+}
+
+func (p *parser) parseRelation() (Filter, error) {
+	left, err := p.parseArith(0)
+	if err != nil {
+		return nil, err
+	}
+	compare, ok := relations[p.peek()]
+	if !ok {
+		return nil, p.errorf("expected comparison operator")
+	}
+	p.pos++
+	right, err := p.parseArith(0)
+	if err != nil {
+		return nil, err
+	}
+	return func(pkt gopacket.Packet) bool {
+		a, ok := left(pkt)
+		if !ok {
+			return false
+		}
+		b, ok := right(pkt)
+		return ok && compare(a, b)
+	}, nil
+}
+
+// value computes an unsigned integer from a packet.  It returns false if
+// the packet lacks the bytes it refers to.
+type value func(gopacket.Packet) (uint32, bool)
+
+// arithLevels lists the binary arithmetic operators from lowest to highest
+// precedence.
+var arithLevels = [][]string{
+	{"|"},
+	{"^"},
+	{"&"},
+	{"<<", ">>"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseArith(level int) (value, error) {
+	if level == len(arithLevels) {
+		return p.parseUnary()
+	}
+	left, err := p.parseArith(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if !contains(arithLevels[level], op) || p.toks[p.pos].word {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseArith(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryOp(op, left, right)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, t := range list {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+func binaryOp(op string, left, right value) value {
+	var fn func(a, b uint32) (uint32, bool)
+	switch op {
+	case "|":
+		fn = func(a, b uint32) (uint32, bool) { return a | b, true }
+	case "^":
+		fn = func(a, b uint32) (uint32, bool) { return a ^ b, true }
+	case "&":
+		fn = func(a, b uint32) (uint32, bool) { return a & b, true }
+	case "<<":
+		fn = func(a, b uint32) (uint32, bool) { return a << b, true }
+	case ">>":
+		fn = func(a, b uint32) (uint32, bool) { return a >> b, true }
+	case "+":
+		fn = func(a, b uint32) (uint32, bool) { return a + b, true }
+	case "-":
+		fn = func(a, b uint32) (uint32, bool) { return a - b, true }
+	case "*":
+		fn = func(a, b uint32) (uint32, bool) { return a * b, true }
+	case "/":
+		fn = func(a, b uint32) (uint32, bool) { return a / b, b != 0 }
+	case "%":
+		fn = func(a, b uint32) (uint32, bool) { return a % b, b != 0 }
+	}
+	return func(pkt gopacket.Packet) (uint32, bool) {
+		a, ok := left(pkt)
+		if !ok {
+			return 0, false
+		}
+		b, ok := right(pkt)
+		if !ok {
+			return 0, false
+		}
+		return fn(a, b)
+	}
+}
+
+func (p *parser) parseUnary() (value, error) {
+	if p.accept("-") {
+		v, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(pkt gopacket.Packet) (uint32, bool) {
+			n, ok := v(pkt)
+			return -n, ok
+		}, nil
+	}
+	if p.accept("(") {
+		v, err := p.parseArith(0)
+		if err != nil {
+			return nil, err
+		}
+		return v, p.expect(")")
+	}
+	w := p.peekWord()
+	if w == "" {
+		return nil, p.errorf("expected arithmetic expression")
+	}
+	if w == "len" {
+		p.pos++
+		return func(pkt gopacket.Packet) (uint32, bool) {
+			return uint32(packetLength(pkt)), true
+		}, nil
+	}
+	if _, ok := accessorLayers[w]; ok && p.peekAt(1) == "[" {
+		p.pos++
+		return p.parseAccessor(w)
+	}
+	if n, ok := constants[w]; ok {
+		p.pos++
+		return constant(n), nil
+	}
+	n, err := parseNumber(w)
+	if err != nil {
+		return nil, p.errorf("expected arithmetic expression")
+	}
+	p.pos++
+	return constant(n), nil
+}
+
+func constant(n uint32) value {
+	return func(gopacket.Packet) (uint32, bool) { return n, true }
+}
+
+// parseAccessor parses the bracketed part of a packet accessor such as
+// "ip[2:2]", which loads the bytes at an offset from the start of the
+// header of proto.
+func (p *parser) parseAccessor(proto string) (value, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	offset, err := p.parseArith(0)
+	if err != nil {
+		return nil, err
+	}
+	size := 1
+	if p.accept(":") {
+		w, err := p.word("accessor size")
+		if err != nil {
+			return nil, err
+		}
+		switch w {
+		case "1", "2", "4":
+			size, _ = strconv.Atoi(w)
+		default:
+			p.pos--
+			return nil, p.errorf("accessor size must be 1, 2, or 4")
+		}
+	}
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	return func(pkt gopacket.Packet) (uint32, bool) {
+		b := layerBytes(pkt, proto)
+		if b == nil {
+			return 0, false
+		}
+		off, ok := offset(pkt)
+		if !ok || uint64(off)+uint64(size) > uint64(len(b)) {
+			return 0, false
+		}
+		var n uint32
+		for _, c := range b[off : int(off)+size] {
+			n = n<<8 | uint32(c)
+		}
+		return n, true
+	}, nil
+}
+
+// parseNumber parses a decimal, hexadecimal (0x), or octal (leading 0)
+// number.
+func parseNumber(s string) (uint32, error) {
+	n, err := strconv.ParseUint(s, 0, 32)
+	return uint32(n), err
+}
+
+var protoQualifiers = map[string]bool{
+	"ether": true,
+	"link":  true,
+	"ip":    true,
+	"ip6":   true,
+	"arp":   true,
+	"tcp":   true,
+	"udp":   true,
+	"icmp":  true,
+	"icmp6": true,
+	"sctp":  true,
+}
+
+var typeQualifiers = map[string]bool{
+	"host":      true,
+	"net":       true,
+	"port":      true,
+	"portrange": true,
+}
+
+// isKeyword reports whether w may not be used as a bare address or number.
+func isKeyword(w string) bool {
+	switch w {
+	case "src", "dst", "and", "or", "not", "proto", "less", "greater",
+		"vlan", "broadcast", "multicast", "len", "mask":
+		return true
+	}
+	return protoQualifiers[w] || typeQualifiers[w]
+}
+
+func (p *parser) parsePrimitive() (Filter, error) {
+	switch {
+	case p.accept("less"):
+		return p.parseLength(func(l, n int) bool { return l <= n })
+	case p.accept("greater"):
+		return p.parseLength(func(l, n int) bool { return l >= n })
+	case p.accept("vlan"):
+		return p.parseVLAN()
+	case p.accept("proto"):
+		return p.parseProto("")
+	case p.accept("broadcast"):
+		return matchBroadcast(), nil
+	case p.accept("multicast"):
+		return matchMulticast(""), nil
+	}
+	var q qualifiers
+	if w := p.peekWord(); protoQualifiers[w] {
+		p.pos++
+		q.proto = w
+		if q.proto == "link" {
+			q.proto = "ether"
+		}
+		switch {
+		case p.accept("proto"):
+			return p.parseProto(q.proto)
+		case p.peek() == "broadcast" && q.proto == "ether":
+			p.pos++
+			return matchBroadcast(), nil
+		case p.peek() == "multicast" && (q.proto == "ether" || q.proto == "ip" || q.proto == "ip6"):
+			p.pos++
+			return matchMulticast(q.proto), nil
+		}
+	}
+	if w := p.peekWord(); w == "src" || w == "dst" {
+		p.pos++
+		q.dir = w
+		if other := map[string]string{"src": "dst", "dst": "src"}[w]; (p.peek() == "or" || p.peek() == "and") && p.peekAt(1) == other {
+			q.dir = "src " + p.peek() + " dst"
+			p.pos += 2
+		}
+	}
+	if w := p.peekWord(); typeQualifiers[w] {
+		p.pos++
+		q.typ = w
+	}
+	if q == (qualifiers{}) {
+		// A bare address or number takes the qualifiers of the
+		// previous primitive.
+		w := p.peekWord()
+		if w == "" || isKeyword(w) {
+			return nil, p.errorf("expected filter primitive")
+		}
+		if p.last != nil {
+			q = *p.last
+		}
+	} else if q.dir == "" && q.typ == "" {
+		if w := p.peekWord(); w == "" || isKeyword(w) {
+			return matchProtocol(q.proto), nil
+		}
+	}
+	idPos := p.pos
+	id, err := p.word("address or number")
+	if err != nil {
+		return nil, err
+	}
+	if q.typ == "" {
+		q.typ = "host"
+		if strings.Contains(id, "/") {
+			q.typ = "net"
+		}
+	}
+	var mask string
+	if q.typ == "net" && p.accept("mask") {
+		if mask, err = p.word("netmask"); err != nil {
+			return nil, err
+		}
+	}
+	f, err := compileID(q, id, mask)
+	if err != nil {
+		p.pos = idPos
+		return nil, p.errorf("%s", err)
+	}
+	p.last = &q
+	return f, nil
+}
+
+func compileID(q qualifiers, id, mask string) (Filter, error) {
+	switch q.typ {
+	case "host":
+		return compileHost(q, id)
+	case "net":
+		return compileNet(q, id, mask)
+	case "port":
+		return compilePort(q, id, id)
+	case "portrange":
+		i := strings.Index(id, "-")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid port range %q", id)
+		}
+		return compilePort(q, id[:i], id[i+1:])
+	}
+	return nil, fmt.Errorf("unknown qualifier %q", q.typ)
+}
+
+func (p *parser) parseLength(compare func(l, n int) bool) (Filter, error) {
+	w, err := p.word("length")
+	if err != nil {
+		return nil, err
+	}
+	n, err := parseNumber(w)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("invalid length %q", w)
+	}
+	return func(pkt gopacket.Packet) bool {
+		return compare(packetLength(pkt), int(n))
+	}, nil
+}
+
+func (p *parser) parseVLAN() (Filter, error) {
+	id := -1
+	if w := p.peekWord(); w != "" && !isKeyword(w) {
+		n, err := parseNumber(w)
+		if err != nil || n > 4095 {
+			return nil, p.errorf("invalid VLAN ID %q", w)
+		}
+		p.pos++
+		id = int(n)
+	}
+	return matchVLAN(id), nil
+}
+
+func (p *parser) parseProto(qualifier string) (Filter, error) {
+	w, err := p.word("protocol")
+	if err != nil {
+		return nil, err
+	}
+	f, err := compileProto(qualifier, w)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("%s", err)
+	}
+	return f, nil
+}
@@ -0,0 +1,148 @@
+package pcap
+
+import (
+	"container/heap"
+	"context"
+	"io"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket/layers"
+)
+
+// Merge writes the packets of the pcaps read from readers to w as a single
+// pcap-ng file ordered by timestamp.  Each input is presumed to be in time
+// order, so only one packet per input is held in memory at a time.  Packets
+// with equal timestamps are written in the order of their inputs.  The output
+// has an interface for each link type found in the inputs so captures with
+// differing link types may be merged.
+func Merge(ctx context.Context, w io.Writer, readers []pcapio.Reader) error {
+	out, err := pcapio.NewNgWriter(w)
+	if err != nil {
+		return err
+	}
+	m := &merger{
+		out:    out,
+		ifaces: make(map[layers.LinkType]int),
+	}
+	for k, r := range readers {
+		in := &mergeInput{reader: r, order: k}
+		ok, err := in.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			m.inputs = append(m.inputs, in)
+		}
+	}
+	heap.Init(m)
+	for len(m.inputs) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		in := m.inputs[0]
+		if err := m.write(in); err != nil {
+			return err
+		}
+		ok, err := in.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(m, 0)
+		} else {
+			heap.Pop(m)
+		}
+	}
+	return out.Flush()
+}
+
+type merger struct {
+	out    *pcapio.NgWriter
+	ifaces map[layers.LinkType]int
+	inputs []*mergeInput
+}
+
+func (m *merger) write(in *mergeInput) error {
+	ifno, ok := m.ifaces[in.linkType]
+	if !ok {
+		var err error
+		ifno, err = m.out.AddInterface(in.linkType, 0)
+		if err != nil {
+			return err
+		}
+		m.ifaces[in.linkType] = ifno
+	}
+	return m.out.WritePacket(ifno, in.ts, in.data, in.length)
+}
+
+func (m *merger) Len() int { return len(m.inputs) }
+
+func (m *merger) Less(i, j int) bool {
+	a, b := m.inputs[i], m.inputs[j]
+	if a.ts == b.ts {
+		return a.order < b.order
+	}
+	return a.ts < b.ts
+}
+
+func (m *merger) Swap(i, j int) { m.inputs[i], m.inputs[j] = m.inputs[j], m.inputs[i] }
+
+func (m *merger) Push(x interface{}) { m.inputs = append(m.inputs, x.(*mergeInput)) }
+
+func (m *merger) Pop() interface{} {
+	n := len(m.inputs)
+	in := m.inputs[n-1]
+	m.inputs = m.inputs[:n-1]
+	return in
+}
+
+// mergeInput holds the next packet of an input to Merge.
+type mergeInput struct {
+	reader   pcapio.Reader
+	order    int
+	ts       nano.Ts
+	linkType layers.LinkType
+	data     []byte
+	length   int
+}
+
+// next reads the next packet of the input and returns false when there
+// are no more packets.  The packet is copied since the underlying block
+// is only valid until the following read.
+func (m *mergeInput) next() (bool, error) {
+	for {
+		block, typ, err := m.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return false, nil
+			}
+			return false, err
+		}
+		if block == nil {
+			return false, nil
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		pkt, ts, linkType, err := m.reader.Packet(block)
+		if pkt == nil {
+			return false, err
+		}
+		length, err := m.reader.PacketLength(block)
+		if err != nil {
+			return false, err
+		}
+		// Some pcaps have captures that exceed the size of the actual
+		// packet (see pcapio.PcapReader.Read), so fix up the length
+		// rather than writing an invalid block.
+		if length < len(pkt) {
+			length = len(pkt)
+		}
+		m.ts = ts
+		m.linkType = linkType
+		m.data = append(m.data[:0], pkt...)
+		m.length = length
+		return true, nil
+	}
+}
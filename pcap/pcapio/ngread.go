@@ -114,6 +114,15 @@ func (r *NgReader) Packet(block []byte) ([]byte, nano.Ts, layers.LinkType, error
 	return packet, nano.TimeToTs(t), r.ifaces[ifno].LinkType, nil
 }
 
+// PacketLength returns the original length of a packet from an enhanced
+// packet block returned by Read().
+func (r *NgReader) PacketLength(block []byte) (int, error) {
+	if len(block) < PacketBlockHeaderLen {
+		return 0, errInvalidf("packet buffer length less than minimum packet size")
+	}
+	return int(r.getUint32(block[24:28])), nil
+}
+
 func (r *NgReader) InterfaceDescriptor(block []byte) (NgInterface, error) {
 	return r.parseInterfaceDescriptor(block)
 }
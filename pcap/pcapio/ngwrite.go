@@ -0,0 +1,119 @@
+// The code in this source file is derived from
+// https://github.com/google/gopacket/blob/master/pcapgo/ngwrite.go
+// as of February 2020 and is covered by the copyright below.
+// The changes are covered by the copyright and license in the
+// LICENSE file in the root directory of this repository.
+
+// Copyright 2018 The GoPacket Authors. All rights reserved.
+// See acknowledgments.txt for full license text from:
+// https://github.com/google/gopacket/LICENSE
+
+package pcapio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket/layers"
+)
+
+// NgWriter writes a pcap-ng file holding a single section.  Packet
+// timestamps are written with nanosecond resolution.  Since output is
+// buffered, Flush must be called before the underlying writer is closed.
+type NgWriter struct {
+	w      *bufio.Writer
+	ifaces int
+	buf    [28]byte
+}
+
+// NewNgWriter returns a writer to w after writing a section header.
+// Interfaces must be added with AddInterface before packets that
+// reference them are written.
+func NewNgWriter(w io.Writer) (*NgWriter, error) {
+	ret := &NgWriter{w: bufio.NewWriter(w)}
+	if err := ret.writeSectionHeader(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (w *NgWriter) writeSectionHeader() error {
+	const length = 28
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(ngBlockTypeSectionHeader))
+	binary.LittleEndian.PutUint32(w.buf[4:8], length)
+	binary.LittleEndian.PutUint32(w.buf[8:12], ngByteOrderMagic)
+	binary.LittleEndian.PutUint16(w.buf[12:14], ngVersionMajor)
+	binary.LittleEndian.PutUint16(w.buf[14:16], ngVersionMinor)
+	// The section length is unspecified.
+	binary.LittleEndian.PutUint64(w.buf[16:24], 0xffffffffffffffff)
+	binary.LittleEndian.PutUint32(w.buf[24:28], length)
+	_, err := w.w.Write(w.buf[:28])
+	return err
+}
+
+// AddInterface writes an interface description block for the given link
+// type and snap length (where zero means unlimited) and returns the
+// interface number to be used for its packets.
+func (w *NgWriter) AddInterface(linkType layers.LinkType, snaplen uint32) (int, error) {
+	// The block holds a timestamp resolution option (with its value
+	// padded to four bytes) followed by an end-of-options option.
+	const length = 16 + 8 + 4 + 4
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(ngBlockTypeInterfaceDescriptor))
+	binary.LittleEndian.PutUint32(w.buf[4:8], length)
+	binary.LittleEndian.PutUint16(w.buf[8:10], uint16(linkType))
+	binary.LittleEndian.PutUint16(w.buf[10:12], 0)
+	binary.LittleEndian.PutUint32(w.buf[12:16], snaplen)
+	binary.LittleEndian.PutUint16(w.buf[16:18], uint16(ngOptionCodeInterfaceTimestampResolution))
+	binary.LittleEndian.PutUint16(w.buf[18:20], 1)
+	binary.LittleEndian.PutUint32(w.buf[20:24], 9)
+	binary.LittleEndian.PutUint32(w.buf[24:28], uint32(ngOptionCodeEndOfOptions))
+	if _, err := w.w.Write(w.buf[:28]); err != nil {
+		return 0, err
+	}
+	binary.LittleEndian.PutUint32(w.buf[0:4], length)
+	if _, err := w.w.Write(w.buf[:4]); err != nil {
+		return 0, err
+	}
+	id := w.ifaces
+	w.ifaces++
+	return id, nil
+}
+
+// WritePacket writes an enhanced packet block holding the captured
+// portion of a packet, data, whose length on the wire was length.
+func (w *NgWriter) WritePacket(ifno int, ts nano.Ts, data []byte, length int) error {
+	if ifno < 0 || ifno >= w.ifaces {
+		return fmt.Errorf("packet references unknown interface no: %d", ifno)
+	}
+	if len(data) > length {
+		return fmt.Errorf("capture length %d exceeds packet length %d", len(data), length)
+	}
+	blockLen := uint32(len(data)) + 32
+	padding := (4 - blockLen&3) & 3
+	blockLen += padding
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(ngBlockTypeEnhancedPacket))
+	binary.LittleEndian.PutUint32(w.buf[4:8], blockLen)
+	binary.LittleEndian.PutUint32(w.buf[8:12], uint32(ifno))
+	binary.LittleEndian.PutUint32(w.buf[12:16], uint32(uint64(ts)>>32))
+	binary.LittleEndian.PutUint32(w.buf[16:20], uint32(ts))
+	binary.LittleEndian.PutUint32(w.buf[20:24], uint32(len(data)))
+	binary.LittleEndian.PutUint32(w.buf[24:28], uint32(length))
+	if _, err := w.w.Write(w.buf[:28]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(w.buf[:4], 0)
+	binary.LittleEndian.PutUint32(w.buf[4:8], blockLen)
+	_, err := w.w.Write(w.buf[4-padding : 8])
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *NgWriter) Flush() error {
+	return w.w.Flush()
+}
@@ -95,6 +95,15 @@ func (r *PcapReader) Packet(block []byte) ([]byte, nano.Ts, layers.LinkType, err
 	return pkt[:caplen], ts, r.LinkType, nil
 }
 
+// PacketLength returns the original length of a packet from a block returned
+// by Read() with BlockType equal to TypePacket.
+func (r *PcapReader) PacketLength(block []byte) (int, error) {
+	if len(block) <= packetHeaderLen {
+		return 0, errInvalidf("packet buffer length less than minimum packet size")
+	}
+	return int(r.byteOrder.Uint32(block[12:16])), nil
+}
+
 func (r *PcapReader) readHeader() error {
 	hdr, err := r.Reader.Read(fileHeaderLen)
 	if err != nil {
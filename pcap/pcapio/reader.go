@@ -6,7 +6,7 @@ import (
 	"io"
 
 	"github.com/brimsec/zq/pkg/nano"
-	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/pkg/recorder"
 	"github.com/google/gopacket/layers"
 	"go.uber.org/multierr"
 )
@@ -25,10 +25,12 @@ const (
 // header (TypePacket), a pcap-ng section block (TypeSection), a pcap-ng
 // interface block (TypeInterface), or a pcap-ng packet block (TypePacket).
 // For TypePacket, the capture timestamp and the link-layer type of the packet
-// is indicated in the Info return value.
+// is indicated in the Info return value, and PacketLength returns the length
+// of the packet on the wire, which may exceed the length of its captured portion.
 type Reader interface {
 	Read() ([]byte, BlockType, error)
 	Packet([]byte) ([]byte, nano.Ts, layers.LinkType, error)
+	PacketLength([]byte) (int, error)
 	Offset() uint64
 }
 
@@ -42,16 +44,16 @@ func NewReader(r io.Reader) (Reader, error) {
 // pcap implementations can have out-of-spec peculiarities that can be tolerated
 // so we send warnings and try to keep going.
 func NewReaderWithWarnings(r io.Reader, warningCh chan<- string) (Reader, error) {
-	recorder := detector.NewRecorder(r)
-	track := detector.NewTrack(recorder)
+	rec := recorder.New(r)
+	track := recorder.NewTrack(rec)
 	_, err1 := NewPcapReader(track)
 	if err1 == nil {
-		return NewPcapReader(recorder)
+		return NewPcapReader(rec)
 	}
 	track.Reset()
 	_, err2 := NewNgReader(track)
 	if err2 == nil {
-		r, err := NewNgReader(recorder)
+		r, err := NewNgReader(rec)
 		r.SetWarningChan(warningCh)
 		return r, err
 	}
@@ -0,0 +1,92 @@
+package pcapio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket/layers"
+)
+
+// Writer is the interface implemented by PcapWriter and NgWriter.
+type Writer interface {
+	AddInterface(linkType layers.LinkType, snaplen uint32) (int, error)
+	WritePacket(ifno int, ts nano.Ts, data []byte, length int) error
+	Flush() error
+}
+
+// PcapWriter writes a legacy pcap file in little-endian byte order with
+// nanosecond timestamps.  Since a pcap has a single link type, it has
+// a single interface, and the file header is written when it is added.
+// Since output is buffered, Flush must be called before the underlying
+// writer is closed.
+type PcapWriter struct {
+	w        *bufio.Writer
+	linkType layers.LinkType
+	snaplen  uint32
+	added    bool
+	buf      [fileHeaderLen]byte
+}
+
+// NewPcapWriter returns a writer to w.
+func NewPcapWriter(w io.Writer) *PcapWriter {
+	return &PcapWriter{w: bufio.NewWriter(w)}
+}
+
+// AddInterface writes the file header for the given link type and snap
+// length (where zero means unlimited) and returns the interface number,
+// which is always zero, to be used for packets.  Adding an interface of
+// the link type and snap length of an earlier one returns the same
+// interface, but adding one that differs is an error.
+func (w *PcapWriter) AddInterface(linkType layers.LinkType, snaplen uint32) (int, error) {
+	if w.added {
+		if linkType != w.linkType || snaplen != w.snaplen {
+			return 0, fmt.Errorf("pcap cannot hold more than one link type or snap length")
+		}
+		return 0, nil
+	}
+	w.linkType = linkType
+	w.snaplen = snaplen
+	w.added = true
+	if snaplen == 0 {
+		// Zero is not a valid snap length in a pcap header so use the
+		// maximum of libpcap.
+		snaplen = 262144
+	}
+	binary.LittleEndian.PutUint32(w.buf[0:4], magicNanoseconds)
+	binary.LittleEndian.PutUint16(w.buf[4:6], versionMajor)
+	binary.LittleEndian.PutUint16(w.buf[6:8], versionMinor)
+	binary.LittleEndian.PutUint32(w.buf[8:12], 0)
+	binary.LittleEndian.PutUint32(w.buf[12:16], 0)
+	binary.LittleEndian.PutUint32(w.buf[16:20], snaplen)
+	binary.LittleEndian.PutUint32(w.buf[20:24], uint32(linkType))
+	_, err := w.w.Write(w.buf[:fileHeaderLen])
+	return 0, err
+}
+
+// WritePacket writes a packet record holding the captured portion of a
+// packet, data, whose length on the wire was length.
+func (w *PcapWriter) WritePacket(ifno int, ts nano.Ts, data []byte, length int) error {
+	if ifno != 0 || !w.added {
+		return fmt.Errorf("packet references unknown interface no: %d", ifno)
+	}
+	if len(data) > length {
+		return fmt.Errorf("capture length %d exceeds packet length %d", len(data), length)
+	}
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(ts/1_000_000_000))
+	binary.LittleEndian.PutUint32(w.buf[4:8], uint32(ts%1_000_000_000))
+	binary.LittleEndian.PutUint32(w.buf[8:12], uint32(len(data)))
+	binary.LittleEndian.PutUint32(w.buf[12:16], uint32(length))
+	if _, err := w.w.Write(w.buf[:packetHeaderLen]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(data)
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *PcapWriter) Flush() error {
+	return w.w.Flush()
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 
+	"github.com/brimsec/zq/pcap/bpf"
 	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/ctxio"
 	"github.com/brimsec/zq/pkg/nano"
@@ -63,6 +64,32 @@ func NewRangeSearch(span nano.Span) *Search {
 	}
 }
 
+// NewBPFSearch returns a search for the packets matching the BPF filter
+// expression expr, e.g., "udp port 53 and host 8.8.8.8".
+func NewBPFSearch(span nano.Span, expr string) (*Search, error) {
+	s := NewRangeSearch(span)
+	if err := s.AddBPF(expr); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// AddBPF restricts the search to the packets that also match the BPF
+// filter expression expr.
+func (s *Search) AddBPF(expr string) error {
+	f, err := bpf.Compile(expr)
+	if err != nil {
+		return zqe.E(zqe.Invalid, "bpf filter: %s", err)
+	}
+	if prev := s.filter; prev != nil {
+		s.filter = func(p gopacket.Packet) bool { return prev(p) && f(p) }
+	} else {
+		s.filter = PacketFilter(f)
+	}
+	s.id += "_bpf"
+	return nil
+}
+
 func (s Search) Span() nano.Span {
 	return s.span
 }
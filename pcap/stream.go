@@ -0,0 +1,273 @@
+package pcap
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqe"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/reassembly"
+)
+
+// The formats of a reassembled TCP stream written by WriteStream.
+const (
+	// StreamClient is the payload sent by the client of a connection.
+	StreamClient = "client"
+	// StreamServer is the payload sent by the server of a connection.
+	StreamServer = "server"
+	// StreamFollow is a text view of the payload sent in both directions
+	// in the style of tshark's "follow,tcp,ascii" output.
+	StreamFollow = "follow"
+)
+
+// ValidStreamFormat returns an error if format is not one of the formats
+// accepted by WriteStream.
+func ValidStreamFormat(format string) error {
+	switch format {
+	case StreamClient, StreamServer, StreamFollow:
+		return nil
+	}
+	return zqe.E(zqe.Invalid, "unknown stream format %q (must be %s, %s, or %s)", format, StreamClient, StreamServer, StreamFollow)
+}
+
+// Segment is a run of reassembled payload sent by one side of a TCP
+// connection.
+type Segment struct {
+	Ts nano.Ts
+	// Flow is the connection of the segment with its client as S0.
+	Flow Flow
+	// Conn numbers the connections in the order they are first seen.
+	Conn int
+	// Client is true if the payload was sent by the client.
+	Client  bool
+	Payload []byte
+}
+
+// Reassemble reassembles the TCP connections in the packets read from r and
+// calls fn with each run of payload in the order it becomes contiguous, so
+// retransmitted data is passed only once and out-of-order data is passed in
+// sequence order.  The payload is valid only for the duration of the call.
+// Packets that are not TCP are ignored.  The client of a connection is the
+// sender of its first packet if that packet is a SYN, the receiver if it is
+// a SYN-ACK, and otherwise, as for a connection that began before the
+// capture, the endpoint with the higher port.
+//
+// So that memory does not grow with the length of the capture, the payload
+// held behind a gap in a connection for longer than streamTimeout of
+// capture time is passed without waiting for the gap to fill, and a
+// connection idle that long is closed.  A later packet of a closed
+// connection starts a new one.
+func Reassemble(ctx context.Context, r pcapio.Reader, fn func(Segment) error) error {
+	factory := &streamFactory{fn: fn}
+	assembler := reassembly.NewAssembler(reassembly.NewStreamPool(factory))
+	opts := gopacket.DecodeOptions{Lazy: true, NoCopy: true}
+	var nextFlush time.Time
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		block, typ, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if block == nil {
+			break
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		buf, ts, linkType, err := r.Packet(block)
+		if buf == nil {
+			return err
+		}
+		if t := ts.Time(); nextFlush.IsZero() {
+			nextFlush = t.Add(streamFlushInterval)
+		} else if t.After(nextFlush) {
+			assembler.FlushCloseOlderThan(t.Add(-streamTimeout))
+			if factory.err != nil {
+				return factory.err
+			}
+			nextFlush = t.Add(streamFlushInterval)
+		}
+		packet := gopacket.NewPacket(buf, linkType, opts)
+		tcp, ok := packet.TransportLayer().(*layers.TCP)
+		if !ok || packet.NetworkLayer() == nil {
+			continue
+		}
+		ac := &assemblerContext{gopacket.CaptureInfo{
+			Timestamp:     ts.Time(),
+			CaptureLength: len(buf),
+			Length:        len(buf),
+		}}
+		assembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, ac)
+		if factory.err != nil {
+			return factory.err
+		}
+	}
+	assembler.FlushAll()
+	return factory.err
+}
+
+// Reassemble flushes and closes the connections idle for streamTimeout of
+// capture time every streamFlushInterval of capture time.
+const (
+	streamFlushInterval = time.Minute
+	streamTimeout       = 2 * time.Minute
+)
+
+// WriteStream reassembles the TCP connections in the packets read from r and
+// writes their payload to w in the given format.  The client and server
+// formats are meaningful for a single connection; for several, the payload
+// of each is written in the order it becomes contiguous.
+func WriteStream(ctx context.Context, w io.Writer, r pcapio.Reader, format string) error {
+	if err := ValidStreamFormat(format); err != nil {
+		return err
+	}
+	if format != StreamFollow {
+		client := format == StreamClient
+		return Reassemble(ctx, r, func(s Segment) error {
+			if s.Client != client {
+				return nil
+			}
+			_, err := w.Write(s.Payload)
+			return err
+		})
+	}
+	f := &follower{w: w, conn: -1}
+	if err := Reassemble(ctx, r, f.write); err != nil {
+		return err
+	}
+	return f.close()
+}
+
+// follower writes the payload of each connection as a block listing the
+// connection's endpoints followed by each segment's length and contents, with
+// non-printable characters replaced by '.'.  The lengths of the segments sent
+// by the server are indented by a tab.  A new block begins whenever the
+// connection changes.
+type follower struct {
+	w    io.Writer
+	conn int
+}
+
+const followRule = "===================================================================\n"
+
+func (f *follower) write(s Segment) error {
+	var b strings.Builder
+	if s.Conn != f.conn {
+		if f.conn >= 0 {
+			b.WriteString(followRule)
+		}
+		b.WriteString(followRule)
+		b.WriteString("Follow: tcp,ascii\n")
+		fmt.Fprintf(&b, "Node 0: %s\n", s.Flow.S0)
+		fmt.Fprintf(&b, "Node 1: %s\n", s.Flow.S1)
+		f.conn = s.Conn
+	}
+	if !s.Client {
+		b.WriteByte('\t')
+	}
+	fmt.Fprintf(&b, "%d\n", len(s.Payload))
+	for _, c := range s.Payload {
+		if (c < ' ' || c > '~') && c != '\n' && c != '\r' && c != '\t' {
+			c = '.'
+		}
+		b.WriteByte(c)
+	}
+	if n := len(s.Payload); n > 0 && s.Payload[n-1] != '\n' {
+		b.WriteByte('\n')
+	}
+	_, err := io.WriteString(f.w, b.String())
+	return err
+}
+
+func (f *follower) close() error {
+	if f.conn < 0 {
+		return nil
+	}
+	_, err := io.WriteString(f.w, followRule)
+	return err
+}
+
+type assemblerContext struct {
+	ci gopacket.CaptureInfo
+}
+
+func (a *assemblerContext) GetCaptureInfo() gopacket.CaptureInfo {
+	return a.ci
+}
+
+type streamFactory struct {
+	fn    func(Segment) error
+	err   error
+	conns int
+}
+
+func (f *streamFactory) New(netFlow, tcpFlow gopacket.Flow, tcp *layers.TCP, ac reassembly.AssemblerContext) reassembly.Stream {
+	src, dst := netFlow.Endpoints()
+	sport, dport := tcpFlow.Endpoints()
+	flow := Flow{
+		S0: Socket{net.IP(append([]byte(nil), src.Raw()...)), int(binary.BigEndian.Uint16(sport.Raw()))},
+		S1: Socket{net.IP(append([]byte(nil), dst.Raw()...)), int(binary.BigEndian.Uint16(dport.Raw()))},
+	}
+	// The client sends the SYN.  Without a handshake, guess that the
+	// server is the endpoint with the lower, likely well-known, port.
+	swap := flow.S0.Port < flow.S1.Port
+	if tcp.SYN {
+		swap = tcp.ACK
+	}
+	if swap {
+		flow.S0, flow.S1 = flow.S1, flow.S0
+	}
+	s := &tcpStream{factory: f, flow: flow, conn: f.conns, swap: swap}
+	f.conns++
+	return s
+}
+
+type tcpStream struct {
+	factory *streamFactory
+	flow    Flow
+	conn    int
+	swap    bool
+}
+
+// Accept accepts every packet and starts reassembly at the first packet
+// seen in each direction so connections that began before the capture (or
+// the search's time span) are reassembled too.
+func (s *tcpStream) Accept(tcp *layers.TCP, ci gopacket.CaptureInfo, dir reassembly.TCPFlowDirection, nextSeq reassembly.Sequence, start *bool, ac reassembly.AssemblerContext) bool {
+	*start = true
+	return true
+}
+
+func (s *tcpStream) ReassembledSG(sg reassembly.ScatterGather, ac reassembly.AssemblerContext) {
+	if s.factory.err != nil {
+		return
+	}
+	n, _ := sg.Lengths()
+	if n == 0 {
+		return
+	}
+	dir, _, _, _ := sg.Info()
+	s.factory.err = s.factory.fn(Segment{
+		Ts:      nano.TimeToTs(sg.CaptureInfo(0).Timestamp),
+		Flow:    s.flow,
+		Conn:    s.conn,
+		Client:  (dir == reassembly.TCPDirClientToServer) != s.swap,
+		Payload: sg.Fetch(n),
+	})
+}
+
+func (s *tcpStream) ReassemblyComplete(ac reassembly.AssemblerContext) bool {
+	return true
+}
@@ -0,0 +1,137 @@
+package pcap_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type segment struct {
+	fromClient bool
+	tcp        layers.TCP
+	payload    string
+}
+
+// writePcap returns a pcap holding a packet for each segment of a
+// connection between 10.0.0.1:40000 and 10.0.0.2:80.
+func writePcap(t *testing.T, segments []segment) []byte {
+	return writePcapAt(t, segments, nil)
+}
+
+// writePcapAt is like writePcap but, if ts is not nil, timestamps the
+// packet of segments[i] with ts[i].
+func writePcapAt(t *testing.T, segments []segment, ts []nano.Ts) []byte {
+	client, server := net.IPv4(10, 0, 0, 1), net.IPv4(10, 0, 0, 2)
+	var buf bytes.Buffer
+	w, err := pcapio.NewNgWriter(&buf)
+	require.NoError(t, err)
+	ifno, err := w.AddInterface(layers.LinkTypeEthernet, 0)
+	require.NoError(t, err)
+	for i, s := range segments {
+		ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: client, DstIP: server}
+		tcp := s.tcp
+		tcp.SrcPort, tcp.DstPort = 40000, 80
+		if !s.fromClient {
+			ip.SrcIP, ip.DstIP = server, client
+			tcp.SrcPort, tcp.DstPort = 80, 40000
+		}
+		require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+		pkt := gopacket.NewSerializeBuffer()
+		opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+		ether := &layers.Ethernet{SrcMAC: make([]byte, 6), DstMAC: make([]byte, 6), EthernetType: layers.EthernetTypeIPv4}
+		require.NoError(t, gopacket.SerializeLayers(pkt, opts, ether, ip, &tcp, gopacket.Payload(s.payload)))
+		pts := nano.Ts(1e9 + i*1000)
+		if ts != nil {
+			pts = ts[i]
+		}
+		require.NoError(t, w.WritePacket(ifno, pts, pkt.Bytes(), len(pkt.Bytes())))
+	}
+	require.NoError(t, w.Flush())
+	return buf.Bytes()
+}
+
+func writeStream(t *testing.T, segments []segment, format string) string {
+	r, err := pcapio.NewReader(bytes.NewReader(writePcap(t, segments)))
+	require.NoError(t, err)
+	var out strings.Builder
+	require.NoError(t, pcap.WriteStream(context.Background(), &out, r, format))
+	return out.String()
+}
+
+func TestWriteStream(t *testing.T) {
+	// The client's payload arrives out of order with a retransmission.
+	segments := []segment{
+		{true, layers.TCP{SYN: true, Seq: 100}, ""},
+		{false, layers.TCP{SYN: true, ACK: true, Seq: 500, Ack: 101}, ""},
+		{true, layers.TCP{ACK: true, Seq: 107, Ack: 501}, "world\n"},
+		{true, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501}, "hello "},
+		{true, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501}, "hello "},
+		{false, layers.TCP{ACK: true, PSH: true, Seq: 501, Ack: 113}, "ok\x00\x01"},
+		{true, layers.TCP{ACK: true, FIN: true, Seq: 113, Ack: 505}, ""},
+		{false, layers.TCP{ACK: true, FIN: true, Seq: 505, Ack: 114}, ""},
+	}
+	assert.Equal(t, "hello world\n", writeStream(t, segments, pcap.StreamClient))
+	assert.Equal(t, "ok\x00\x01", writeStream(t, segments, pcap.StreamServer))
+	expected := `===================================================================
+Follow: tcp,ascii
+Node 0: 10.0.0.1:40000
+Node 1: 10.0.0.2:80
+12
+hello world
+	4
+ok..
+===================================================================
+`
+	assert.Equal(t, expected, writeStream(t, segments, pcap.StreamFollow))
+
+	// Without a handshake, the server is the endpoint with the lower port
+	// even when it sends the first packet.
+	segments = []segment{
+		{false, layers.TCP{ACK: true, PSH: true, Seq: 501, Ack: 101}, "banner\n"},
+		{true, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 508}, "quit\n"},
+	}
+	assert.Equal(t, "quit\n", writeStream(t, segments, pcap.StreamClient))
+	assert.Equal(t, "banner\n", writeStream(t, segments, pcap.StreamServer))
+}
+
+func TestReassembleStaleConnection(t *testing.T) {
+	// The client's first payload arrives ten minutes after the rest, long
+	// after the connection has been flushed and closed, so it starts a new
+	// connection rather than filling the gap.
+	segments := []segment{
+		{true, layers.TCP{SYN: true, Seq: 100}, ""},
+		{false, layers.TCP{SYN: true, ACK: true, Seq: 500, Ack: 101}, ""},
+		{true, layers.TCP{ACK: true, Seq: 107, Ack: 501}, "world\n"},
+		{true, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501}, "hello "},
+	}
+	ts := []nano.Ts{1e9, 1e9 + 1000, 1e9 + 2000, 1e9 + 600e9}
+	r, err := pcapio.NewReader(bytes.NewReader(writePcapAt(t, segments, ts)))
+	require.NoError(t, err)
+	var payloads []string
+	var conns []int
+	err = pcap.Reassemble(context.Background(), r, func(s pcap.Segment) error {
+		payloads = append(payloads, string(s.Payload))
+		conns = append(conns, s.Conn)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"world\n", "hello "}, payloads)
+	assert.Equal(t, []int{0, 1}, conns)
+}
+
+func TestWriteStreamInvalidFormat(t *testing.T) {
+	r, err := pcapio.NewReader(bytes.NewReader(writePcap(t, nil)))
+	require.NoError(t, err)
+	err = pcap.WriteStream(context.Background(), &strings.Builder{}, r, "hex")
+	assert.EqualError(t, err, `invalid operation: unknown stream format "hex" (must be client, server, or follow)`)
+}
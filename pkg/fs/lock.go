@@ -0,0 +1,45 @@
+package fs
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrLocked is returned by TryLock when a conflicting lock is held.
+var ErrLocked = errors.New("file is locked")
+
+// A FileLock is an advisory lock on a file, held until Unlock is called or
+// the process exits.
+type FileLock struct {
+	f *os.File
+}
+
+// TryLock acquires an advisory lock on the named file, creating the file if
+// it does not exist and the caller may write to its directory.  If exclusive
+// is true, the lock excludes all other locks on the file; otherwise, it
+// excludes only exclusive locks.  TryLock does not block and returns
+// ErrLocked if a conflicting lock is held, including one held by the
+// calling process through another FileLock.
+func TryLock(name string, exclusive bool) (*FileLock, error) {
+	f, err := OpenFile(name, os.O_RDWR|os.O_CREATE, 0666)
+	if os.IsPermission(err) && !exclusive {
+		f, err = Open(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f, exclusive); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &FileLock{f}, nil
+}
+
+// Unlock releases the lock.
+func (l *FileLock) Unlock() error {
+	err := unlockFile(l.f)
+	if closeErr := l.f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
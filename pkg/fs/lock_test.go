@@ -0,0 +1,32 @@
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryLock(t *testing.T) {
+	tdir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(tdir)
+	name := filepath.Join(tdir, "lock")
+
+	shared1, err := TryLock(name, false)
+	require.NoError(t, err)
+	shared2, err := TryLock(name, false)
+	require.NoError(t, err)
+	_, err = TryLock(name, true)
+	require.Equal(t, ErrLocked, err)
+
+	require.NoError(t, shared1.Unlock())
+	require.NoError(t, shared2.Unlock())
+	excl, err := TryLock(name, true)
+	require.NoError(t, err)
+	_, err = TryLock(name, false)
+	require.Equal(t, ErrLocked, err)
+	require.NoError(t, excl.Unlock())
+}
@@ -2,9 +2,11 @@ package httpd
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"net"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -14,22 +16,37 @@ import (
 var ShutdownTimeout = time.Second * 5
 
 type Server struct {
-	addr   string
-	lnAddr string
-	logger *zap.Logger
-	srv    *http.Server
-	done   sync.WaitGroup
-	err    error
+	network string
+	addr    string
+	lnAddr  string
+	logger  *zap.Logger
+	srv     *http.Server
+	done    sync.WaitGroup
+	err     error
 }
 
+// New returns a server that listens on the TCP address addr.
 func New(addr string, h http.Handler) *Server {
-	return &Server{addr: addr, srv: &http.Server{Handler: h}, logger: zap.NewNop()}
+	return &Server{network: "tcp", addr: addr, srv: &http.Server{Handler: h}, logger: zap.NewNop()}
+}
+
+// NewUnix returns a server that listens on a Unix domain socket at path.
+// A stale socket left at path by a previous server is removed when the
+// server starts, and the socket is removed when the server shuts down.
+func NewUnix(path string, h http.Handler) *Server {
+	return &Server{network: "unix", addr: path, srv: &http.Server{Handler: h}, logger: zap.NewNop()}
 }
 
 func (s *Server) SetLogger(l *zap.Logger) {
 	s.logger = l
 }
 
+// SetTLSConfig configures the server to serve HTTPS.  conf must contain at
+// least one certificate.  It must be called before Start.
+func (s *Server) SetTLSConfig(conf *tls.Config) {
+	s.srv.TLSConfig = conf
+}
+
 func (s *Server) Addr() string {
 	return s.lnAddr
 }
@@ -37,13 +54,26 @@ func (s *Server) Addr() string {
 func (s *Server) Start(ctx context.Context) error {
 	s.done.Add(1)
 	s.srv.BaseContext = func(l net.Listener) context.Context { return ctx }
-	ln, err := net.Listen("tcp", s.addr)
+	if s.network == "unix" {
+		if err := removeStaleSocket(s.addr); err != nil {
+			s.logger.Error("Listen error", zap.Error(err))
+			return err
+		}
+	}
+	ln, err := net.Listen(s.network, s.addr)
 	if err != nil {
 		s.logger.Error("Listen error", zap.Error(err))
 		return err
 	}
+	if s.srv.TLSConfig != nil {
+		ln = tls.NewListener(ln, s.srv.TLSConfig)
+	}
 	s.lnAddr = ln.Addr().String()
-	s.logger.Info("Listening", zap.String("addr", s.lnAddr))
+	s.logger.Info("Listening",
+		zap.String("network", s.network),
+		zap.String("addr", s.lnAddr),
+		zap.Bool("tls", s.srv.TLSConfig != nil),
+	)
 	go s.serve(ctx, ln)
 	return nil
 }
@@ -94,3 +124,19 @@ func (s *Server) serve(ctx context.Context, ln net.Listener) {
 	s.logger.Info("Closed", zap.Error(err))
 	s.err = err
 }
+
+// removeStaleSocket removes the Unix domain socket at path, if any, so that
+// a server can listen there.  Files other than sockets are left alone.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return errors.New(path + ": file exists and is not a socket")
+	}
+	return os.Remove(path)
+}
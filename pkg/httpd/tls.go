@@ -0,0 +1,31 @@
+package httpd
+
+import (
+	"crypto/tls"
+
+	"github.com/brimsec/zq/pkg/tlsutil"
+)
+
+// TLSConfig returns a server TLS configuration using the PEM-encoded
+// certificate and key in certFile and keyFile.  If clientCAFile is not
+// empty, clients must present a certificate signed by one of the
+// PEM-encoded certificate authorities in that file.
+func TLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := tlsutil.LoadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		conf.ClientCAs = pool
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return conf, nil
+}
@@ -43,12 +43,13 @@ func (r *Reader) fill(min int) error {
 	r.buffer = r.buffer[:cap(r.buffer)]
 	copy(r.buffer, r.cursor)
 	clen := len(r.cursor)
-	space := len(r.buffer) - clen
-	for space > 0 {
+	// Stop once min bytes are buffered rather than when the buffer is
+	// full so that a reader of a stream, such as a live tail, is not held
+	// up waiting for data that has yet to be sent.
+	for clen < min {
 		cc, err := r.Reader.Read(r.buffer[clen:])
 		if cc > 0 {
 			clen += cc
-			space -= cc
 		}
 		if err != nil {
 			if err == io.EOF {
@@ -64,14 +65,14 @@ func (r *Reader) fill(min int) error {
 }
 
 func (r *Reader) Peek(n int) ([]byte, error) {
-	if len(r.cursor) == 0 && r.eof {
-		return nil, io.EOF
-	}
 	if n > len(r.cursor) && !r.eof {
 		if err := r.fill(n); err != nil {
 			return nil, err
 		}
 	}
+	if len(r.cursor) == 0 && r.eof {
+		return nil, io.EOF
+	}
 	if n > len(r.cursor) {
 		return r.cursor, ErrTruncated
 	}
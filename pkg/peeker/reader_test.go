@@ -0,0 +1,91 @@
+package peeker
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortReads(t *testing.T) {
+	input := []byte("0123456789")
+	for _, r := range []io.Reader{
+		iotest.OneByteReader(bytes.NewReader(input)),
+		iotest.HalfReader(bytes.NewReader(input)),
+		iotest.DataErrReader(bytes.NewReader(input)),
+	} {
+		p := NewReader(r, 4, 100)
+		b, err := p.Read(3)
+		require.NoError(t, err)
+		require.Equal(t, "012", string(b))
+		b, err = p.Peek(6)
+		require.NoError(t, err)
+		require.Equal(t, "345678", string(b))
+		b, err = p.Read(7)
+		require.NoError(t, err)
+		require.Equal(t, "3456789", string(b))
+		_, err = p.Peek(1)
+		require.Equal(t, io.EOF, err)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	p := NewReader(bytes.NewReader([]byte("abc")), 16, 100)
+	b, err := p.Peek(5)
+	require.Equal(t, ErrTruncated, err)
+	require.Equal(t, "abc", string(b))
+	b, err = p.Read(3)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(b))
+	_, err = p.Read(1)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestEmpty(t *testing.T) {
+	p := NewReader(bytes.NewReader(nil), 16, 100)
+	_, err := p.Peek(1)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestBufferOverflow(t *testing.T) {
+	p := NewReader(bytes.NewReader(make([]byte, 200)), 16, 100)
+	_, err := p.Peek(101)
+	require.Equal(t, ErrBufferOverflow, err)
+	b, err := p.Read(100)
+	require.NoError(t, err)
+	require.Len(t, b, 100)
+}
+
+var errBlocked = errors.New("read past available data")
+
+// streamReader returns its data and then fails, like a stream with no more
+// data yet available.
+type streamReader struct {
+	data []byte
+}
+
+func (s *streamReader) Read(b []byte) (int, error) {
+	if len(s.data) == 0 {
+		return 0, errBlocked
+	}
+	n := copy(b, s.data)
+	s.data = s.data[n:]
+	return n, nil
+}
+
+func TestFillStopsAtMin(t *testing.T) {
+	// The buffer is larger than the available data, so a fill that
+	// tried to fill the buffer would read past the available data.
+	p := NewReader(&streamReader{[]byte("abcdef")}, 64, 100)
+	b, err := p.Read(2)
+	require.NoError(t, err)
+	require.Equal(t, "ab", string(b))
+	b, err = p.Read(4)
+	require.NoError(t, err)
+	require.Equal(t, "cdef", string(b))
+	_, err = p.Read(1)
+	require.Equal(t, errBlocked, err)
+}
@@ -1,4 +1,7 @@
-package detector
+// Package recorder provides readers that record the data read from an
+// io.Reader so that it can be read again, e.g., to try several parsers while
+// detecting the format of a stream.
+package recorder
 
 import (
 	"errors"
@@ -10,13 +13,16 @@ var ErrBufferOverflow = errors.New("buffer exceeded max size trying to infer inp
 const MaxBufferSize = 10 * 1024 * 1024
 const InitBufferSize = 8 * 1024
 
+// Recorder buffers the data read from an underlying reader.  Tracks read the
+// buffered data from the start, and reads from the Recorder itself consume
+// the buffer before reading from the underlying reader.
 type Recorder struct {
 	io.Reader
 	eof    bool
 	buffer []byte
 }
 
-func NewRecorder(r io.Reader) *Recorder {
+func New(r io.Reader) *Recorder {
 	return &Recorder{
 		Reader: r,
 		buffer: make([]byte, 0, InitBufferSize),
@@ -1,7 +1,8 @@
-package detector
+package recorder
 
 const TrackSize = InitBufferSize
 
+// Track reads the data buffered by a Recorder from the start.
 type Track struct {
 	recorder *Recorder
 	off      int
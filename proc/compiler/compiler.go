@@ -13,6 +13,7 @@ import (
 	"github.com/brimsec/zq/proc/fuse"
 	"github.com/brimsec/zq/proc/groupby"
 	"github.com/brimsec/zq/proc/head"
+	"github.com/brimsec/zq/proc/lookup"
 	"github.com/brimsec/zq/proc/merge"
 	"github.com/brimsec/zq/proc/orderedmerge"
 	"github.com/brimsec/zq/proc/pass"
@@ -23,6 +24,7 @@ import (
 	"github.com/brimsec/zq/proc/tail"
 	"github.com/brimsec/zq/proc/top"
 	"github.com/brimsec/zq/proc/uniq"
+	"github.com/brimsec/zq/proc/window"
 )
 
 type Hook func(ast.Proc, *proc.Context, proc.Interface) (proc.Interface, error)
@@ -94,14 +96,14 @@ func compileProc(custom Hook, node ast.Proc, pctx *proc.Context, parent proc.Int
 		return pass.New(parent), nil
 
 	case *ast.FilterProc:
-		f, err := filter.Compile(v.Filter)
+		f, err := filter.Compile(pctx.TypeContext, v.Filter)
 		if err != nil {
 			return nil, fmt.Errorf("compiling filter: %w", err)
 		}
 		return filterproc.New(parent, f), nil
 
 	case *ast.TopProc:
-		fields, err := expr.CompileExprs(v.Fields)
+		fields, err := expr.CompileExprs(pctx.TypeContext, v.Fields)
 		if err != nil {
 			return nil, fmt.Errorf("compiling top: %w", err)
 		}
@@ -123,6 +125,20 @@ func compileProc(custom Hook, node ast.Proc, pctx *proc.Context, parent proc.Int
 
 	case *ast.FuseProc:
 		return fuse.New(pctx, parent)
+
+	case *ast.LookupProc:
+		lookup, err := lookup.New(pctx, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return lookup, nil
+
+	case *ast.WindowProc:
+		window, err := window.New(pctx, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return window, nil
 	}
 }
 
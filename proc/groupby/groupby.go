@@ -16,6 +16,7 @@ import (
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqe"
 )
 
 // Key represents the name of the column (target) and an expression for
@@ -45,8 +46,8 @@ func (e errTooBig) Error() string {
 }
 
 func IsErrTooBig(err error) bool {
-	_, ok := err.(errTooBig)
-	return ok
+	var e errTooBig
+	return errors.As(err, &e)
 }
 
 var DefaultLimit = 1000000
@@ -55,7 +56,7 @@ func CompileParams(node *ast.GroupByProc, zctx *resolver.Context) (*Params, erro
 	keys := []Key{}
 	var targets []string
 	for _, astKey := range node.Keys {
-		ex, err := expr.CompileExpr(astKey.Expr)
+		ex, err := expr.CompileExpr(zctx, astKey.Expr)
 		if err != nil {
 			return nil, fmt.Errorf("compiling groupby: %w", err)
 		}
@@ -67,7 +68,7 @@ func CompileParams(node *ast.GroupByProc, zctx *resolver.Context) (*Params, erro
 	}
 	reducers := []compile.CompiledReducer{}
 	for _, reducer := range node.Reducers {
-		compiled, err := compile.Compile(reducer)
+		compiled, err := compile.Compile(zctx, reducer)
 		if err != nil {
 			return nil, err
 		}
@@ -158,6 +159,9 @@ func NewAggregator(c *proc.Context, params Params) *Aggregator {
 	if limit == 0 {
 		limit = DefaultLimit
 	}
+	if c.GroupByLimit > 0 && limit > c.GroupByLimit {
+		limit = c.GroupByLimit
+	}
 	var valueCompare expr.ValueCompareFn
 	var keyCompare, keysCompare expr.CompareFn
 
@@ -406,7 +410,7 @@ func (a *Aggregator) Consume(r *zng.Record) error {
 	if !ok {
 		if len(a.table) >= a.limit {
 			if !a.decomposable {
-				return errTooBig(a.limit)
+				return zqe.E(zqe.LimitExceeded, errTooBig(a.limit))
 			}
 			if err := a.spillTable(false); err != nil {
 				return err
@@ -0,0 +1,369 @@
+package lookup
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/microindex"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Proc is a proc that enriches each record with the fields of the
+// record of a table whose key matches the value of an expression.  Keys
+// are compared by their text form, so an ip field of the input matches
+// a string key of a CSV table.  The fields of the matched record other
+// than its key are appended to the input record, replacing any input
+// field of the same name.
+type Proc struct {
+	pctx   *proc.Context
+	parent proc.Interface
+	key    expr.Evaluator
+	table  table
+	miss   string
+	rules  map[ruleKey]*rule
+}
+
+// A table holds the records looked up by the proc.
+type table interface {
+	// lookup returns the record with the given key or nil if there
+	// is none.
+	lookup(key string) (*zng.Record, error)
+	// isKey returns true if the named top-level field is a key of
+	// the table, and so is not merged into the output.
+	isKey(field string) bool
+	// nullType returns the type of the fields given null values for
+	// a record without a match, or nil if the table is empty.
+	nullType() (*zng.TypeRecord, error)
+	close() error
+}
+
+type ruleKey struct {
+	in    int
+	table int
+}
+
+// A rule describes how the output record is built from an input record
+// and a table record of given types.  Each output column is taken from
+// the column of the input or table record indicated by its source.
+type rule struct {
+	typ  *zng.TypeRecord
+	cols []source
+}
+
+type source struct {
+	table     bool
+	column    int
+	container bool
+}
+
+func New(pctx *proc.Context, parent proc.Interface, node *ast.LookupProc) (*Proc, error) {
+	key, err := expr.CompileExpr(pctx.TypeContext, node.Key)
+	if err != nil {
+		return nil, err
+	}
+	miss := node.Miss
+	switch miss {
+	case "":
+		miss = "keep"
+	case "keep", "drop", "null":
+	default:
+		return nil, fmt.Errorf("lookup: unknown miss policy %q", miss)
+	}
+	if node.Table == "" {
+		return nil, errors.New("lookup: a -file or -index table is required")
+	}
+	var t table
+	if node.Index {
+		if node.KeyField != "" {
+			return nil, errors.New("lookup: -key applies only to a -file table")
+		}
+		t, err = openIndex(pctx, node.Table)
+	} else {
+		t, err = loadFile(pctx.TypeContext, node.Table, node.KeyField)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lookup: %w", err)
+	}
+	return &Proc{
+		pctx:   pctx,
+		parent: parent,
+		key:    key,
+		table:  t,
+		miss:   miss,
+		rules:  make(map[ruleKey]*rule),
+	}, nil
+}
+
+func (p *Proc) Pull() (zbuf.Batch, error) {
+	batch, err := p.parent.Pull()
+	if proc.EOS(batch, err) {
+		p.table.close()
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		in := batch.Index(k)
+		rec, err := p.match(in)
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			switch p.miss {
+			case "drop":
+				continue
+			case "null":
+				typ, err := p.table.nullType()
+				if err != nil {
+					return nil, err
+				}
+				if typ != nil {
+					rec = zng.NewRecord(typ, nil)
+				}
+			}
+		}
+		if rec == nil {
+			out = append(out, in.Keep())
+			continue
+		}
+		merged, err := p.merge(in, rec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, merged)
+	}
+	return zbuf.Array(out), nil
+}
+
+// match returns the table record matching the key of in, or nil if
+// there is none or in has no key.
+func (p *Proc) match(in *zng.Record) (*zng.Record, error) {
+	v, err := p.key.Eval(in)
+	if err != nil || v.Type == nil || v.Bytes == nil {
+		return nil, nil
+	}
+	return p.table.lookup(v.Format(zng.OutFormatUnescaped))
+}
+
+// merge returns in with the fields of rec appended.  If the body of rec is
+// nil, the appended fields are null.
+func (p *Proc) merge(in, rec *zng.Record) (*zng.Record, error) {
+	key := ruleKey{in.Type.ID(), rec.Type.ID()}
+	r, ok := p.rules[key]
+	if !ok {
+		var err error
+		r, err = p.buildRule(in.Type, rec.Type)
+		if err != nil {
+			return nil, err
+		}
+		p.rules[key] = r
+	}
+	inVals, err := columnBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	var tableVals []zcode.Bytes
+	if rec.Raw != nil {
+		if tableVals, err = columnBytes(rec); err != nil {
+			return nil, err
+		}
+	}
+	var b zcode.Bytes
+	for _, s := range r.cols {
+		var val zcode.Bytes
+		if !s.table {
+			val = inVals[s.column]
+		} else if tableVals != nil {
+			val = tableVals[s.column]
+		}
+		b = zcode.AppendAs(b, s.container, val)
+	}
+	return zng.NewRecord(r.typ, b), nil
+}
+
+func (p *Proc) buildRule(in, table *zng.TypeRecord) (*rule, error) {
+	var cols []zng.Column
+	var sources []source
+	for k, c := range in.Columns {
+		s := source{column: k, container: zng.IsContainerType(c.Type)}
+		if position, ok := table.ColumnOfField(c.Name); ok && !p.table.isKey(c.Name) {
+			c = table.Columns[position]
+			s = source{table: true, column: position, container: zng.IsContainerType(c.Type)}
+		}
+		cols = append(cols, c)
+		sources = append(sources, s)
+	}
+	for k, c := range table.Columns {
+		if p.table.isKey(c.Name) || in.HasField(c.Name) {
+			continue
+		}
+		cols = append(cols, c)
+		sources = append(sources, source{table: true, column: k, container: zng.IsContainerType(c.Type)})
+	}
+	typ, err := p.pctx.TypeContext.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	return &rule{typ, sources}, nil
+}
+
+func columnBytes(rec *zng.Record) ([]zcode.Bytes, error) {
+	vals := make([]zcode.Bytes, 0, len(rec.Type.Columns))
+	for it := rec.Raw.Iter(); !it.Done(); {
+		val, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) != len(rec.Type.Columns) {
+		return nil, errors.New("record body does not match its type")
+	}
+	return vals, nil
+}
+
+func (p *Proc) Done() {
+	p.table.close()
+	p.parent.Done()
+}
+
+// fileTable is a table read into memory from a file.
+type fileTable struct {
+	keyField string
+	recs     map[string]*zng.Record
+	first    *zng.TypeRecord
+}
+
+// loadFile reads the records of the file at path into a table keyed by
+// keyField or, if keyField is empty, the first field of the first record.
+// A file whose name ends in ".csv" is read as CSV.  Records without the
+// key field are skipped and the first record with a given key is kept.
+func loadFile(zctx *resolver.Context, path, keyField string) (*fileTable, error) {
+	var opts zio.ReaderOpts
+	if strings.HasSuffix(strings.ToLower(path), ".csv") {
+		opts.Format = "csv"
+	}
+	f, err := detector.OpenFile(zctx, path, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t := &fileTable{
+		keyField: keyField,
+		recs:     make(map[string]*zng.Record),
+	}
+	for {
+		rec, err := f.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			return t, nil
+		}
+		if t.first == nil {
+			t.first = rec.Type
+			if t.keyField == "" {
+				if len(rec.Type.Columns) == 0 {
+					return nil, fmt.Errorf("%s: record has no fields", path)
+				}
+				t.keyField = rec.Type.Columns[0].Name
+			}
+		}
+		v, err := rec.ValueByField(t.keyField)
+		if err != nil || v.Bytes == nil {
+			continue
+		}
+		key := v.Format(zng.OutFormatUnescaped)
+		if _, ok := t.recs[key]; !ok {
+			t.recs[key] = rec.Keep()
+		}
+	}
+}
+
+func (t *fileTable) lookup(key string) (*zng.Record, error) {
+	return t.recs[key], nil
+}
+
+func (t *fileTable) isKey(field string) bool {
+	return field == t.keyField
+}
+
+func (t *fileTable) nullType() (*zng.TypeRecord, error) {
+	return t.first, nil
+}
+
+func (t *fileTable) close() error {
+	return nil
+}
+
+// indexTable is a table searched in a microindex for each lookup.  Only
+// the first key of the microindex is looked up.
+type indexTable struct {
+	finder *microindex.Finder
+	keys   *zng.TypeRecord
+	typ    *zng.TypeRecord
+	closed bool
+}
+
+func openIndex(pctx *proc.Context, path string) (*indexTable, error) {
+	uri, err := iosrc.ParseURI(path)
+	if err != nil {
+		return nil, err
+	}
+	finder, err := microindex.NewFinder(pctx, pctx.TypeContext, uri)
+	if err != nil {
+		return nil, err
+	}
+	return &indexTable{
+		finder: finder,
+		keys:   finder.Keys(),
+	}, nil
+}
+
+func (t *indexTable) lookup(key string) (*zng.Record, error) {
+	keys, err := t.finder.ParseKeys([]string{key})
+	if err != nil || keys == nil {
+		// A key that does not parse as the type of the
+		// microindex key can't match.
+		return nil, nil
+	}
+	return t.finder.Lookup(keys)
+}
+
+func (t *indexTable) isKey(field string) bool {
+	return t.keys != nil && t.keys.HasField(field)
+}
+
+func (t *indexTable) nullType() (*zng.TypeRecord, error) {
+	if t.typ != nil || t.finder.IsEmpty() {
+		return t.typ, nil
+	}
+	reader, err := t.finder.NewSectionReader(0)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := reader.Read()
+	if err != nil || rec == nil {
+		return nil, err
+	}
+	t.typ = rec.Type
+	return t.typ, nil
+}
+
+func (t *indexTable) close() error {
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	return t.finder.Close()
+}
@@ -40,6 +40,13 @@ type Context struct {
 	TypeContext *resolver.Context
 	Logger      *zap.Logger
 	Warnings    chan string
+	// SortMemMaxBytes, if non-zero, overrides sort.MemMaxBytes for the
+	// sort procs of the flowgraph.
+	SortMemMaxBytes int
+	// GroupByLimit, if non-zero, caps the number of groups that each
+	// groupby proc of the flowgraph holds in memory and the number of
+	// partitions of each window proc.
+	GroupByLimit int
 }
 
 func EOS(batch zbuf.Batch, err error) bool {
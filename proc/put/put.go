@@ -1,6 +1,9 @@
 package put
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/proc"
@@ -11,12 +14,14 @@ import (
 
 // Put is a proc that modifies the record stream with computed values.
 // Each new value is called a clause and consists of a field name and
-// an expression.  Currently, the field name must be a top-level record
-// name, i.e., it cannot be a dotted record access or array reference
-// (XXX this will change when we add more comprehensive expression support).
-// Each put clause either replaces an existing value in the column specified
-// or appends a value as a new column.  Appended values appear as new
-// columns in the order that the clause appears in the put expression.
+// an expression.  The field name may be a dotted path into a record field
+// (e.g., id.resp_net), in which case the value is set in the nested record,
+// which is created if it doesn't exist.  Clauses for the same top-level
+// field are combined, in the order they appear, into a single value for
+// that field.  Each put clause either replaces an existing value in the
+// column specified or appends a value as a new column.  Appended values
+// appear as new columns in the order that the clause appears in the put
+// expression.
 type Proc struct {
 	pctx    *proc.Context
 	parent  proc.Interface
@@ -50,26 +55,46 @@ type clauseType struct {
 	container bool
 }
 
+// A clause computes the value of a top-level field from the assignments
+// to the field and the fields nested within it.
 type clause struct {
-	target string
-	eval   expr.Evaluator
+	target  string
+	assigns []assignment
+}
+
+// An assignment sets the field at path within its clause's target or, if
+// path is nil, the target itself.
+type assignment struct {
+	path []string
+	eval expr.Evaluator
 }
 
 func New(pctx *proc.Context, parent proc.Interface, node *ast.PutProc) (proc.Interface, error) {
-	clauses := make([]clause, len(node.Clauses))
-	for k, cl := range node.Clauses {
-		var err error
-		clauses[k].target = cl.Target
-		clauses[k].eval, err = expr.CompileExpr(cl.Expr)
+	var clauses []clause
+	positions := make(map[string]int)
+	for _, cl := range node.Clauses {
+		eval, err := expr.CompileExpr(pctx.TypeContext, cl.Expr)
 		if err != nil {
 			return nil, err
 		}
+		path := strings.Split(cl.Target, ".")
+		a := assignment{eval: eval}
+		if len(path) > 1 {
+			a.path = path[1:]
+		}
+		k, ok := positions[path[0]]
+		if !ok {
+			k = len(clauses)
+			positions[path[0]] = k
+			clauses = append(clauses, clause{target: path[0]})
+		}
+		clauses[k].assigns = append(clauses[k].assigns, a)
 	}
 	return &Proc{
 		pctx:    pctx,
 		parent:  parent,
 		clauses: clauses,
-		vals:    make([]zng.Value, len(node.Clauses)),
+		vals:    make([]zng.Value, len(clauses)),
 		rules:   make(map[int]*putRule),
 		warned:  make(map[string]struct{}),
 	}, nil
@@ -88,7 +113,11 @@ func (p *Proc) eval(in *zng.Record) ([]zng.Value, error) {
 	vals := p.vals
 	for k, cl := range p.clauses {
 		var err error
-		vals[k], err = cl.eval.Eval(in)
+		if len(cl.assigns) == 1 && cl.assigns[0].path == nil {
+			vals[k], err = cl.assigns[0].eval.Eval(in)
+		} else {
+			vals[k], err = p.evalNested(in, cl)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -96,6 +125,95 @@ func (p *Proc) eval(in *zng.Record) ([]zng.Value, error) {
 	return vals, nil
 }
 
+// evalNested computes the value of a clause by applying its assignments
+// in turn to the value of its target in the input record.
+func (p *Proc) evalNested(in *zng.Record, cl clause) (zng.Value, error) {
+	val, err := in.ValueByField(cl.target)
+	if err != nil {
+		val = zng.Value{}
+	}
+	for _, a := range cl.assigns {
+		zv, err := a.eval.Eval(in)
+		if err != nil {
+			return zng.Value{}, err
+		}
+		if a.path == nil {
+			val = zv.Copy()
+			continue
+		}
+		val, err = p.setField(val, cl.target, a.path, zv)
+		if err != nil {
+			return zng.Value{}, err
+		}
+	}
+	return val, nil
+}
+
+// setField returns a copy of the record value rec, named name, with the
+// field at path set to val.  Records along the path that don't exist
+// are created, and fields that don't exist are appended.
+func (p *Proc) setField(rec zng.Value, name string, path []string, val zng.Value) (zng.Value, error) {
+	var cols []zng.Column
+	var vals []zcode.Bytes
+	if rec.Type != nil && rec.Type != zng.TypeNull {
+		typ, ok := zng.AliasedType(rec.Type).(*zng.TypeRecord)
+		if !ok {
+			return zng.Value{}, fmt.Errorf("put: %s is not a record", name)
+		}
+		cols = append(cols, typ.Columns...)
+		vals = make([]zcode.Bytes, 0, len(cols))
+		if rec.Bytes == nil {
+			vals = vals[:len(cols)]
+		}
+		for it := rec.Bytes.Iter(); !it.Done(); {
+			zv, _, err := it.Next()
+			if err != nil {
+				return zng.Value{}, err
+			}
+			vals = append(vals, zv)
+		}
+	}
+	var child zng.Value
+	position := -1
+	for k, c := range cols {
+		if c.Name == path[0] {
+			position = k
+			child = zng.Value{c.Type, vals[k]}
+			break
+		}
+	}
+	if len(path) > 1 {
+		var err error
+		child, err = p.setField(child, name+"."+path[0], path[1:], val)
+		if err != nil {
+			return zng.Value{}, err
+		}
+	} else {
+		child = val
+	}
+	typ := child.Type
+	if typ == nil {
+		typ = zng.TypeNull
+	}
+	col := zng.Column{Name: path[0], Type: typ}
+	if position >= 0 {
+		cols[position] = col
+		vals[position] = child.Bytes
+	} else {
+		cols = append(cols, col)
+		vals = append(vals, child.Bytes)
+	}
+	recType, err := p.pctx.TypeContext.LookupTypeRecord(cols)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	var b zcode.Bytes
+	for k, c := range cols {
+		b = zcode.AppendAs(b, zng.IsContainerType(c.Type), vals[k])
+	}
+	return zng.Value{recType, b}, nil
+}
+
 func (p *Proc) buildRule(inType *zng.TypeRecord, vals []zng.Value) (*putRule, error) {
 	n := len(inType.Columns)
 	cols := make([]zng.Column, n, n+len(p.clauses))
@@ -31,7 +31,7 @@ type Proc struct {
 }
 
 func New(pctx *proc.Context, parent proc.Interface, node *ast.SortProc) (*Proc, error) {
-	fieldResolvers, err := expr.CompileExprs(node.Fields)
+	fieldResolvers, err := expr.CompileExprs(pctx.TypeContext, node.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +105,10 @@ func (p *Proc) sendResult(b zbuf.Batch, err error) {
 }
 
 func (p *Proc) recordsForOneRun() ([]*zng.Record, bool, error) {
+	memMaxBytes := MemMaxBytes
+	if p.pctx.SortMemMaxBytes != 0 {
+		memMaxBytes = p.pctx.SortMemMaxBytes
+	}
 	var nbytes int
 	var recs []*zng.Record
 	for {
@@ -123,7 +127,7 @@ func (p *Proc) recordsForOneRun() ([]*zng.Record, bool, error) {
 			// We're keeping records owned by batch so don't call Unref.
 			recs = append(recs, rec)
 		}
-		if nbytes >= MemMaxBytes {
+		if nbytes >= memMaxBytes {
 			return recs, false, nil
 		}
 	}
@@ -0,0 +1,109 @@
+package spill
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// SortedReader is a zbuf.Reader that returns the records of another reader
+// in sorted order.  On the first call to Read, it reads all of the records
+// and sorts them in runs of up to memMaxBytes.  If the records do not fit
+// in a single run, the runs are spilled to disk and merged as they are
+// read back.
+type SortedReader struct {
+	reader      zbuf.Reader
+	compareFn   expr.CompareFn
+	memMaxBytes int
+	sorted      zbuf.Reader
+	merger      *MergeSort
+}
+
+// NewSortedReader returns a SortedReader that sorts the records of r
+// according to compareFn.  Call Close to remove any spilled runs.
+func NewSortedReader(r zbuf.Reader, compareFn expr.CompareFn, memMaxBytes int) *SortedReader {
+	return &SortedReader{
+		reader:      r,
+		compareFn:   compareFn,
+		memMaxBytes: memMaxBytes,
+	}
+}
+
+func (s *SortedReader) Read() (*zng.Record, error) {
+	if s.sorted == nil {
+		if err := s.sort(); err != nil {
+			return nil, err
+		}
+	}
+	return s.sorted.Read()
+}
+
+func (s *SortedReader) sort() error {
+	var recs []*zng.Record
+	var nbytes int
+	for {
+		rec, err := s.reader.Read()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec.Keep())
+		nbytes += len(rec.Raw)
+		if nbytes >= s.memMaxBytes {
+			if err := s.spill(recs); err != nil {
+				return err
+			}
+			recs = nil
+			nbytes = 0
+		}
+	}
+	if s.merger == nil {
+		expr.SortStable(recs, s.compareFn)
+		s.sorted = zbuf.Array(recs).NewReader()
+		return nil
+	}
+	if len(recs) > 0 {
+		if err := s.spill(recs); err != nil {
+			return err
+		}
+	}
+	s.sorted = s.merger
+	return nil
+}
+
+func (s *SortedReader) spill(recs []*zng.Record) error {
+	if s.merger == nil {
+		var err error
+		if s.merger, err = NewMergeSort(s.compareFn); err != nil {
+			return err
+		}
+	}
+	return s.merger.Spill(recs)
+}
+
+func (s *SortedReader) Close() error {
+	if s.merger != nil {
+		s.merger.Cleanup()
+	}
+	return nil
+}
+
+// TimeCompareFn returns a function that compares records by timestamp in
+// the order of dir.
+func TimeCompareFn(dir zbuf.Direction) expr.CompareFn {
+	return func(a, b *zng.Record) int {
+		ta, tb := a.Ts(), b.Ts()
+		if dir == zbuf.DirTimeReverse {
+			ta, tb = tb, ta
+		}
+		switch {
+		case ta < tb:
+			return -1
+		case ta > tb:
+			return 1
+		}
+		return 0
+	}
+}
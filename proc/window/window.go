@@ -0,0 +1,322 @@
+package window
+
+import (
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/proc/groupby"
+	"github.com/brimsec/zq/reducer/compile"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zqe"
+)
+
+// Proc is a proc that computes running aggregates.  Each record is
+// passed with the results of the reducers over a frame of the records of
+// its partition appended, replacing any input fields of the same name.
+// The partition of a record is determined by the values of the key
+// expressions, and its frame is one of
+//
+//   - the last N records of the partition, including the record,
+//   - the records of the partition whose ts is within a duration of
+//     the record's ts, or
+//   - every record of the partition up to and including the record.
+//
+// Frames follow the order of the input, which is expected to be sorted
+// by ts in either direction.
+//
+// The results of a sliding frame are computed anew from the records of the
+// frame, so each record costs time proportional to the size of its frame.
+// A range partition is dropped once its last record is out of range of the
+// input, and the number of partitions held is bounded like the groups of
+// a groupby: a window exceeding the limit fails with an error of kind
+// zqe.LimitExceeded.
+type Proc struct {
+	pctx       *proc.Context
+	parent     proc.Interface
+	keys       []expr.Evaluator
+	reducers   []compile.CompiledReducer
+	rows       int
+	span       int64
+	limit      int
+	partitions map[string]*partition
+	// order holds the range partitions from least to most recently
+	// used, which, as the input is sorted, is the order of their last
+	// records.
+	order    *list.List
+	rules    map[ruleKey]*rule
+	keyBytes []byte
+}
+
+// A partition holds the records of the frame of a sliding window or, for
+// a running aggregate, the reducers of every record so far.
+type partition struct {
+	key  string
+	recs []*zng.Record
+	row  *compile.Row
+	elem *list.Element
+}
+
+type ruleKey struct {
+	in     int
+	result int
+}
+
+// A rule describes how the output record is built from an input record
+// and a record of reducer results of given types.
+type rule struct {
+	typ  *zng.TypeRecord
+	cols []source
+}
+
+type source struct {
+	result    bool
+	column    int
+	container bool
+}
+
+func New(pctx *proc.Context, parent proc.Interface, node *ast.WindowProc) (*Proc, error) {
+	if node.Rows < 0 {
+		return nil, errors.New("window: -rows must be positive")
+	}
+	if node.Rows > 0 && node.Range.Seconds > 0 {
+		return nil, errors.New("window: -rows and -range may not both be specified")
+	}
+	var keys []expr.Evaluator
+	for _, key := range node.Keys {
+		e, err := expr.CompileExpr(pctx.TypeContext, key.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("compiling window: %w", err)
+		}
+		keys = append(keys, e)
+	}
+	var reducers []compile.CompiledReducer
+	for _, r := range node.Reducers {
+		compiled, err := compile.Compile(pctx.TypeContext, r)
+		if err != nil {
+			return nil, err
+		}
+		reducers = append(reducers, compiled)
+	}
+	limit := groupby.DefaultLimit
+	if pctx.GroupByLimit > 0 && limit > pctx.GroupByLimit {
+		limit = pctx.GroupByLimit
+	}
+	return &Proc{
+		pctx:       pctx,
+		parent:     parent,
+		keys:       keys,
+		reducers:   reducers,
+		rows:       node.Rows,
+		span:       int64(node.Range.Seconds) * 1_000_000_000,
+		limit:      limit,
+		partitions: make(map[string]*partition),
+		order:      list.New(),
+		rules:      make(map[ruleKey]*rule),
+	}, nil
+}
+
+func (p *Proc) Pull() (zbuf.Batch, error) {
+	batch, err := p.parent.Pull()
+	if proc.EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		in := batch.Index(k).Keep()
+		result, err := p.consume(in)
+		if err != nil {
+			return nil, err
+		}
+		rec, err := p.merge(in, result)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+	return zbuf.Array(out), nil
+}
+
+// consume adds rec to its partition and returns the results of the
+// reducers over its frame.
+func (p *Proc) consume(rec *zng.Record) (*zng.Record, error) {
+	key, err := p.partitionKey(rec)
+	if err != nil {
+		return nil, err
+	}
+	if p.span > 0 {
+		p.expire(rec.Ts())
+	}
+	part, ok := p.partitions[key]
+	if !ok {
+		if len(p.partitions) >= p.limit {
+			return nil, zqe.E(zqe.LimitExceeded, "window exceeded configured partition limit (%d)", p.limit)
+		}
+		part = &partition{key: key}
+		if p.span > 0 {
+			part.elem = p.order.PushBack(part)
+		}
+		p.partitions[key] = part
+	} else if part.elem != nil {
+		p.order.MoveToBack(part.elem)
+	}
+	if p.rows == 0 && p.span == 0 {
+		if part.row == nil {
+			row := compile.NewRow(p.reducers)
+			part.row = &row
+		}
+		part.row.Consume(rec)
+		return part.row.Result(p.pctx.TypeContext)
+	}
+	part.recs = append(part.recs, rec)
+	var n int
+	if p.rows > 0 {
+		if len(part.recs) > p.rows {
+			n = len(part.recs) - p.rows
+		}
+	} else {
+		ts := rec.Ts()
+		for n < len(part.recs) && p.outOfRange(part.recs[n], ts) {
+			n++
+		}
+	}
+	if n > 0 {
+		part.recs = append(part.recs[:0], part.recs[n:]...)
+	}
+	// Reducers can't remove a record from their result, so the
+	// results of a sliding frame are computed anew for each record.
+	row := compile.NewRow(p.reducers)
+	for _, r := range part.recs {
+		row.Consume(r)
+	}
+	return row.Result(p.pctx.TypeContext)
+}
+
+// expire drops the range partitions whose last record is out of range of
+// ts.  Since the input is sorted, no later record can fall in their frames.
+func (p *Proc) expire(ts nano.Ts) {
+	for e := p.order.Front(); e != nil; e = p.order.Front() {
+		part := e.Value.(*partition)
+		if !p.outOfRange(part.recs[len(part.recs)-1], ts) {
+			return
+		}
+		p.order.Remove(e)
+		delete(p.partitions, part.key)
+	}
+}
+
+// outOfRange returns true if the ts of rec is at least the range of the
+// window away from ts in either direction.
+func (p *Proc) outOfRange(rec *zng.Record, ts nano.Ts) bool {
+	d := int64(ts - rec.Ts())
+	if d < 0 {
+		d = -d
+	}
+	return d >= p.span
+}
+
+// partitionKey returns the values of the key expressions of rec encoded
+// with their types.  A key missing from rec is encoded as a null value
+// of no type.
+func (p *Proc) partitionKey(rec *zng.Record) (string, error) {
+	b := p.keyBytes[:0]
+	for _, key := range p.keys {
+		v, err := key.Eval(rec)
+		if err != nil && !errors.Is(err, expr.ErrNoSuchField) && !errors.Is(err, zng.ErrUnset) {
+			return "", err
+		}
+		id := -1
+		if v.Type != nil {
+			id = v.Type.ID()
+		}
+		var buf [binary.MaxVarintLen64]byte
+		n := binary.PutVarint(buf[:], int64(id))
+		b = append(b, buf[:n]...)
+		b = zcode.AppendAs(b, v.IsContainer(), v.Bytes)
+	}
+	p.keyBytes = b
+	return string(b), nil
+}
+
+func (p *Proc) merge(in, result *zng.Record) (*zng.Record, error) {
+	key := ruleKey{in.Type.ID(), result.Type.ID()}
+	r, ok := p.rules[key]
+	if !ok {
+		var err error
+		r, err = p.buildRule(in.Type, result.Type)
+		if err != nil {
+			return nil, err
+		}
+		p.rules[key] = r
+	}
+	inVals, err := columnBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	resultVals, err := columnBytes(result)
+	if err != nil {
+		return nil, err
+	}
+	var b zcode.Bytes
+	for _, s := range r.cols {
+		val := inVals[s.column]
+		if s.result {
+			val = resultVals[s.column]
+		}
+		b = zcode.AppendAs(b, s.container, val)
+	}
+	return zng.NewRecord(r.typ, b), nil
+}
+
+func (p *Proc) buildRule(in, result *zng.TypeRecord) (*rule, error) {
+	var cols []zng.Column
+	var sources []source
+	for k, c := range in.Columns {
+		s := source{column: k, container: zng.IsContainerType(c.Type)}
+		if position, ok := result.ColumnOfField(c.Name); ok {
+			c = result.Columns[position]
+			s = source{result: true, column: position, container: zng.IsContainerType(c.Type)}
+		}
+		cols = append(cols, c)
+		sources = append(sources, s)
+	}
+	for k, c := range result.Columns {
+		if in.HasField(c.Name) {
+			continue
+		}
+		cols = append(cols, c)
+		sources = append(sources, source{result: true, column: k, container: zng.IsContainerType(c.Type)})
+	}
+	typ, err := p.pctx.TypeContext.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	return &rule{typ, sources}, nil
+}
+
+func columnBytes(rec *zng.Record) ([]zcode.Bytes, error) {
+	vals := make([]zcode.Bytes, 0, len(rec.Type.Columns))
+	for it := rec.Raw.Iter(); !it.Done(); {
+		val, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) != len(rec.Type.Columns) {
+		return nil, errors.New("record body does not match its type")
+	}
+	return vals, nil
+}
+
+func (p *Proc) Done() {
+	p.parent.Done()
+}
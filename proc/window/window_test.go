@@ -0,0 +1,61 @@
+package window_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/pkg/test"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqe"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func run(t *testing.T, prog, input string, limit int) (string, error) {
+	proc, err := zql.ParseProc(prog)
+	require.NoError(t, err)
+	zctx := resolver.NewContext()
+	zr := tzngio.NewReader(strings.NewReader(input), zctx)
+	var buf bytes.Buffer
+	zw := tzngio.NewWriter(zio.NopCloser(&buf))
+	err = driver.Copy(context.Background(), zw, proc, zctx, zr, driver.Config{GroupByLimit: limit})
+	return buf.String(), err
+}
+
+func TestPartitionLimit(t *testing.T) {
+	// Each partition is out of range by the time the next one starts,
+	// so a limit of one partition is not exceeded.
+	const disjoint = `
+#0:record[ts:time,host:string]
+0:[1;a;]
+0:[10;b;]
+0:[20;a;]`
+	out, err := run(t, "window -range 5s count() by host", disjoint, 1)
+	require.NoError(t, err)
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,host:string,count:uint64]
+0:[1;a;1;]
+0:[10;b;1;]
+0:[20;a;1;]`), out)
+
+	const overlapping = `
+#0:record[ts:time,host:string]
+0:[1;a;]
+0:[2;b;]`
+	_, err = run(t, "window -range 5s count() by host", overlapping, 1)
+	require.Error(t, err)
+	assert.True(t, zqe.IsLimitExceeded(err))
+	assert.EqualError(t, err, "resource limit exceeded: window exceeded configured partition limit (1)")
+
+	// The partitions of -rows and running windows never expire.
+	for _, prog := range []string{"window -rows 2 count() by host", "window count() by host"} {
+		_, err = run(t, prog, disjoint, 1)
+		assert.True(t, zqe.IsLimitExceeded(err), prog)
+	}
+}
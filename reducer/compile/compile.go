@@ -8,6 +8,7 @@ import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/reducer/field"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 var (
@@ -21,10 +22,10 @@ type CompiledReducer struct {
 	Instantiate    func() reducer.Interface
 }
 
-func Compile(params ast.Reducer) (CompiledReducer, error) {
+func Compile(zctx *resolver.Context, params ast.Reducer) (CompiledReducer, error) {
 	var fld *expr.FieldExpr
 	if params.Field != nil {
-		eval, err := expr.CompileExpr(params.Field)
+		eval, err := expr.CompileExpr(zctx, params.Field)
 		if err != nil {
 			return CompiledReducer{}, err
 		}
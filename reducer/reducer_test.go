@@ -60,7 +60,7 @@ func TestDecomposableReducers(t *testing.T) {
 	recs := b.Records()
 
 	makeReducer := func(op, field string) compile.CompiledReducer {
-		cred, err := compile.Compile(ast.Reducer{
+		cred, err := compile.Compile(resolver, ast.Reducer{
 			Node: ast.Node{Op: op},
 			Var:  strings.ToLower(op),
 			Field: &ast.Field{
@@ -0,0 +1,75 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Reader reads CSV with a header row naming the fields of each record.
+// Every field is a string, and an empty cell is a null string.
+type Reader struct {
+	reader *csv.Reader
+	zctx   *resolver.Context
+	typ    *zng.TypeRecord
+}
+
+func NewReader(r io.Reader, zctx *resolver.Context) *Reader {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	return &Reader{
+		reader: reader,
+		zctx:   zctx,
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	if r.typ == nil {
+		hdr, err := r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return nil, err
+		}
+		if err := r.init(hdr); err != nil {
+			return nil, err
+		}
+	}
+	fields, err := r.reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			err = nil
+		}
+		return nil, err
+	}
+	var b zcode.Bytes
+	for _, f := range fields {
+		if f == "" {
+			b = zcode.AppendPrimitive(b, nil)
+		} else {
+			b = zcode.AppendPrimitive(b, zng.EncodeString(f))
+		}
+	}
+	return zng.NewRecord(r.typ, b), nil
+}
+
+func (r *Reader) init(hdr []string) error {
+	cols := make([]zng.Column, 0, len(hdr))
+	for _, name := range hdr {
+		if name == "" {
+			return errors.New("csv header has an empty field name")
+		}
+		cols = append(cols, zng.Column{Name: name, Type: zng.TypeString})
+	}
+	typ, err := r.zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return err
+	}
+	r.typ = typ
+	return nil
+}
@@ -3,16 +3,18 @@ package detector
 import (
 	"compress/gzip"
 	"io"
+
+	"github.com/brimsec/zq/pkg/recorder"
 )
 
 func GzipReader(r io.Reader) io.Reader {
-	recorder := NewRecorder(r)
-	track := NewTrack(recorder)
+	rec := recorder.New(r)
+	track := recorder.NewTrack(rec)
 	_, err := gzip.NewReader(track)
 	if err == nil {
 		// create a new reader from recorder (track keeps a copy of read data)
-		r, _ := gzip.NewReader(recorder)
+		r, _ := gzip.NewReader(rec)
 		return r
 	}
-	return recorder
+	return rec
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/brimsec/zq/zio/azngio"
 	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/packetio"
 	"github.com/brimsec/zq/zio/tableio"
 	"github.com/brimsec/zq/zio/textio"
 	"github.com/brimsec/zq/zio/tzngio"
@@ -76,6 +77,10 @@ func lookupReader(r io.Reader, zctx *resolver.Context, path string, opts zio.Rea
 		return zstio.NewReader(r, zctx)
 	case "azng":
 		return azngio.NewReader(r, zctx)
+	case "pcap":
+		return packetio.NewReader(r, zctx, opts.Pcap)
+	case "csv":
+		return csvio.NewReader(r, zctx), nil
 	}
 	return nil, fmt.Errorf("no such format: \"%s\"", opts.Format)
 }
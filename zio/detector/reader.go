@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/recorder"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zio/azngio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/packetio"
 	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
@@ -18,12 +21,12 @@ import (
 )
 
 func NewReaderWithOpts(r io.Reader, zctx *resolver.Context, path string, opts zio.ReaderOpts) (zbuf.Reader, error) {
-	recorder := NewRecorder(r)
-	track := NewTrack(recorder)
+	rec := recorder.New(r)
+	track := recorder.NewTrack(rec)
 
 	tzngErr := match(tzngio.NewReader(track, resolver.NewContext()), "tzng")
 	if tzngErr == nil {
-		return tzngio.NewReader(recorder, zctx), nil
+		return tzngio.NewReader(rec, zctx), nil
 	}
 	track.Reset()
 
@@ -33,14 +36,14 @@ func NewReaderWithOpts(r io.Reader, zctx *resolver.Context, path string, opts zi
 	}
 	zeekErr := match(zr, "zeek")
 	if zeekErr == nil {
-		return zeekio.NewReader(recorder, zctx)
+		return zeekio.NewReader(rec, zctx)
 	}
 	track.Reset()
 
 	// zjson must come before ndjson since zjson is a subset of ndjson
 	zjsonErr := match(zjsonio.NewReader(track, resolver.NewContext()), "zjson")
 	if zjsonErr == nil {
-		return zjsonio.NewReader(recorder, zctx), nil
+		return zjsonio.NewReader(rec, zctx), nil
 	}
 	track.Reset()
 
@@ -51,13 +54,19 @@ func NewReaderWithOpts(r io.Reader, zctx *resolver.Context, path string, opts zi
 	}
 	ndjsonErr := match(nr, "ndjson")
 	if ndjsonErr == nil {
-		return ndjsonio.NewReader(recorder, zctx, opts.JSON, path)
+		return ndjsonio.NewReader(rec, zctx, opts.JSON, path)
 	}
 	track.Reset()
 
 	zngErr := match(zngio.NewReaderWithOpts(track, resolver.NewContext(), zngio.ReaderOpts{Validate: true}), "zng")
 	if zngErr == nil {
-		return zngio.NewReaderWithOpts(recorder, zctx, opts.Zng), nil
+		return zngio.NewReaderWithOpts(rec, zctx, opts.Zng), nil
+	}
+	track.Reset()
+
+	pcapErr := matchPcap(track)
+	if pcapErr == nil {
+		return packetio.NewReader(rec, zctx, opts.Pcap)
 	}
 	track.Reset()
 
@@ -72,11 +81,11 @@ func NewReaderWithOpts(r io.Reader, zctx *resolver.Context, path string, opts zi
 	// tear it down.
 	ar.Close()
 	if azngErr == nil {
-		return azngio.NewReader(recorder, zctx)
+		return azngio.NewReader(rec, zctx)
 	}
 	parquetErr := errors.New("parquet: auto-detection not supported")
 	zstErr := errors.New("zst: auto-detection not supported")
-	return nil, joinErrs([]error{tzngErr, zeekErr, ndjsonErr, zjsonErr, zngErr, azngErr, parquetErr, zstErr})
+	return nil, joinErrs([]error{tzngErr, zeekErr, ndjsonErr, zjsonErr, zngErr, pcapErr, azngErr, parquetErr, zstErr})
 }
 
 func NewReader(r io.Reader, zctx *resolver.Context) (zbuf.Reader, error) {
@@ -90,6 +99,16 @@ func joinErrs(errs []error) error {
 	}
 	return zqe.E(s)
 }
+
+// matchPcap checks only the pcap header since a pcap may begin with many
+// blocks that hold no packets.
+func matchPcap(r io.Reader) error {
+	if _, err := pcapio.NewReader(r); err != nil {
+		return fmt.Errorf("pcap: %s", err)
+	}
+	return nil
+}
+
 func match(r zbuf.Reader, name string) error {
 	_, err := r.Read()
 	if err != nil {
@@ -0,0 +1,194 @@
+package packetio
+
+import (
+	"bytes"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/google/gopacket"
+)
+
+// FlowTimeout is the time after its last packet at which a flow is
+// considered finished and its record is returned.
+const FlowTimeout = 5 * time.Minute
+
+// sweepInterval is how often, in packet time, the flow table looks for
+// finished flows.
+const sweepInterval = 10 * time.Second
+
+type flowKey struct {
+	proto  string
+	h0, h1 [16]byte
+	p0, p1 uint16
+}
+
+// newFlowKey returns the key of the flow holding packets between (src,
+// sport) and (dst, dport) in either direction.
+func newFlowKey(proto string, src, dst net.IP, sport, dport uint16) flowKey {
+	k := flowKey{proto: proto, p0: sport, p1: dport}
+	copy(k.h0[:], src.To16())
+	copy(k.h1[:], dst.To16())
+	if c := bytes.Compare(k.h0[:], k.h1[:]); c > 0 || c == 0 && k.p0 > k.p1 {
+		k.h0, k.h1 = k.h1, k.h0
+		k.p0, k.p1 = k.p1, k.p0
+	}
+	return k
+}
+
+type flow struct {
+	proto        string
+	origH, respH net.IP
+	origP, respP uint16
+	hasPorts     bool
+	first, last  nano.Ts
+	origPkts     uint64
+	origBytes    uint64
+	origIPBytes  uint64
+	respPkts     uint64
+	respBytes    uint64
+	respIPBytes  uint64
+	tcpFlags     map[byte]bool
+}
+
+// flowTable aggregates packets into flows.  The originator of a flow is
+// the sender of its first packet.
+type flowTable struct {
+	typ       *zng.TypeRecord
+	flows     map[flowKey]*flow
+	lastSweep nano.Ts
+}
+
+func newFlowTable(typ *zng.TypeRecord) *flowTable {
+	return &flowTable{
+		typ:   typ,
+		flows: make(map[flowKey]*flow),
+	}
+}
+
+// add adds a packet to its flow and returns the records of any flows that
+// have finished.  Packets that are not IP or ARP are ignored.
+func (t *flowTable) add(pkt gopacket.Packet, ts nano.Ts) []*zng.Record {
+	info := decode(pkt)
+	if info.srcIP != nil && info.proto != "" {
+		src, dst := net.IP(info.srcIP), net.IP(info.dstIP)
+		// The ICMP messages between two hosts form a single flow
+		// whose ports are the type and code of its first message.
+		sport, dport := info.srcPort, info.dstPort
+		if isICMP(info.proto) {
+			sport, dport = 0, 0
+		}
+		key := newFlowKey(info.proto, src, dst, sport, dport)
+		f, ok := t.flows[key]
+		if !ok {
+			f = &flow{
+				proto:    info.proto,
+				origH:    append(net.IP(nil), src...),
+				respH:    append(net.IP(nil), dst...),
+				origP:    info.srcPort,
+				respP:    info.dstPort,
+				hasPorts: info.hasPorts,
+				first:    ts,
+				tcpFlags: make(map[byte]bool),
+			}
+			t.flows[key] = f
+		}
+		f.update(info, ts, src)
+	}
+	if ts < t.lastSweep+nano.Ts(sweepInterval) {
+		return nil
+	}
+	t.lastSweep = ts
+	return t.sweep(ts - nano.Ts(FlowTimeout))
+}
+
+func (f *flow) update(info packetInfo, ts nano.Ts, src net.IP) {
+	if ts > f.last {
+		f.last = ts
+	}
+	if ts < f.first {
+		f.first = ts
+	}
+	ipLen := uint64(info.ipLen)
+	if src.Equal(f.origH) && (info.srcPort == f.origP || isICMP(f.proto)) {
+		f.origPkts++
+		f.origBytes += uint64(info.payloadLen)
+		f.origIPBytes += ipLen
+	} else {
+		f.respPkts++
+		f.respBytes += uint64(info.payloadLen)
+		f.respIPBytes += ipLen
+	}
+	for i := 0; i < len(info.tcpFlags); i++ {
+		f.tcpFlags[info.tcpFlags[i]] = true
+	}
+}
+
+func isICMP(proto string) bool {
+	return proto == "icmp" || proto == "icmp6"
+}
+
+// sweep removes the flows whose last packet precedes before and returns
+// their records ordered by start time.
+func (t *flowTable) sweep(before nano.Ts) []*zng.Record {
+	var done []*flow
+	for k, f := range t.flows {
+		if f.last < before {
+			done = append(done, f)
+			delete(t.flows, k)
+		}
+	}
+	return t.records(done)
+}
+
+// flush removes every flow and returns their records ordered by start time.
+func (t *flowTable) flush() []*zng.Record {
+	return t.sweep(nano.MaxTs)
+}
+
+func (t *flowTable) records(flows []*flow) []*zng.Record {
+	sort.Slice(flows, func(i, j int) bool { return flows[i].first < flows[j].first })
+	recs := make([]*zng.Record, 0, len(flows))
+	for _, f := range flows {
+		recs = append(recs, f.record(t.typ))
+	}
+	return recs
+}
+
+func (f *flow) record(typ *zng.TypeRecord) *zng.Record {
+	var b zcode.Builder
+	b.AppendPrimitive(zng.EncodeString("flow"))
+	b.AppendPrimitive(zng.EncodeTime(f.first))
+	b.BeginContainer()
+	b.AppendPrimitive(zng.EncodeIP(f.origH))
+	b.AppendPrimitive(encodePort(f.origP, f.hasPorts))
+	b.AppendPrimitive(zng.EncodeIP(f.respH))
+	b.AppendPrimitive(encodePort(f.respP, f.hasPorts))
+	b.EndContainer()
+	b.AppendPrimitive(zng.EncodeString(f.proto))
+	b.AppendPrimitive(zng.EncodeDuration(int64(f.last - f.first)))
+	b.AppendPrimitive(zng.EncodeUint(f.origPkts))
+	b.AppendPrimitive(zng.EncodeUint(f.origBytes))
+	b.AppendPrimitive(zng.EncodeUint(f.origIPBytes))
+	b.AppendPrimitive(zng.EncodeUint(f.respPkts))
+	b.AppendPrimitive(zng.EncodeUint(f.respBytes))
+	b.AppendPrimitive(zng.EncodeUint(f.respIPBytes))
+	b.AppendPrimitive(encodeString(f.flags()))
+	return zng.NewRecord(typ, b.Bytes())
+}
+
+// flags returns the union of the TCP flags seen in the flow in the order
+// used by tcpFlags.
+func (f *flow) flags() string {
+	var b strings.Builder
+	for _, c := range []byte("SFPRUEW.") {
+		if f.tcpFlags[c] {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,291 @@
+// Package packetio reads the packets of a pcap or pcap-ng file as zng
+// records, either one record per packet or, in flow mode, one conn-like
+// record per flow.  It lets packets be queried with zql without running
+// Zeek.
+package packetio
+
+import (
+	"io"
+	"strings"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+type ReaderOpts struct {
+	// Flows, if true, causes the reader to summarize the packets of each
+	// flow as a single record rather than returning a record per packet.
+	Flows bool
+}
+
+// Reader is a zbuf.Reader that decodes the packets of a pcap.
+type Reader struct {
+	reader  pcapio.Reader
+	opts    gopacket.DecodeOptions
+	builder *zng.Builder
+	flows   *flowTable
+	pending []*zng.Record
+	eof     bool
+}
+
+func NewReader(r io.Reader, zctx *resolver.Context, opts ReaderOpts) (*Reader, error) {
+	reader, err := pcapio.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	types, err := newTypes(zctx)
+	if err != nil {
+		return nil, err
+	}
+	pr := &Reader{
+		reader: reader,
+		opts:   gopacket.DecodeOptions{Lazy: true, NoCopy: true},
+	}
+	if opts.Flows {
+		pr.flows = newFlowTable(types.flow)
+	} else {
+		pr.builder = zng.NewBuilder(types.packet)
+	}
+	return pr, nil
+}
+
+type types struct {
+	packet *zng.TypeRecord
+	flow   *zng.TypeRecord
+}
+
+func newTypes(zctx *resolver.Context) (*types, error) {
+	zenum, err := zctx.LookupTypeAlias("zenum", zng.TypeString)
+	if err != nil {
+		return nil, err
+	}
+	port, err := zctx.LookupTypeAlias("port", zng.TypeUint16)
+	if err != nil {
+		return nil, err
+	}
+	packet, err := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn("_path", zng.TypeString),
+		zng.NewColumn("ts", zng.TypeTime),
+		zng.NewColumn("link_type", zng.TypeString),
+		zng.NewColumn("src_ip", zng.TypeIP),
+		zng.NewColumn("src_port", port),
+		zng.NewColumn("dst_ip", zng.TypeIP),
+		zng.NewColumn("dst_port", port),
+		zng.NewColumn("proto", zenum),
+		zng.NewColumn("len", zng.TypeUint64),
+		zng.NewColumn("tcp_flags", zng.TypeString),
+	})
+	if err != nil {
+		return nil, err
+	}
+	id, err := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn("orig_h", zng.TypeIP),
+		zng.NewColumn("orig_p", port),
+		zng.NewColumn("resp_h", zng.TypeIP),
+		zng.NewColumn("resp_p", port),
+	})
+	if err != nil {
+		return nil, err
+	}
+	flow, err := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn("_path", zng.TypeString),
+		zng.NewColumn("ts", zng.TypeTime),
+		zng.NewColumn("id", id),
+		zng.NewColumn("proto", zenum),
+		zng.NewColumn("duration", zng.TypeDuration),
+		zng.NewColumn("orig_pkts", zng.TypeUint64),
+		zng.NewColumn("orig_bytes", zng.TypeUint64),
+		zng.NewColumn("orig_ip_bytes", zng.TypeUint64),
+		zng.NewColumn("resp_pkts", zng.TypeUint64),
+		zng.NewColumn("resp_bytes", zng.TypeUint64),
+		zng.NewColumn("resp_ip_bytes", zng.TypeUint64),
+		zng.NewColumn("tcp_flags", zng.TypeString),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types{packet: packet, flow: flow}, nil
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	for {
+		if len(r.pending) > 0 {
+			rec := r.pending[0]
+			r.pending = r.pending[1:]
+			return rec, nil
+		}
+		if r.eof {
+			return nil, nil
+		}
+		pkt, ts, linkType, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		if pkt == nil {
+			r.eof = true
+			if r.flows != nil {
+				r.pending = r.flows.flush()
+			}
+			continue
+		}
+		if r.flows == nil {
+			return r.packetRecord(pkt, ts, linkType), nil
+		}
+		r.pending = r.flows.add(pkt, ts)
+	}
+}
+
+// next returns the next packet of the pcap, skipping headers and other
+// blocks that do not hold packets.  The packet's metadata holds its captured
+// and original lengths.
+func (r *Reader) next() (gopacket.Packet, nano.Ts, layers.LinkType, error) {
+	for {
+		block, typ, err := r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, 0, 0, nil
+			}
+			return nil, 0, 0, err
+		}
+		if block == nil {
+			return nil, 0, 0, nil
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		buf, ts, linkType, err := r.reader.Packet(block)
+		if buf == nil {
+			return nil, 0, 0, err
+		}
+		length, err := r.reader.PacketLength(block)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		pkt := gopacket.NewPacket(buf, linkType, r.opts)
+		md := pkt.Metadata()
+		md.CaptureLength = len(buf)
+		md.Length = length
+		return pkt, ts, linkType, nil
+	}
+}
+
+func (r *Reader) packetRecord(pkt gopacket.Packet, ts nano.Ts, linkType layers.LinkType) *zng.Record {
+	info := decode(pkt)
+	// The builder reuses its record, so return a copy.
+	return r.builder.Build(
+		zng.EncodeString("packet"),
+		zng.EncodeTime(ts),
+		zng.EncodeString(linkType.String()),
+		encodeIP(info.srcIP),
+		encodePort(info.srcPort, info.hasPorts),
+		encodeIP(info.dstIP),
+		encodePort(info.dstPort, info.hasPorts),
+		encodeString(info.proto),
+		zng.EncodeUint(uint64(pkt.Metadata().Length)),
+		encodeString(info.tcpFlags),
+	).Keep()
+}
+
+// packetInfo holds the fields of a packet that appear in its record.
+type packetInfo struct {
+	srcIP, dstIP     []byte
+	srcPort, dstPort uint16
+	hasPorts         bool
+	proto            string
+	ipLen            int
+	payloadLen       int
+	tcpFlags         string
+}
+
+func decode(pkt gopacket.Packet) packetInfo {
+	var info packetInfo
+	switch l := pkt.NetworkLayer().(type) {
+	case *layers.IPv4:
+		info.srcIP, info.dstIP = l.SrcIP, l.DstIP
+		info.proto = strings.ToLower(l.Protocol.String())
+		info.ipLen = int(l.Length)
+	case *layers.IPv6:
+		info.srcIP, info.dstIP = l.SrcIP, l.DstIP
+		info.proto = strings.ToLower(l.NextHeader.String())
+		info.ipLen = int(l.Length) + 40
+	}
+	if arp, ok := pkt.Layer(layers.LayerTypeARP).(*layers.ARP); ok {
+		info.srcIP, info.dstIP = arp.SourceProtAddress, arp.DstProtAddress
+		info.proto = "arp"
+	}
+	switch l := pkt.TransportLayer().(type) {
+	case *layers.TCP:
+		info.proto = "tcp"
+		info.srcPort, info.dstPort, info.hasPorts = uint16(l.SrcPort), uint16(l.DstPort), true
+		info.payloadLen = len(l.LayerPayload())
+		info.tcpFlags = tcpFlags(l)
+	case *layers.UDP:
+		info.proto = "udp"
+		info.srcPort, info.dstPort, info.hasPorts = uint16(l.SrcPort), uint16(l.DstPort), true
+		info.payloadLen = len(l.LayerPayload())
+	case *layers.SCTP:
+		info.proto = "sctp"
+		info.srcPort, info.dstPort, info.hasPorts = uint16(l.SrcPort), uint16(l.DstPort), true
+		info.payloadLen = len(l.LayerPayload())
+	}
+	// As in Zeek, the ports of an ICMP flow are the message type and code.
+	if icmp, ok := pkt.Layer(layers.LayerTypeICMPv4).(*layers.ICMPv4); ok {
+		info.proto = "icmp"
+		info.srcPort, info.dstPort, info.hasPorts = uint16(icmp.TypeCode.Type()), uint16(icmp.TypeCode.Code()), true
+		info.payloadLen = len(icmp.LayerPayload())
+	} else if icmp, ok := pkt.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6); ok {
+		info.proto = "icmp6"
+		info.srcPort, info.dstPort, info.hasPorts = uint16(icmp.TypeCode.Type()), uint16(icmp.TypeCode.Code()), true
+		info.payloadLen = len(icmp.LayerPayload())
+	}
+	return info
+}
+
+// tcpFlags formats the flags of a TCP header as tcpdump does.
+func tcpFlags(tcp *layers.TCP) string {
+	var b strings.Builder
+	for _, f := range []struct {
+		set bool
+		c   byte
+	}{
+		{tcp.SYN, 'S'},
+		{tcp.FIN, 'F'},
+		{tcp.PSH, 'P'},
+		{tcp.RST, 'R'},
+		{tcp.URG, 'U'},
+		{tcp.ECE, 'E'},
+		{tcp.CWR, 'W'},
+		{tcp.ACK, '.'},
+	} {
+		if f.set {
+			b.WriteByte(f.c)
+		}
+	}
+	return b.String()
+}
+
+func encodeIP(ip []byte) zcode.Bytes {
+	if ip == nil {
+		return nil
+	}
+	return zng.EncodeIP(ip)
+}
+
+func encodePort(port uint16, ok bool) zcode.Bytes {
+	if !ok {
+		return nil
+	}
+	return zng.EncodeUint(uint64(port))
+}
+
+func encodeString(s string) zcode.Bytes {
+	if s == "" {
+		return nil
+	}
+	return zng.EncodeString(s)
+}
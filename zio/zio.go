@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/packetio"
 	"github.com/brimsec/zq/zio/textio"
 	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zio/zstio"
@@ -14,6 +15,7 @@ type ReaderOpts struct {
 	Format string
 	Zng    zngio.ReaderOpts
 	JSON   ndjsonio.ReaderOpts
+	Pcap   packetio.ReaderOpts
 	AwsCfg *aws.Config
 }
 
@@ -43,6 +43,30 @@ type SearchRequest struct {
 	Proc  json.RawMessage `json:"proc" validate:"required"`
 	Span  nano.Span       `json:"span"`
 	Dir   int             `json:"dir" validate:"required"`
+	// Limits, if not nil, bounds the resources used by the search.  The
+	// limits may tighten but not loosen those of the server.
+	Limits *SearchLimits `json:"limits,omitempty"`
+}
+
+// SearchLimits bounds the resources used by a search.  A zero field means
+// no limit.
+type SearchLimits struct {
+	// Timeout is the maximum running time of the search in nanoseconds.
+	Timeout int64 `json:"timeout,omitempty"`
+	// MaxRecordsRead is the maximum number of records the search may
+	// read from storage.
+	MaxRecordsRead int64 `json:"max_records_read,omitempty"`
+	// MaxOutputBytes is the maximum size of the records the search may
+	// return.
+	MaxOutputBytes int64 `json:"max_output_bytes,omitempty"`
+	// SortMemMaxBytes is the memory each sort may use before spilling to
+	// disk.
+	SortMemMaxBytes int64 `json:"sort_mem_max_bytes,omitempty"`
+	// GroupByLimit is the number of groups each groupby may hold in memory
+	// before spilling to disk.  A groupby whose reducers cannot be spilled
+	// fails when it exceeds the limit, as does a window holding more
+	// partitions.
+	GroupByLimit int64 `json:"groupby_limit,omitempty"`
 }
 
 type WorkerRequest struct {
@@ -58,6 +82,64 @@ type Chunk struct {
 	RecordCount int     `json:"record_count" validate:"required"`
 }
 
+// SearchIDHeader is the response header holding the ID of a search, which
+// may be used to cancel the search while it runs.
+const SearchIDHeader = "X-Search-ID"
+
+// SearchInfo describes a running search.
+type SearchInfo struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	Space     SpaceID   `json:"space"`
+	User      string    `json:"user,omitempty"`
+	Span      nano.Span `json:"span"`
+	StartTime nano.Ts   `json:"start_time"`
+	// Elapsed is the running time of the search in nanoseconds.
+	Elapsed int64 `json:"elapsed"`
+	ScannerStats
+}
+
+// JobPostRequest starts a search that runs in the background and stores its
+// results for later retrieval.
+type JobPostRequest struct {
+	SearchRequest
+	// TTL is the number of seconds the job and its results are kept after
+	// the job ends.  If zero, a default is used.
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+// JobPutRequest extends the life of a job.  The job expires TTL seconds
+// after it ends or, if it has already ended, TTL seconds from now.
+type JobPutRequest struct {
+	TTL int64 `json:"ttl"`
+}
+
+type JobState string
+
+const (
+	JobRunning  JobState = "running"
+	JobDone     JobState = "done"
+	JobFailed   JobState = "failed"
+	JobCanceled JobState = "canceled"
+)
+
+type JobInfo struct {
+	ID      string        `json:"id"`
+	Space   SpaceID       `json:"space"`
+	User    string        `json:"user,omitempty"`
+	State   JobState      `json:"state"`
+	Error   string        `json:"error,omitempty"`
+	Request SearchRequest `json:"request"`
+	TTL     int64         `json:"ttl"`
+	// RecordCount is the number of result records stored so far.
+	RecordCount int64   `json:"record_count"`
+	StartTime   nano.Ts `json:"start_time"`
+	// EndTime and ExpireTime are zero while the job is running.
+	EndTime    nano.Ts `json:"end_time"`
+	ExpireTime nano.Ts `json:"expire_time"`
+	ScannerStats
+}
+
 type SearchRecords struct {
 	Type      string           `json:"type"`
 	ChannelID int              `json:"channel_id"`
@@ -119,6 +201,7 @@ type SpaceInfo struct {
 	PcapSupport bool         `json:"pcap_support"`
 	PcapSize    int64        `json:"pcap_size" unit:"bytes"`
 	PcapPath    iosrc.URI    `json:"pcap_path"`
+	PcapPaths   []iosrc.URI  `json:"pcap_paths,omitempty"`
 	ParentID    SpaceID      `json:"parent_id,omitempty"`
 }
 
@@ -188,14 +271,20 @@ type LogPostStatus struct {
 }
 
 // PcapSearch are the query string args to the packet endpoint when searching
-// for packets within a connection 5-tuple.
+// for packets within a connection 5-tuple and/or matching a BPF filter
+// expression.  If Filter is set, Proto, SrcHost, and DstHost may be omitted.
+// If Stream is set to "client", "server", or "follow", the endpoint returns
+// the reassembled TCP payload of the matching packets in that format rather
+// than a pcap.
 type PcapSearch struct {
 	Span    nano.Span
-	Proto   string `validate:"required"`
-	SrcHost net.IP `validate:"required"`
+	Proto   string
+	SrcHost net.IP
 	SrcPort uint16
-	DstHost net.IP `validate:"required"`
+	DstHost net.IP
 	DstPort uint16
+	Filter  string
+	Stream  string
 }
 
 // ToQuery transforms a packet search into a url.Values.
@@ -208,6 +297,15 @@ func (ps *PcapSearch) ToQuery() url.Values {
 	q.Add("ts_ns", strconv.Itoa(int(tsns)))
 	q.Add("duration_sec", strconv.Itoa(dursec))
 	q.Add("duration_ns", strconv.Itoa(durns))
+	if ps.Filter != "" {
+		q.Add("filter", ps.Filter)
+	}
+	if ps.Stream != "" {
+		q.Add("stream", ps.Stream)
+	}
+	if ps.Proto == "" && ps.Filter != "" {
+		return q
+	}
 	q.Add("proto", ps.Proto)
 	q.Add("src_host", ps.SrcHost.String())
 	q.Add("dst_host", ps.DstHost.String())
@@ -255,7 +353,12 @@ func (ps *PcapSearch) FromQuery(v url.Values) error {
 		Dur: nano.Duration(durSec, durNs),
 	}
 	ps.Span = span
+	ps.Filter = v.Get("filter")
+	ps.Stream = v.Get("stream")
 	ps.Proto = v.Get("proto")
+	if ps.Proto == "" && ps.Filter != "" {
+		return nil
+	}
 	switch ps.Proto {
 	case "tcp", "udp", "icmp":
 	default:
@@ -282,3 +385,54 @@ type IndexPostRequest struct {
 	InputFile  string          `json:"input_file"`
 	OutputFile string          `json:"output_file"`
 }
+
+// IndexDef is a named index definition of an archive space.  The index is
+// built for the data already in the space when the definition is created
+// and for all data subsequently added to it.  A definition holds either a
+// Pattern, which is a field name or a ":" followed by a type name, or Keys
+// and an optional ZQL query computing the values stored with the keys.
+type IndexDef struct {
+	Name      string   `json:"name"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Keys      []string `json:"keys,omitempty"`
+	ZQL       string   `json:"zql,omitempty"`
+	Framesize int      `json:"framesize,omitempty"`
+}
+
+// TailRequest asks for the records ingested into a space to be streamed to
+// the client as they are written.  Proc holds the AST of a zql filter
+// selecting the records to be streamed.
+type TailRequest struct {
+	Proc json.RawMessage `json:"proc"`
+}
+
+// SavedQuery is a named zql query stored with a space.  Query may refer to
+// parameters as ${name} wherever a literal may appear.  When the query is
+// run, each is bound to the value given in QueryRunRequest.Params or,
+// failing that, to the default in Params.  A value must be a single
+// literal, such as conn, "a b", 10, or 10.0.0.0/8.
+type SavedQuery struct {
+	Name        string            `json:"name"`
+	Query       string            `json:"query"`
+	Description string            `json:"description,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+}
+
+// QueryRunRequest runs a saved query.  A zero Span searches the whole
+// space and a zero Dir searches backward in time.
+type QueryRunRequest struct {
+	Params map[string]string `json:"params,omitempty"`
+	Span   nano.Span         `json:"span"`
+	Dir    int               `json:"dir"`
+	Limits *SearchLimits     `json:"limits,omitempty"`
+}
+
+// ArchiveDeleteRequest asks an archive space to delete all records matching
+// Filter, which holds the AST of a zql filter proc.
+type ArchiveDeleteRequest struct {
+	Filter json.RawMessage `json:"filter"`
+}
+
+type ArchiveDeleteResponse struct {
+	RecordsDeleted int64 `json:"records_deleted"`
+}
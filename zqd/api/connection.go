@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"path"
 	"strconv"
 
@@ -15,6 +17,7 @@ import (
 	"time"
 
 	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/tlsutil"
 	"github.com/go-resty/resty/v2"
 )
 
@@ -60,10 +63,58 @@ func NewConnectionTo(hostURL string) *Connection {
 	return newConnection(client)
 }
 
+// NewUnixConnection creates a new connection to a server listening on the
+// Unix domain socket at path.
+func NewUnixConnection(path string) *Connection {
+	client := resty.New()
+	client.HostURL = "http://unix"
+	client.SetTransport(&http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		},
+	})
+	return newConnection(client)
+}
+
+// SetTLSConfig sets the TLS configuration used to connect to an https
+// server.
+func (c *Connection) SetTLSConfig(conf *tls.Config) {
+	c.client.SetTLSClientConfig(conf)
+}
+
+// ClientTLSConfig returns a TLS configuration that verifies the server's
+// certificate against the PEM-encoded certificate authorities in caFile
+// (or the system's authorities if caFile is empty) and, if certFile and
+// keyFile are not empty, presents the client certificate they hold.
+func ClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	conf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pool, err := tlsutil.LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		conf.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
+
 func (c *Connection) SetUserAgent(useragent string) {
 	c.client.SetHeader("User-Agent", useragent)
 }
 
+// SetAuthToken sets the bearer token sent with each request.
+func (c *Connection) SetAuthToken(token string) {
+	c.client.SetAuthToken(token)
+}
+
 func (c *Connection) Do(ctx context.Context, method, url string, body interface{}) (*resty.Response, error) {
 	req := c.Request(ctx).SetBody(body)
 	return req.Execute(method, url)
@@ -225,6 +276,89 @@ func (c *Connection) SearchRaw(ctx context.Context, search SearchRequest, params
 	return c.stream(req)
 }
 
+// SearchList returns the searches running on the server.
+func (c *Connection) SearchList(ctx context.Context) ([]SearchInfo, error) {
+	var res []SearchInfo
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get("/search")
+	return res, err
+}
+
+// SearchCancel cancels a running search.
+func (c *Connection) SearchCancel(ctx context.Context, id int64) error {
+	_, err := c.Request(ctx).
+		Delete(path.Join("/search", strconv.FormatInt(id, 10)))
+	return err
+}
+
+// JobPost starts a search that runs in the background and stores its
+// results on the server.
+func (c *Connection) JobPost(ctx context.Context, req JobPostRequest) (*JobInfo, error) {
+	var res JobInfo
+	_, err := c.Request(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Post("/job")
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Connection) JobList(ctx context.Context) ([]JobInfo, error) {
+	var res []JobInfo
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get("/job")
+	return res, err
+}
+
+func (c *Connection) JobGet(ctx context.Context, id string) (*JobInfo, error) {
+	var res JobInfo
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get(path.Join("/job", url.PathEscape(id)))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Connection) JobPut(ctx context.Context, id string, req JobPutRequest) (*JobInfo, error) {
+	var res JobInfo
+	_, err := c.Request(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Put(path.Join("/job", url.PathEscape(id)))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// JobDelete cancels a job if it is running and removes it and its results.
+func (c *Connection) JobDelete(ctx context.Context, id string) error {
+	_, err := c.Request(ctx).
+		Delete(path.Join("/job", url.PathEscape(id)))
+	return err
+}
+
+// JobResults returns the results of an ended job.  The "offset" and "limit"
+// params select a page of the results.
+func (c *Connection) JobResults(ctx context.Context, id string, params map[string]string) (Search, error) {
+	req := c.Request(ctx).
+		SetQueryParam("format", "zng")
+	req.SetQueryParams(params)
+	req.Method = http.MethodGet
+	req.URL = path.Join("/job", url.PathEscape(id), "results")
+	r, err := c.stream(req)
+	if err != nil {
+		return nil, err
+	}
+	return NewZngSearch(r), nil
+}
+
 func (c *Connection) WorkerRaw(ctx context.Context, search WorkerRequest, params map[string]string) (io.ReadCloser, error) {
 	req := c.Request(ctx).
 		SetBody(search).
@@ -266,6 +400,99 @@ func (c *Connection) IndexPost(ctx context.Context, space SpaceID, post IndexPos
 	return err
 }
 
+func (c *Connection) IndexDefPost(ctx context.Context, space SpaceID, def IndexDef) error {
+	_, err := c.Request(ctx).
+		SetBody(def).
+		Post(path.Join("/space", string(space), "indexdef"))
+	return err
+}
+
+func (c *Connection) IndexDefList(ctx context.Context, space SpaceID) ([]IndexDef, error) {
+	var res []IndexDef
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get(path.Join("/space", string(space), "indexdef"))
+	return res, err
+}
+
+func (c *Connection) IndexDefDelete(ctx context.Context, space SpaceID, name string) error {
+	_, err := c.Request(ctx).
+		Delete(path.Join("/space", string(space), "indexdef", url.PathEscape(name)))
+	return err
+}
+
+// Tail streams the records matching the filter in tail that are ingested
+// into space until ctx is canceled or the space is deleted.
+func (c *Connection) Tail(ctx context.Context, space SpaceID, tail TailRequest, params map[string]string) (Search, error) {
+	req := c.Request(ctx).
+		SetBody(tail).
+		SetQueryParam("format", "zng")
+	req.SetQueryParams(params)
+	req.Method = http.MethodPost
+	req.URL = path.Join("/space", string(space), "tail")
+	r, err := c.stream(req)
+	if err != nil {
+		return nil, err
+	}
+	return NewZngSearch(r), nil
+}
+
+func (c *Connection) QueryPost(ctx context.Context, space SpaceID, query SavedQuery) error {
+	_, err := c.Request(ctx).
+		SetBody(query).
+		Post(path.Join("/space", string(space), "query"))
+	return err
+}
+
+func (c *Connection) QueryList(ctx context.Context, space SpaceID) ([]SavedQuery, error) {
+	var res []SavedQuery
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get(path.Join("/space", string(space), "query"))
+	return res, err
+}
+
+func (c *Connection) QueryGet(ctx context.Context, space SpaceID, name string) (*SavedQuery, error) {
+	var res SavedQuery
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get(path.Join("/space", string(space), "query", url.PathEscape(name)))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// QueryPut replaces the saved query named name with query.
+func (c *Connection) QueryPut(ctx context.Context, space SpaceID, name string, query SavedQuery) error {
+	_, err := c.Request(ctx).
+		SetBody(query).
+		Put(path.Join("/space", string(space), "query", url.PathEscape(name)))
+	return err
+}
+
+func (c *Connection) QueryDelete(ctx context.Context, space SpaceID, name string) error {
+	_, err := c.Request(ctx).
+		Delete(path.Join("/space", string(space), "query", url.PathEscape(name)))
+	return err
+}
+
+// QueryRun runs the saved query named name and returns a Search streaming
+// its results.
+func (c *Connection) QueryRun(ctx context.Context, space SpaceID, name string, run QueryRunRequest, params map[string]string) (Search, error) {
+	req := c.Request(ctx).
+		SetBody(run).
+		SetQueryParam("format", "zng")
+	req.SetQueryParams(params)
+	req.Method = http.MethodPost
+	req.URL = path.Join("/space", string(space), "query", url.PathEscape(name), "search")
+	r, err := c.stream(req)
+	if err != nil {
+		return nil, err
+	}
+	return NewZngSearch(r), nil
+}
+
 func (c *Connection) ArchiveStat(ctx context.Context, space SpaceID, params map[string]string) (Search, error) {
 	req := c.Request(ctx).
 		SetQueryParam("format", "zng")
@@ -279,6 +506,17 @@ func (c *Connection) ArchiveStat(ctx context.Context, space SpaceID, params map[
 	return NewZngSearch(r), nil
 }
 
+func (c *Connection) ArchiveDelete(ctx context.Context, space SpaceID, req ArchiveDeleteRequest) (*ArchiveDeleteResponse, error) {
+	resp, err := c.Request(ctx).
+		SetBody(req).
+		SetResult(&ArchiveDeleteResponse{}).
+		Post(path.Join("/space", string(space), "archivedelete"))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result().(*ArchiveDeleteResponse), nil
+}
+
 func (c *Connection) PcapPostStream(ctx context.Context, space SpaceID, payload PcapPostRequest) (*Stream, error) {
 	req := c.Request(ctx).
 		SetBody(payload)
@@ -328,6 +566,23 @@ type PcapReadCloser struct {
 	io.Closer
 }
 
+// PcapStream returns the reassembled TCP payload of the packets matching
+// payload, whose Stream field selects the format.
+func (c *Connection) PcapStream(ctx context.Context, space SpaceID, payload PcapSearch) (io.ReadCloser, error) {
+	req := c.Request(ctx).
+		SetQueryParamsFromValues(payload.ToQuery())
+	req.Method = http.MethodGet
+	req.URL = path.Join("/space", string(space), "pcap")
+	r, err := c.stream(req)
+	if err != nil {
+		if r, ok := err.(*ErrorResponse); ok && r.StatusCode() == http.StatusNotFound {
+			return nil, ErrNoPcapResultsFound
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
 func (c *Connection) LogPostStream(ctx context.Context, space SpaceID, payload LogPostRequest) (*Stream, error) {
 	req := c.Request(ctx).
 		SetBody(payload)
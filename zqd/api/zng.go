@@ -14,8 +14,14 @@ type ZngSearch struct {
 }
 
 func NewZngSearch(body io.Reader) *ZngSearch {
+	return NewZngSearchWithContext(body, resolver.NewContext())
+}
+
+// NewZngSearchWithContext returns a ZngSearch whose records have types from
+// zctx.
+func NewZngSearchWithContext(body io.Reader, zctx *resolver.Context) *ZngSearch {
 	return &ZngSearch{
-		reader: zngio.NewReader(body, resolver.NewContext()),
+		reader: zngio.NewReader(body, zctx),
 	}
 }
 
@@ -0,0 +1,89 @@
+package zqd
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
+	"github.com/brimsec/zq/zqe"
+	"github.com/gorilla/mux"
+)
+
+type authErrorKey struct{}
+
+// authMiddleware authenticates each request and adds the resulting identity,
+// or the reason authentication failed, to the request context.  It rejects
+// nothing itself so that unauthenticated routes such as /version keep
+// working; handlers are guarded by Core.authorize.  If core has no
+// authenticator, requests pass through unchanged.
+func authMiddleware(core *Core) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if core.auth == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var ctx context.Context
+			id, err := core.auth.Authenticate(r)
+			if err != nil {
+				ctx = context.WithValue(r.Context(), authErrorKey{}, err)
+			} else {
+				ctx = auth.ContextWithIdentity(r.Context(), id)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// getUser returns the name of the authenticated user of a request or an
+// empty string if there is none.
+func getUser(ctx context.Context) string {
+	if id := auth.IdentityFromContext(ctx); id != nil {
+		return id.User
+	}
+	return ""
+}
+
+// authorize returns true if the request may proceed with permission perm on
+// space.  An empty space requires only that the request be authenticated.
+// Otherwise, it responds with an error and returns false.
+func (c *Core) authorize(w http.ResponseWriter, r *http.Request, space api.SpaceID, perm auth.Permission) bool {
+	if c.auth == nil {
+		return true
+	}
+	id := auth.IdentityFromContext(r.Context())
+	if id == nil {
+		err, _ := r.Context().Value(authErrorKey{}).(error)
+		if err == nil {
+			err = zqe.E(zqe.Unauthorized, "request is not authenticated")
+		}
+		w.Header().Set("WWW-Authenticate", `Bearer realm="zqd"`)
+		respondError(c, w, r, err)
+		return false
+	}
+	if space != "" && !id.Allowed(space, perm) {
+		respondError(c, w, r, zqe.E(zqe.Forbidden, "user %s does not have %s permission on space %s", id.User, perm, space))
+		return false
+	}
+	return true
+}
+
+// authorizeOwner is like authorize but is used for operations on an object
+// in space owned by owner, such as a running search.  The owner needs only
+// read permission on space while other users need admin permission.
+func (c *Core) authorizeOwner(w http.ResponseWriter, r *http.Request, space api.SpaceID, owner string) bool {
+	perm := auth.Admin
+	if owner == getUser(r.Context()) {
+		perm = auth.Read
+	}
+	return c.authorize(w, r, space, perm)
+}
+
+// canRead returns true if the request's identity may read space.
+func (c *Core) canRead(r *http.Request, space api.SpaceID) bool {
+	if c.auth == nil {
+		return true
+	}
+	id := auth.IdentityFromContext(r.Context())
+	return id != nil && id.Allowed(space, auth.Read)
+}
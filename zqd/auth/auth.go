@@ -0,0 +1,204 @@
+// Package auth implements authentication and per-space authorization of
+// zqd requests.
+//
+// A request authenticates by carrying a bearer token in its Authorization
+// header.  The token is either one of the static tokens listed in the
+// configuration or a JWT signed with HS256 using the configured secret.
+// Either way, the token resolves to an Identity holding the name of the user
+// and the user's permission on each space.
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+)
+
+// A Permission is a level of access to a space.  Each level includes the
+// levels below it.
+type Permission int
+
+const (
+	// None grants no access.
+	None Permission = iota
+	// Read allows searching a space and reading its data and indexes.
+	Read
+	// Write additionally allows adding data to a space, deleting data
+	// from it, and creating indexes.
+	Write
+	// Admin additionally allows renaming and deleting a space and
+	// creating subspaces of it.
+	Admin
+)
+
+func (p Permission) String() string {
+	switch p {
+	case None:
+		return "none"
+	case Read:
+		return "read"
+	case Write:
+		return "write"
+	case Admin:
+		return "admin"
+	}
+	return fmt.Sprintf("Permission(%d)", int(p))
+}
+
+func ParsePermission(s string) (Permission, error) {
+	switch s {
+	case "none":
+		return None, nil
+	case "read":
+		return Read, nil
+	case "write":
+		return Write, nil
+	case "admin":
+		return Admin, nil
+	}
+	return None, fmt.Errorf("unknown permission: %q", s)
+}
+
+func (p *Permission) UnmarshalText(text []byte) error {
+	var err error
+	*p, err = ParsePermission(string(text))
+	return err
+}
+
+func (p Permission) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// AllSpaces is the space key of a permission that applies to every space,
+// including spaces not yet created.  Creating a space requires Admin
+// permission on AllSpaces.
+const AllSpaces = "*"
+
+// An Identity is an authenticated user and the user's permissions, which
+// are keyed by space ID or by AllSpaces.
+type Identity struct {
+	User        string
+	Permissions map[string]Permission
+}
+
+// Permission returns the greater of the identity's permission on space and
+// its permission on AllSpaces.  If space is empty, only the permission on
+// AllSpaces is considered.
+func (i *Identity) Permission(space api.SpaceID) Permission {
+	p := i.Permissions[AllSpaces]
+	if space != "" {
+		if sp := i.Permissions[string(space)]; sp > p {
+			p = sp
+		}
+	}
+	return p
+}
+
+// Allowed returns true if the identity has at least permission p on space.
+func (i *Identity) Allowed(space api.SpaceID, p Permission) bool {
+	return i.Permission(space) >= p
+}
+
+type TokenConfig struct {
+	User        string                `yaml:"user"`
+	Token       string                `yaml:"token"`
+	Permissions map[string]Permission `yaml:"permissions"`
+}
+
+// JWTConfig configures the validation of JWTs.  A JWT must be signed with
+// HS256 using Secret.  If Issuer or Audience is set, the token's "iss"
+// or "aud" claim must match it.  The token's "sub" claim names the user
+// and its "permissions" claim maps space IDs or "*" to permission names.
+type JWTConfig struct {
+	Secret   string `yaml:"secret"`
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+}
+
+type Config struct {
+	Tokens []TokenConfig `yaml:"tokens"`
+	JWT    *JWTConfig    `yaml:"jwt"`
+}
+
+type Authenticator struct {
+	// tokens is keyed by the SHA-256 hash of each static token so that
+	// lookups do not compare secrets directly.
+	tokens map[[sha256.Size]byte]*Identity
+	jwt    *JWTConfig
+	now    func() time.Time
+}
+
+func New(conf Config) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens: make(map[[sha256.Size]byte]*Identity),
+		now:    time.Now,
+	}
+	for _, t := range conf.Tokens {
+		if t.User == "" {
+			return nil, fmt.Errorf("auth token without a user")
+		}
+		if t.Token == "" {
+			return nil, fmt.Errorf("auth token for user %s is empty", t.User)
+		}
+		key := sha256.Sum256([]byte(t.Token))
+		if _, ok := a.tokens[key]; ok {
+			return nil, fmt.Errorf("auth token for user %s is not unique", t.User)
+		}
+		a.tokens[key] = &Identity{User: t.User, Permissions: t.Permissions}
+	}
+	if conf.JWT != nil {
+		if conf.JWT.Secret == "" {
+			return nil, fmt.Errorf("auth jwt secret is empty")
+		}
+		a.jwt = conf.JWT
+	}
+	if len(a.tokens) == 0 && a.jwt == nil {
+		return nil, fmt.Errorf("auth configuration has no tokens and no jwt secret")
+	}
+	return a, nil
+}
+
+// Authenticate returns the identity of the bearer token in the request's
+// Authorization header or an error of kind zqe.Unauthorized.
+func (a *Authenticator) Authenticate(r *http.Request) (*Identity, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return nil, zqe.E(zqe.Unauthorized, "no bearer token")
+	}
+	const prefix = "Bearer "
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return nil, zqe.E(zqe.Unauthorized, "authorization header is not a bearer token")
+	}
+	token := strings.TrimSpace(header[len(prefix):])
+	if id, ok := a.tokens[sha256.Sum256([]byte(token))]; ok {
+		return id, nil
+	}
+	if a.jwt != nil && strings.Count(token, ".") == 2 {
+		id, err := a.verifyJWT(token)
+		if err != nil {
+			return nil, zqe.E(zqe.Unauthorized, err)
+		}
+		return id, nil
+	}
+	return nil, zqe.E(zqe.Unauthorized, "invalid token")
+}
+
+type contextKey struct{}
+
+// ContextWithIdentity returns a copy of ctx holding id.
+func ContextWithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// IdentityFromContext returns the identity added to ctx by
+// ContextWithIdentity or nil if there is none.
+func IdentityFromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(contextKey{}).(*Identity)
+	return id
+}
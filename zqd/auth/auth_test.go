@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/zqe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJWT(secret, header, claims string) string {
+	enc := base64.RawURLEncoding
+	s := enc.EncodeToString([]byte(header)) + "." + enc.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(s))
+	return s + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+func authenticate(a *Authenticator, token string) (*Identity, error) {
+	r, _ := http.NewRequest("GET", "/space", nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return a.Authenticate(r)
+}
+
+func TestIdentityPermission(t *testing.T) {
+	id := &Identity{User: "u", Permissions: map[string]Permission{
+		AllSpaces: Read,
+		"sp1":     Admin,
+	}}
+	assert.Equal(t, Admin, id.Permission("sp1"))
+	assert.Equal(t, Read, id.Permission("sp2"))
+	assert.True(t, id.Allowed("sp2", Read))
+	assert.False(t, id.Allowed("sp2", Write))
+	assert.False(t, id.Allowed(AllSpaces, Admin))
+}
+
+func TestStaticTokens(t *testing.T) {
+	a, err := New(Config{Tokens: []TokenConfig{
+		{User: "alice", Token: "secret1", Permissions: map[string]Permission{"sp1": Write}},
+	}})
+	require.NoError(t, err)
+
+	id, err := authenticate(a, "secret1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", id.User)
+	assert.Equal(t, Write, id.Permission("sp1"))
+
+	_, err = authenticate(a, "secret2")
+	assert.True(t, zqe.IsUnauthorized(err))
+	_, err = authenticate(a, "")
+	assert.True(t, zqe.IsUnauthorized(err))
+
+	_, err = New(Config{Tokens: []TokenConfig{{User: "a", Token: "t"}, {User: "b", Token: "t"}}})
+	assert.Error(t, err)
+	_, err = New(Config{})
+	assert.Error(t, err)
+}
+
+func TestJWT(t *testing.T) {
+	const secret = "jwtsecret"
+	a, err := New(Config{JWT: &JWTConfig{Secret: secret, Issuer: "iss", Audience: "zqd"}})
+	require.NoError(t, err)
+	a.now = func() time.Time { return time.Unix(1000, 0) }
+	header := `{"alg":"HS256","typ":"JWT"}`
+
+	token := makeJWT(secret, header, `{"sub":"bob","iss":"iss","aud":["x","zqd"],"exp":2000,"nbf":500,"permissions":{"*":"read","sp1":"admin"}}`)
+	id, err := authenticate(a, token)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", id.User)
+	assert.Equal(t, Admin, id.Permission("sp1"))
+	assert.Equal(t, Read, id.Permission("sp2"))
+
+	cases := map[string]string{
+		"expired":    makeJWT(secret, header, `{"sub":"bob","iss":"iss","aud":"zqd","exp":1000}`),
+		"not before": makeJWT(secret, header, `{"sub":"bob","iss":"iss","aud":"zqd","nbf":1001}`),
+		"issuer":     makeJWT(secret, header, `{"sub":"bob","iss":"other","aud":"zqd"}`),
+		"audience":   makeJWT(secret, header, `{"sub":"bob","iss":"iss","aud":"other"}`),
+		"subject":    makeJWT(secret, header, `{"iss":"iss","aud":"zqd"}`),
+		"permission": makeJWT(secret, header, `{"sub":"bob","iss":"iss","aud":"zqd","permissions":{"*":"root"}}`),
+		"signature":  makeJWT("wrong", header, `{"sub":"bob","iss":"iss","aud":"zqd"}`),
+		"algorithm":  makeJWT(secret, `{"alg":"none"}`, `{"sub":"bob","iss":"iss","aud":"zqd"}`),
+	}
+	for name, token := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := authenticate(a, token)
+			assert.True(t, zqe.IsUnauthorized(err), "error: %v", err)
+		})
+	}
+}
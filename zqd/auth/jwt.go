@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+	Subject     string            `json:"sub"`
+	Issuer      string            `json:"iss"`
+	Audience    jwtAudience       `json:"aud"`
+	ExpiresAt   *int64            `json:"exp"`
+	NotBefore   *int64            `json:"nbf"`
+	Permissions map[string]string `json:"permissions"`
+}
+
+// jwtAudience is the "aud" claim, which may be a string or an array of
+// strings.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = jwtAudience{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+	*a = ss
+	return nil
+}
+
+func (a jwtAudience) contains(s string) bool {
+	for _, aud := range a {
+		if aud == s {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyJWT checks the signature and claims of an HS256 JWT and returns the
+// identity it describes.
+func (a *Authenticator) verifyJWT(token string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed jwt header")
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, errors.New("malformed jwt header")
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported jwt algorithm: %q", header.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed jwt signature")
+	}
+	mac := hmac.New(sha256.New, []byte(a.jwt.Secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return nil, errors.New("invalid jwt signature")
+	}
+	b, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed jwt claims")
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return nil, errors.New("malformed jwt claims")
+	}
+	now := a.now()
+	if claims.ExpiresAt != nil && !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return nil, errors.New("jwt has expired")
+	}
+	if claims.NotBefore != nil && now.Before(time.Unix(*claims.NotBefore, 0)) {
+		return nil, errors.New("jwt is not yet valid")
+	}
+	if a.jwt.Issuer != "" && claims.Issuer != a.jwt.Issuer {
+		return nil, errors.New("jwt has wrong issuer")
+	}
+	if a.jwt.Audience != "" && !claims.Audience.contains(a.jwt.Audience) {
+		return nil, errors.New("jwt has wrong audience")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("jwt has no subject")
+	}
+	id := &Identity{
+		User:        claims.Subject,
+		Permissions: make(map[string]Permission),
+	}
+	for space, s := range claims.Permissions {
+		p, err := ParsePermission(s)
+		if err != nil {
+			return nil, fmt.Errorf("jwt permissions: %w", err)
+		}
+		id.Permissions[space] = p
+	}
+	return id, nil
+}
@@ -1,12 +1,20 @@
 package zqd
 
 import (
+	"context"
+	"crypto/tls"
 	"net/http"
 	"sync/atomic"
 
 	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
+	"github.com/brimsec/zq/zqd/ingest"
+	"github.com/brimsec/zq/zqd/jobs"
 	"github.com/brimsec/zq/zqd/pcapanalyzer"
+	"github.com/brimsec/zq/zqd/search"
 	"github.com/brimsec/zq/zqd/space"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -18,17 +26,56 @@ type Config struct {
 	Version  string
 	Suricata pcapanalyzer.Launcher
 	Zeek     pcapanalyzer.Launcher
-	Logger   *zap.Logger
+	// Analyzers lists further pcap analyzers run on each imported pcap.
+	Analyzers []pcapanalyzer.Analyzer
+	Logger    *zap.Logger
+	// Auth authenticates requests.  If nil, requests are not authenticated
+	// and every client has full access to every space.
+	Auth *auth.Authenticator
+	// Registerer, if not nil, is used to register the prometheus metrics
+	// of the Core.
+	Registerer prometheus.Registerer
+	// SearchLimits bounds the resources used by each search.  Searches
+	// may request tighter limits.
+	SearchLimits api.SearchLimits
+	// MaxSearches, if positive, is the number of searches that may run at
+	// once.  Up to MaxQueuedSearches further searches wait for a running
+	// search to finish, and searches beyond those are rejected.
+	MaxSearches       int
+	MaxQueuedSearches int
+	// Workers, if not empty, lists the URLs of worker zqd instances
+	// serving the same data directory.  Searches of archive spaces are
+	// distributed across the workers.
+	Workers []string
+	// WorkerToken, if not empty, is the bearer token sent to the workers.
+	// It must grant read permission on every space searched.
+	WorkerToken string
+	// WorkerTLS, if not nil, configures connections to workers at https
+	// URLs.
+	WorkerTLS *tls.Config
+	// LookupDirs lists the directories, besides those of the searched
+	// space, from which the lookup procs of searches may read tables.
+	LookupDirs []string
 }
 
 type Core struct {
-	Root      iosrc.URI
-	Version   string
-	Suricata  pcapanalyzer.Launcher
-	Zeek      pcapanalyzer.Launcher
-	spaces    *space.Manager
-	taskCount int64
-	logger    *zap.Logger
+	Root       iosrc.URI
+	Version    string
+	Suricata   pcapanalyzer.Launcher
+	Zeek       pcapanalyzer.Launcher
+	Analyzers  []pcapanalyzer.Analyzer
+	spaces     *space.Manager
+	taskCount  int64
+	logger     *zap.Logger
+	auth       *auth.Authenticator
+	searches   *searchRegistry
+	jobs       *jobs.Manager
+	tail       *ingest.Tail
+	metrics    *metrics
+	limits     api.SearchLimits
+	admission  *admission
+	workers    *search.WorkerPool
+	lookupDirs []iosrc.URI
 }
 
 func NewCore(conf Config) (*Core, error) {
@@ -44,18 +91,98 @@ func NewCore(conf Config) (*Core, error) {
 	if err != nil {
 		return nil, err
 	}
+	jobmgr, err := loadJobs(spaces, logger)
+	if err != nil {
+		return nil, err
+	}
+	lookupDirs, err := parseURIs(conf.LookupDirs)
+	if err != nil {
+		jobmgr.Close()
+		return nil, err
+	}
 	version := conf.Version
 	if version == "" {
 		version = "unknown"
 	}
-	return &Core{
-		Root:     root,
-		Version:  version,
-		Suricata: conf.Suricata,
-		Zeek:     conf.Zeek,
-		spaces:   spaces,
-		logger:   logger,
-	}, nil
+	c := &Core{
+		Root:       root,
+		Version:    version,
+		Suricata:   conf.Suricata,
+		Zeek:       conf.Zeek,
+		Analyzers:  conf.Analyzers,
+		spaces:     spaces,
+		logger:     logger,
+		auth:       conf.Auth,
+		searches:   newSearchRegistry(),
+		jobs:       jobmgr,
+		tail:       ingest.NewTail(),
+		metrics:    newMetrics(),
+		limits:     serverLimits(conf),
+		admission:  newAdmission(conf.MaxSearches, conf.MaxQueuedSearches),
+		lookupDirs: lookupDirs,
+	}
+	if len(conf.Workers) > 0 {
+		c.workers = search.NewWorkerPool(conf.Workers, conf.WorkerToken, conf.WorkerTLS, logger)
+	}
+	if conf.Registerer != nil {
+		if err := c.metrics.register(conf.Registerer, c); err != nil {
+			c.Shutdown()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Shutdown stops the background work of c.  It does not wait for running
+// searches or jobs.
+func (c *Core) Shutdown() {
+	c.jobs.Close()
+}
+
+func parseURIs(paths []string) ([]iosrc.URI, error) {
+	var uris []iosrc.URI
+	for _, p := range paths {
+		u, err := iosrc.ParseURI(p)
+		if err != nil {
+			return nil, err
+		}
+		uris = append(uris, u)
+	}
+	return uris, nil
+}
+
+// loadJobs returns a job manager holding the jobs stored in each space.
+func loadJobs(spaces *space.Manager, logger *zap.Logger) (*jobs.Manager, error) {
+	ctx := context.Background()
+	infos, err := spaces.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	valid := func(id api.SpaceID) bool {
+		_, err := spaces.Get(id)
+		return err == nil
+	}
+	mgr := jobs.NewManager(logger)
+	// Subspaces share the directory of their parent, so load each
+	// directory once.
+	loaded := make(map[string]bool)
+	for _, info := range infos {
+		s, err := spaces.Get(info.ID)
+		if err != nil {
+			mgr.Close()
+			return nil, err
+		}
+		dir := s.Path()
+		if loaded[dir.String()] {
+			continue
+		}
+		loaded[dir.String()] = true
+		if err := mgr.Load(ctx, dir, valid); err != nil {
+			mgr.Close()
+			return nil, err
+		}
+	}
+	return mgr, nil
 }
 
 func (c *Core) HasSuricata() bool {
@@ -66,6 +193,12 @@ func (c *Core) HasZeek() bool {
 	return c.Zeek != nil
 }
 
+// HasPcapAnalyzer returns true if Zeek or another pcap analyzer is
+// available to import pcaps.
+func (c *Core) HasPcapAnalyzer() bool {
+	return c.HasZeek() || len(c.Analyzers) > 0
+}
+
 func (c *Core) requestLogger(r *http.Request) *zap.Logger {
 	return c.logger.With(zap.String("request_id", getRequestID(r.Context())))
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
@@ -18,31 +19,67 @@ type handler struct {
 	core *Core
 }
 
+// Handle registers a handler that requires an authenticated request.  The
+// handler is responsible for any further authorization.
 func (h *handler) Handle(path string, f handlerFunc) *mux.Route {
 	return h.Router.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
-		f(h.core, w, r)
+		if h.core.authorize(w, r, "", auth.None) {
+			f(h.core, w, r)
+		}
+	})
+}
+
+// HandleSpace registers a handler that requires permission perm on the
+// space named by the path's "space" variable.
+func (h *handler) HandleSpace(path string, perm auth.Permission, f handlerFunc) *mux.Route {
+	return h.Router.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		space := api.SpaceID(mux.Vars(r)["space"])
+		if h.core.authorize(w, r, space, perm) {
+			f(h.core, w, r)
+		}
 	})
 }
 
 func NewHandler(core *Core, logger *zap.Logger) http.Handler {
 	h := handler{Router: mux.NewRouter(), core: core}
 	h.Use(requestIDMiddleware())
+	h.Use(metricsMiddleware(core.metrics))
+	h.Use(authMiddleware(core))
 	h.Use(accessLogMiddleware(logger))
 	h.Use(panicCatchMiddleware(logger))
 	h.Handle("/space", handleSpaceList).Methods("GET")
 	h.Handle("/space", handleSpacePost).Methods("POST")
-	h.Handle("/space/{space}", handleSpaceGet).Methods("GET")
-	h.Handle("/space/{space}", handleSpacePut).Methods("PUT")
-	h.Handle("/space/{space}", handleSpaceDelete).Methods("DELETE")
-	h.Handle("/space/{space}/pcap", handlePcapSearch).Methods("GET")
-	h.Handle("/space/{space}/pcap", handlePcapPost).Methods("POST")
-	h.Handle("/space/{space}/log", handleLogPost).Methods("POST")
-	h.Handle("/space/{space}/index", handleIndexPost).Methods("POST")
-	h.Handle("/space/{space}/indexsearch", handleIndexSearch).Methods("POST")
-	h.Handle("/space/{space}/archivestat", handleArchiveStat).Methods("GET")
-	h.Handle("/space/{space}/subspace", handleSubspacePost).Methods("POST")
+	h.HandleSpace("/space/{space}", auth.Read, handleSpaceGet).Methods("GET")
+	h.HandleSpace("/space/{space}", auth.Admin, handleSpacePut).Methods("PUT")
+	h.HandleSpace("/space/{space}", auth.Admin, handleSpaceDelete).Methods("DELETE")
+	h.HandleSpace("/space/{space}/pcap", auth.Read, handlePcapSearch).Methods("GET")
+	h.HandleSpace("/space/{space}/pcap", auth.Write, handlePcapPost).Methods("POST")
+	h.HandleSpace("/space/{space}/log", auth.Write, handleLogPost).Methods("POST")
+	h.HandleSpace("/space/{space}/tail", auth.Read, handleTail).Methods("POST")
+	h.HandleSpace("/space/{space}/index", auth.Write, handleIndexPost).Methods("POST")
+	h.HandleSpace("/space/{space}/indexsearch", auth.Read, handleIndexSearch).Methods("POST")
+	h.HandleSpace("/space/{space}/indexdef", auth.Read, handleIndexDefList).Methods("GET")
+	h.HandleSpace("/space/{space}/indexdef", auth.Write, handleIndexDefPost).Methods("POST")
+	h.HandleSpace("/space/{space}/indexdef/{name}", auth.Write, handleIndexDefDelete).Methods("DELETE")
+	h.HandleSpace("/space/{space}/archivestat", auth.Read, handleArchiveStat).Methods("GET")
+	h.HandleSpace("/space/{space}/archivedelete", auth.Write, handleArchiveDelete).Methods("POST")
+	h.HandleSpace("/space/{space}/subspace", auth.Admin, handleSubspacePost).Methods("POST")
+	h.HandleSpace("/space/{space}/query", auth.Read, handleQueryList).Methods("GET")
+	h.HandleSpace("/space/{space}/query", auth.Write, handleQueryPost).Methods("POST")
+	h.HandleSpace("/space/{space}/query/{name}", auth.Read, handleQueryGet).Methods("GET")
+	h.HandleSpace("/space/{space}/query/{name}", auth.Write, handleQueryPut).Methods("PUT")
+	h.HandleSpace("/space/{space}/query/{name}", auth.Write, handleQueryDelete).Methods("DELETE")
+	h.HandleSpace("/space/{space}/query/{name}/search", auth.Read, handleQueryRun).Methods("POST")
 	h.Handle("/search", handleSearch).Methods("POST")
+	h.Handle("/search", handleSearchList).Methods("GET")
+	h.Handle("/search/{id}", handleSearchDelete).Methods("DELETE")
 	h.Handle("/worker", handleWorker).Methods("POST")
+	h.Handle("/job", handleJobPost).Methods("POST")
+	h.Handle("/job", handleJobList).Methods("GET")
+	h.Handle("/job/{job}", handleJobGet).Methods("GET")
+	h.Handle("/job/{job}", handleJobPut).Methods("PUT")
+	h.Handle("/job/{job}", handleJobDelete).Methods("DELETE")
+	h.Handle("/job/{job}/results", handleJobResults).Methods("GET")
 	h.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(&api.VersionResponse{Version: core.Version})
@@ -6,19 +6,28 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
 	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/ctxio"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
 	"github.com/brimsec/zq/zqd/ingest"
+	"github.com/brimsec/zq/zqd/jobs"
+	"github.com/brimsec/zq/zqd/pcapanalyzer"
 	"github.com/brimsec/zq/zqd/search"
 	"github.com/brimsec/zq/zqd/space"
 	"github.com/brimsec/zq/zqd/storage/archivestore"
 	"github.com/brimsec/zq/zqe"
+	"github.com/brimsec/zq/zql"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
@@ -42,6 +51,14 @@ func errorResponse(e error) (status int, ae *api.Error) {
 		status = http.StatusBadRequest
 	case zqe.Conflict:
 		status = http.StatusConflict
+	case zqe.Unauthorized:
+		status = http.StatusUnauthorized
+	case zqe.Forbidden:
+		status = http.StatusForbidden
+	case zqe.LimitExceeded:
+		status = http.StatusUnprocessableEntity
+	case zqe.Unavailable:
+		status = http.StatusServiceUnavailable
 	}
 
 	ae.Kind = ze.Kind.String()
@@ -78,12 +95,26 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	if !request(c, w, r, &req) {
 		return
 	}
+	if !c.authorize(w, r, req.Space, auth.Read) {
+		return
+	}
 
 	s, err := c.spaces.Get(req.Space)
 	if err != nil {
 		respondError(c, w, r, err)
 		return
 	}
+	runSearch(c, w, r, s, "search", req)
+}
+
+// runSearch runs the search req on space s and writes its results to w.
+func runSearch(c *Core, w http.ResponseWriter, r *http.Request, s space.Space, kind string, req api.SearchRequest) {
+	limits, err := c.searchLimits(req.Limits)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	req.Limits = limits
 
 	ctx, cancel, err := s.StartOp(r.Context())
 	if err != nil {
@@ -99,6 +130,18 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, err)
 		return
 	}
+	if err := c.checkLookups(ctx, s, srch.Proc()); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	srch.SetWorkers(c.workers)
+
+	release, err := c.admission.acquire(ctx)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer release()
 
 	out, err := getSearchOutput(w, r)
 	if err != nil {
@@ -106,6 +149,8 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ctx, out, done := c.trackSearch(ctx, w, r, kind, req, out)
+	defer done()
 	w.Header().Set("Content-Type", out.ContentType())
 	if err := srch.Run(ctx, s.Storage(), out); err != nil {
 		c.requestLogger(r).Warn("Error writing response", zap.Error(err))
@@ -117,6 +162,9 @@ func handleWorker(c *Core, w http.ResponseWriter, httpReq *http.Request) {
 	if !request(c, w, httpReq, &req) {
 		return
 	}
+	if !c.authorize(w, httpReq, req.Space, auth.Read) {
+		return
+	}
 
 	space, err := c.spaces.Get(req.Space)
 	if err != nil {
@@ -131,11 +179,29 @@ func handleWorker(c *Core, w http.ResponseWriter, httpReq *http.Request) {
 	}
 	defer cancel()
 
+	limits, err := c.searchLimits(req.Limits)
+	if err != nil {
+		respondError(c, w, httpReq, err)
+		return
+	}
+	req.Limits = limits
+
 	srch, err := search.NewWorkerOp(req)
 	if err != nil {
 		respondError(c, w, httpReq, err)
 		return
 	}
+	if err := c.checkLookups(ctx, space, srch.Proc()); err != nil {
+		respondError(c, w, httpReq, err)
+		return
+	}
+
+	release, err := c.admission.acquire(ctx)
+	if err != nil {
+		respondError(c, w, httpReq, err)
+		return
+	}
+	defer release()
 
 	out, err := getSearchOutput(w, httpReq)
 	if err != nil {
@@ -143,6 +209,8 @@ func handleWorker(c *Core, w http.ResponseWriter, httpReq *http.Request) {
 		return
 	}
 
+	ctx, out, done := c.trackSearch(ctx, w, httpReq, "worker", req.SearchRequest, out)
+	defer done()
 	w.Header().Set("Content-Type", out.ContentType())
 
 	if err := srch.Run(ctx, space.Storage(), out); err != nil {
@@ -151,6 +219,193 @@ func handleWorker(c *Core, w http.ResponseWriter, httpReq *http.Request) {
 
 }
 
+func handleSearchList(c *Core, w http.ResponseWriter, r *http.Request) {
+	searches := c.searches.list()
+	visible := searches[:0]
+	for _, s := range searches {
+		if c.canRead(r, s.Space) {
+			visible = append(visible, s)
+		}
+	}
+	respond(c, w, r, http.StatusOK, visible)
+}
+
+func handleSearchDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "invalid search id: %s", mux.Vars(r)["id"]))
+		return
+	}
+	s, ok := c.searches.get(id)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.NotFound, "search %d not found", id))
+		return
+	}
+	// Users may cancel their own searches.  Canceling the searches of
+	// others requires admin permission on the space.
+	info := s.snapshot()
+	if !c.authorizeOwner(w, r, info.Space, info.User) {
+		return
+	}
+	s.cancel()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleJobPost(c *Core, w http.ResponseWriter, r *http.Request) {
+	var req api.JobPostRequest
+	if !request(c, w, r, &req) {
+		return
+	}
+	if !c.authorize(w, r, req.Space, auth.Read) {
+		return
+	}
+	s, err := c.spaces.Get(req.Space)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	limits, err := c.searchLimits(req.Limits)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	req.Limits = limits
+	srch, err := search.NewSearchOp(req.SearchRequest)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	if err := c.checkLookups(r.Context(), s, srch.Proc()); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	srch.SetWorkers(c.workers)
+	// The job outlives the request, so its operation is not derived from
+	// the request's context.
+	ctx, cancel, err := s.StartOp(context.Background())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	user := getUser(r.Context())
+	info, err := c.jobs.Start(ctx, cancel, s.Path(), user, req, func(ctx context.Context, out *jobs.Output) error {
+		release, err := c.admission.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer release()
+		_, ctx, sout, done := c.registerSearch(ctx, "job", user, req.SearchRequest, out)
+		defer done()
+		return srch.Run(ctx, s.Storage(), sout)
+	})
+	if err != nil {
+		cancel()
+		respondError(c, w, r, err)
+		return
+	}
+	respond(c, w, r, http.StatusAccepted, info)
+}
+
+func handleJobList(c *Core, w http.ResponseWriter, r *http.Request) {
+	infos := c.jobs.List()
+	visible := infos[:0]
+	for _, info := range infos {
+		if c.canRead(r, info.Space) {
+			visible = append(visible, info)
+		}
+	}
+	respond(c, w, r, http.StatusOK, visible)
+}
+
+// extractJob returns the job named in the request's path if the requester
+// may read it.  Otherwise, it responds with an error and returns nil.
+func extractJob(c *Core, w http.ResponseWriter, r *http.Request) *api.JobInfo {
+	info, err := c.jobs.Get(mux.Vars(r)["job"])
+	if err != nil {
+		respondError(c, w, r, err)
+		return nil
+	}
+	if !c.authorize(w, r, info.Space, auth.Read) {
+		return nil
+	}
+	return &info
+}
+
+func handleJobGet(c *Core, w http.ResponseWriter, r *http.Request) {
+	info := extractJob(c, w, r)
+	if info == nil {
+		return
+	}
+	respond(c, w, r, http.StatusOK, info)
+}
+
+func handleJobPut(c *Core, w http.ResponseWriter, r *http.Request) {
+	info := extractJob(c, w, r)
+	if info == nil || !c.authorizeOwner(w, r, info.Space, info.User) {
+		return
+	}
+	var req api.JobPutRequest
+	if !request(c, w, r, &req) {
+		return
+	}
+	updated, err := c.jobs.SetTTL(info.ID, req.TTL)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	respond(c, w, r, http.StatusOK, updated)
+}
+
+func handleJobDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	info := extractJob(c, w, r)
+	if info == nil || !c.authorizeOwner(w, r, info.Space, info.User) {
+		return
+	}
+	if err := c.jobs.Delete(info.ID); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleJobResults sends the results of a job in the format requested as
+// for a search.  The "offset" and "limit" query parameters select a page of
+// the results.
+func handleJobResults(c *Core, w http.ResponseWriter, r *http.Request) {
+	info := extractJob(c, w, r)
+	if info == nil {
+		return
+	}
+	var offset, limit int64
+	for name, p := range map[string]*int64{"offset": &offset, "limit": &limit} {
+		v := r.URL.Query().Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			respondError(c, w, r, zqe.E(zqe.Invalid, "invalid %s: %s", name, v))
+			return
+		}
+		*p = n
+	}
+	out, err := getSearchOutput(w, r)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	reader, err := c.jobs.Results(r.Context(), info.ID, offset, limit)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer reader.Close()
+	w.Header().Set("Content-Type", out.ContentType())
+	if err := search.SendFromReader(out, reader); err != nil {
+		c.requestLogger(r).Warn("Error writing response", zap.Error(err))
+	}
+}
+
 func getSearchOutput(w http.ResponseWriter, r *http.Request) (search.Output, error) {
 	ctrl := true
 	if r.URL.Query().Get("noctrl") != "" {
@@ -191,6 +446,12 @@ func handlePcapSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, zqe.E(zqe.Invalid, err))
 		return
 	}
+	if req.Stream != "" {
+		if err := pcap.ValidStreamFormat(req.Stream); err != nil {
+			respondError(c, w, r, err)
+			return
+		}
+	}
 	pspace, ok := s.(space.PcapSpace)
 	if !ok {
 		respondError(c, w, r, zqe.E(zqe.Invalid, "space does not support pcap searches"))
@@ -211,6 +472,21 @@ func handlePcapSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer reader.Close()
+	if req.Stream != "" {
+		matches, err := pcapio.NewReader(reader)
+		if err != nil {
+			respondError(c, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if req.Stream == pcap.StreamFollow {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		}
+		if err := pcap.WriteStream(ctx, w, matches, req.Stream); err != nil {
+			c.requestLogger(r).Error("Error writing stream response", zap.Error(err))
+		}
+		return
+	}
 	w.Header().Set("Content-Type", "application/vnd.tcpdump.pcap")
 	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.pcap", reader.ID()))
 	_, err = ctxio.Copy(ctx, w, reader)
@@ -225,6 +501,13 @@ func handleSpaceList(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, err)
 		return
 	}
+	readable := spaces[:0]
+	for _, sp := range spaces {
+		if c.canRead(r, sp.ID) {
+			readable = append(readable, sp)
+		}
+	}
+	spaces = readable
 	respond(c, w, r, http.StatusOK, spaces)
 }
 
@@ -251,6 +534,9 @@ func handleSpaceGet(c *Core, w http.ResponseWriter, r *http.Request) {
 }
 
 func handleSpacePost(c *Core, w http.ResponseWriter, r *http.Request) {
+	if !c.authorize(w, r, auth.AllSpaces, auth.Admin) {
+		return
+	}
 	var req api.SpacePostRequest
 	if !request(c, w, r, &req) {
 		return
@@ -338,12 +624,13 @@ func handleSpaceDelete(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, err)
 		return
 	}
+	c.jobs.DeleteSpace(api.SpaceID(id))
 	w.WriteHeader(http.StatusNoContent)
 }
 
 func handlePcapPost(c *Core, w http.ResponseWriter, r *http.Request) {
-	if !c.HasZeek() {
-		respondError(c, w, r, zqe.E(zqe.Invalid, "pcap post not supported: Zeek not found"))
+	if !c.HasPcapAnalyzer() {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "pcap post not supported: no pcap analyzer configured"))
 		return
 	}
 	logger := c.requestLogger(r)
@@ -376,7 +663,13 @@ func handlePcapPost(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, zqe.E(zqe.Invalid, "storage does not support pcap import"))
 		return
 	}
-	op, warnings, err := ingest.NewPcapOp(ctx, pcapstore, logstore, req.Path, c.Suricata, c.Zeek)
+	analyzers := make([]pcapanalyzer.Analyzer, len(c.Analyzers))
+	for i, a := range c.Analyzers {
+		a.Launcher = c.metrics.launcher(a.Name, a.Launcher)
+		analyzers[i] = a
+	}
+	op, warnings, err := ingest.NewPcapOp(ctx, pcapstore, logstore, req.Path,
+		c.metrics.launcher("suricata", c.Suricata), c.metrics.launcher("zeek", c.Zeek), analyzers, c.tail.Publisher(s.ID()))
 	if err != nil {
 		respondError(c, w, r, err)
 		return
@@ -431,6 +724,7 @@ func handlePcapPost(c *Core, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		if done {
+			c.metrics.ingestBytes.WithLabelValues("pcap").Add(float64(status.PcapReadSize))
 			break
 		}
 	}
@@ -471,7 +765,7 @@ func handleLogPost(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, zqe.E(zqe.Invalid, "empty paths"))
 		return
 	}
-	op, err := ingest.NewLogOp(ctx, s.Storage(), req)
+	op, err := ingest.NewLogOp(ctx, s.Storage(), req, c.tail.Publisher(s.ID()))
 	if err != nil {
 		respondError(c, w, r, err)
 		return
@@ -509,7 +803,9 @@ loop:
 		}
 	}
 	// send final status
-	if err := pipe.Send(op.Stats()); err != nil {
+	stats := op.Stats()
+	c.metrics.ingestBytes.WithLabelValues("log").Add(float64(stats.LogReadSize))
+	if err := pipe.Send(stats); err != nil {
 		logger.Warn("error sending payload", zap.Error(err))
 		return
 	}
@@ -519,6 +815,119 @@ loop:
 	}
 }
 
+// tailFlushInterval is how long records are batched before being sent to
+// a tail client.
+const tailFlushInterval = 100 * time.Millisecond
+
+func handleTail(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	var req api.TailRequest
+	if !request(c, w, r, &req) {
+		return
+	}
+	proc, err := ast.UnpackJSON(nil, req.Proc)
+	if err != nil {
+		respondError(c, w, r, zqe.E(zqe.Invalid, err))
+		return
+	}
+	fp, ok := proc.(*ast.FilterProc)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "tail requires a filter"))
+		return
+	}
+	// Records from each ingest operation have types from a different
+	// context, so they are translated into zctx before being filtered.
+	zctx := resolver.NewContext()
+	f, err := filter.Compile(zctx, fp.Filter)
+	if err != nil {
+		respondError(c, w, r, zqe.E(zqe.Invalid, err))
+		return
+	}
+	if r.URL.Query().Get("format") == "json" {
+		// The json output is not sent until the search ends.
+		respondError(c, w, r, zqe.E(zqe.Invalid, "tail does not support json format"))
+		return
+	}
+	out, err := getSearchOutput(w, r)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	ctx, cancel, err := s.StartOp(r.Context())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer cancel()
+
+	sub := c.tail.Subscribe(s.ID())
+	defer sub.Close()
+	w.Header().Set("Content-Type", out.ContentType())
+	logger := c.requestLogger(r)
+	if err := out.SendControl(&api.TaskStart{Type: "TaskStart", TaskID: 0}); err != nil {
+		logger.Warn("Error writing response", zap.Error(err))
+		return
+	}
+	w.(http.Flusher).Flush()
+
+	types := make(map[*zng.TypeRecord]*zng.TypeRecord)
+	var batch zbuf.Array
+	ticker := time.NewTicker(tailFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := out.End(&api.TaskEnd{Type: "TaskEnd", TaskID: 0}); err != nil {
+				logger.Warn("Error writing response", zap.Error(err))
+			}
+			return
+		case rec := <-sub.Records():
+			typ, ok := types[rec.Type]
+			if !ok {
+				if len(types) > 1024 {
+					types = make(map[*zng.TypeRecord]*zng.TypeRecord)
+				}
+				typ, err = zctx.TranslateTypeRecord(rec.Type)
+				if err != nil {
+					logger.Warn("Error translating record type", zap.Error(err))
+					return
+				}
+				types[rec.Type] = typ
+			}
+			rec = zng.NewRecord(typ, rec.Raw)
+			if !f(rec) {
+				continue
+			}
+			batch.Append(rec)
+			if len(batch) < search.DefaultMTU {
+				continue
+			}
+		case <-ticker.C:
+			if n := sub.Dropped(); n > 0 {
+				err := out.SendControl(&api.SearchWarning{
+					Type:    "SearchWarning",
+					Warning: fmt.Sprintf("tail dropped %d records", n),
+				})
+				if err != nil {
+					logger.Warn("Error writing response", zap.Error(err))
+					return
+				}
+			}
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		if err := out.SendBatch(0, batch); err != nil {
+			logger.Warn("Error writing response", zap.Error(err))
+			return
+		}
+		batch = nil
+	}
+}
+
 func handleIndexPost(c *Core, w http.ResponseWriter, r *http.Request) {
 	s := extractSpace(c, w, r)
 	if s == nil {
@@ -535,6 +944,17 @@ func handleIndexPost(c *Core, w http.ResponseWriter, r *http.Request) {
 	if !request(c, w, r, &req) {
 		return
 	}
+	if req.AST != nil {
+		proc, err := ast.UnpackJSON(nil, req.AST)
+		if err != nil {
+			respondError(c, w, r, zqe.E(zqe.Invalid, err))
+			return
+		}
+		if err := c.checkLookups(ctx, s, proc); err != nil {
+			respondError(c, w, r, err)
+			return
+		}
+	}
 
 	store, ok := s.Storage().(*archivestore.Storage)
 	if !ok {
@@ -548,6 +968,214 @@ func handleIndexPost(c *Core, w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+type IndexDefStore interface {
+	IndexDefCreate(context.Context, api.IndexDef) error
+	IndexDefList(context.Context) ([]api.IndexDef, error)
+	IndexDefDrop(context.Context, string) error
+}
+
+func extractIndexDefStore(c *Core, w http.ResponseWriter, r *http.Request, s space.Space) IndexDefStore {
+	store, ok := s.Storage().(IndexDefStore)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "space storage does not support index definitions"))
+		return nil
+	}
+	return store
+}
+
+func handleIndexDefList(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	ctx, cancel, err := s.StartOp(r.Context())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer cancel()
+
+	store := extractIndexDefStore(c, w, r, s)
+	if store == nil {
+		return
+	}
+	defs, err := store.IndexDefList(ctx)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	if defs == nil {
+		defs = []api.IndexDef{}
+	}
+	respond(c, w, r, http.StatusOK, defs)
+}
+
+func handleIndexDefPost(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	ctx, cancel, err := s.StartOp(r.Context())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer cancel()
+
+	var req api.IndexDef
+	if !request(c, w, r, &req) {
+		return
+	}
+	if req.ZQL != "" {
+		proc, err := zql.ParseProc(req.ZQL)
+		if err != nil {
+			respondError(c, w, r, zqe.E(zqe.Invalid, err))
+			return
+		}
+		if err := c.checkLookups(ctx, s, proc); err != nil {
+			respondError(c, w, r, err)
+			return
+		}
+	}
+	store := extractIndexDefStore(c, w, r, s)
+	if store == nil {
+		return
+	}
+	if err := store.IndexDefCreate(ctx, req); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleIndexDefDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	ctx, cancel, err := s.StartOp(r.Context())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer cancel()
+
+	store := extractIndexDefStore(c, w, r, s)
+	if store == nil {
+		return
+	}
+	if err := store.IndexDefDrop(ctx, mux.Vars(r)["name"]); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleQueryList(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	queries, err := space.ListQueries(s)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	respond(c, w, r, http.StatusOK, queries)
+}
+
+func handleQueryPost(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	var req api.SavedQuery
+	if !request(c, w, r, &req) {
+		return
+	}
+	if err := space.CreateQuery(s, req); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleQueryGet(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	q, err := space.GetQuery(s, mux.Vars(r)["name"])
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	respond(c, w, r, http.StatusOK, q)
+}
+
+func handleQueryPut(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	var req api.SavedQuery
+	if !request(c, w, r, &req) {
+		return
+	}
+	if err := space.UpdateQuery(s, mux.Vars(r)["name"], req); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleQueryDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	if err := space.DeleteQuery(s, mux.Vars(r)["name"]); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleQueryRun(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	var req api.QueryRunRequest
+	if !request(c, w, r, &req) {
+		return
+	}
+	q, err := space.GetQuery(s, mux.Vars(r)["name"])
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	proc, err := compileQuery(q, req.Params)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	sreq := api.SearchRequest{
+		Space:  s.ID(),
+		Proc:   proc,
+		Span:   req.Span,
+		Dir:    req.Dir,
+		Limits: req.Limits,
+	}
+	if sreq.Span.Dur == 0 {
+		sreq.Span = nano.MaxSpan
+	}
+	if sreq.Dir == 0 {
+		sreq.Dir = -1
+	}
+	runSearch(c, w, r, s, "query", sreq)
+}
+
 func handleIndexSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	s := extractSpace(c, w, r)
 	if s == nil {
@@ -629,6 +1257,50 @@ func handleArchiveStat(c *Core, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+type ArchiveDeleter interface {
+	Delete(context.Context, ast.BooleanExpr) (int64, error)
+}
+
+func handleArchiveDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	s := extractSpace(c, w, r)
+	if s == nil {
+		return
+	}
+	ctx, cancel, err := s.StartOp(r.Context())
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer cancel()
+
+	var req api.ArchiveDeleteRequest
+	if !request(c, w, r, &req) {
+		return
+	}
+	proc, err := ast.UnpackJSON(nil, req.Filter)
+	if err != nil {
+		respondError(c, w, r, zqe.E(zqe.Invalid, err))
+		return
+	}
+	fp, ok := proc.(*ast.FilterProc)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "archive delete requires a filter"))
+		return
+	}
+
+	store, ok := s.Storage().(ArchiveDeleter)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "space storage does not support archive delete"))
+		return
+	}
+	n, err := store.Delete(ctx, fp.Filter)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	respond(c, w, r, http.StatusOK, api.ArchiveDeleteResponse{RecordsDeleted: n})
+}
+
 func extractSpace(c *Core, w http.ResponseWriter, r *http.Request) space.Space {
 	v := mux.Vars(r)
 	id, ok := v["space"]
@@ -5,12 +5,18 @@ package zqd_test
 import (
 	"context"
 	"errors"
+	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/pkg/test"
@@ -19,6 +25,7 @@ import (
 	"github.com/brimsec/zq/zqd/pcapanalyzer"
 	"github.com/brimsec/zq/zqd/pcapstorage"
 	"github.com/brimsec/zq/zqd/storage"
+	"github.com/brimsec/zq/zql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -125,6 +132,181 @@ func TestPcapPostSearch(t *testing.T) {
 	})
 }
 
+func TestPcapSearchFilter(t *testing.T) {
+	p := pcapPostTest(t, "testdata/valid.pcap", testLauncher(nil, nil))
+	require.NoError(t, p.err)
+	span := nano.Span{Ts: 1501770877471635000, Dur: 3485852000}
+	count := func(t *testing.T, req api.PcapSearch) int {
+		rc, err := p.client.PcapSearch(context.Background(), p.space.ID, req)
+		require.NoError(t, err)
+		defer rc.Close()
+		var n int
+		for {
+			b, typ, err := rc.Read()
+			require.NoError(t, err)
+			if b == nil {
+				return n
+			}
+			if typ == pcapio.TypePacket {
+				n++
+			}
+		}
+	}
+	t.Run("Filter", func(t *testing.T) {
+		req := api.PcapSearch{Span: span, Filter: "tcp[tcpflags] & tcp-syn != 0"}
+		assert.Equal(t, 2, count(t, req))
+	})
+	t.Run("FilterWithFlow", func(t *testing.T) {
+		req := api.PcapSearch{
+			Span:    span,
+			Proto:   "tcp",
+			SrcHost: net.ParseIP("192.168.0.5"),
+			SrcPort: 50798,
+			DstHost: net.ParseIP("54.148.114.85"),
+			DstPort: 80,
+			Filter:  "src host 54.148.114.85",
+		}
+		assert.Equal(t, 11, count(t, req))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		req := api.PcapSearch{Span: span, Filter: "udp port 53"}
+		_, err := p.client.PcapSearch(context.Background(), p.space.ID, req)
+		require.Equal(t, api.ErrNoPcapResultsFound, err)
+	})
+	t.Run("InvalidFilter", func(t *testing.T) {
+		req := api.PcapSearch{Span: span, Filter: "tcp host 10.0.0.1"}
+		_, err := p.client.PcapSearch(context.Background(), p.space.ID, req)
+		require.Error(t, err)
+		var errResp *api.ErrorResponse
+		require.True(t, errors.As(err, &errResp))
+		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
+	})
+	t.Run("Stream", func(t *testing.T) {
+		req := api.PcapSearch{
+			Span:    span,
+			Proto:   "tcp",
+			SrcHost: net.ParseIP("192.168.0.5"),
+			SrcPort: 50798,
+			DstHost: net.ParseIP("54.148.114.85"),
+			DstPort: 80,
+			Stream:  pcap.StreamClient,
+		}
+		rc, err := p.client.PcapStream(context.Background(), p.space.ID, req)
+		require.NoError(t, err)
+		defer rc.Close()
+		b, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(b), "GET "), "unexpected payload %q", b)
+	})
+	t.Run("InvalidStream", func(t *testing.T) {
+		req := api.PcapSearch{Span: span, Filter: "tcp", Stream: "hex"}
+		_, err := p.client.PcapStream(context.Background(), p.space.ID, req)
+		var errResp *api.ErrorResponse
+		require.True(t, errors.As(err, &errResp))
+		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
+	})
+}
+
+func TestPcapPostMultiple(t *testing.T) {
+	var fail bool
+	write := func(p *testPcapProcess) error {
+		if fail {
+			return errors.New("zeek failed")
+		}
+		return writeLogsFn([]string{"./testdata/conn.log"})(p)
+	}
+	ctx := context.Background()
+	_, client := newCoreWithConfig(t, zqd.Config{Zeek: testLauncher(nil, write)})
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	const valid, extra = "testdata/valid.pcap", "testdata/extra.pcapng"
+	_, err = client.PcapPost(ctx, sp.ID, api.PcapPostRequest{valid})
+	require.NoError(t, err)
+	_, err = client.PcapPost(ctx, sp.ID, api.PcapPostRequest{extra})
+	require.NoError(t, err)
+	count := func(t *testing.T, span nano.Span) int {
+		req := api.PcapSearch{Span: span, Filter: "ip"}
+		rc, err := client.PcapSearch(ctx, sp.ID, req)
+		require.NoError(t, err)
+		defer rc.Close()
+		var n int
+		for {
+			b, typ, err := rc.Read()
+			if err == io.EOF || b == nil {
+				return n
+			}
+			require.NoError(t, err)
+			if typ == pcapio.TypePacket {
+				n++
+			}
+		}
+	}
+	validSpan := nano.NewSpanTs(nano.Unix(1501770877, 471635000), nano.Unix(1501770880, 988247001))
+	extraSpan := nano.NewSpanTs(nano.Unix(1583768523, 826851000), nano.Unix(1583774873, 692767000))
+	t.Run("SpaceInfo", func(t *testing.T) {
+		info, err := client.SpaceInfo(ctx, sp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, iosrc.MustParseURI(extra), info.PcapPath)
+		assert.Equal(t, []iosrc.URI{iosrc.MustParseURI(valid), iosrc.MustParseURI(extra)}, info.PcapPaths)
+		assert.Equal(t, int64(4224+12401), info.PcapSize)
+	})
+	// Each import adds a conn record to those of the earlier imports.
+	const twoRecords = `
+#0:record[count:uint64]
+0:[2;]`
+	t.Run("LogsAdded", func(t *testing.T) {
+		assert.Equal(t, test.Trim(twoRecords), searchTzng(t, client, sp.ID, "count()"))
+	})
+	t.Run("SearchOne", func(t *testing.T) {
+		assert.Equal(t, 27, count(t, validSpan))
+		assert.Equal(t, 27, count(t, extraSpan))
+	})
+	t.Run("SearchMerged", func(t *testing.T) {
+		rc, err := client.PcapSearch(ctx, sp.ID, api.PcapSearch{Span: validSpan.Union(extraSpan), Filter: "ip"})
+		require.NoError(t, err)
+		defer rc.Close()
+		var last nano.Ts
+		var n int
+		for {
+			b, typ, err := rc.Read()
+			if err == io.EOF || b == nil {
+				break
+			}
+			require.NoError(t, err)
+			if typ == pcapio.TypePacket {
+				_, ts, _, err := rc.Packet(b)
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, int64(ts), int64(last))
+				last = ts
+				n++
+			}
+		}
+		assert.Equal(t, 54, n)
+	})
+	t.Run("DuplicatePcap", func(t *testing.T) {
+		_, err := client.PcapPost(ctx, sp.ID, api.PcapPostRequest{valid})
+		require.Error(t, err)
+		var errResp *api.ErrorResponse
+		require.True(t, errors.As(err, &errResp))
+		assert.Equal(t, http.StatusConflict, errResp.StatusCode())
+	})
+	t.Run("FailureRestores", func(t *testing.T) {
+		dir := createTempDir(t)
+		pcapfile := filepath.Join(dir, "copy.pcap")
+		b, err := ioutil.ReadFile(valid)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(pcapfile, b, 0644))
+		fail = true
+		defer func() { fail = false }()
+		_, err = client.PcapPost(ctx, sp.ID, api.PcapPostRequest{pcapfile})
+		require.EqualError(t, err, "zeek failed")
+		info, err := client.SpaceInfo(ctx, sp.ID)
+		require.NoError(t, err)
+		assert.Len(t, info.PcapPaths, 2)
+		assert.Equal(t, test.Trim(twoRecords), searchTzng(t, client, sp.ID, "count()"))
+	})
+}
+
 func TestPcapPostPcapNgWithExtraBytes(t *testing.T) {
 	p := pcapPostTest(t, "testdata/extra.pcapng", testLauncher(nil, nil))
 	t.Run("PcapNgExtra", func(t *testing.T) {
@@ -210,6 +392,80 @@ func TestPcapPostZeekFailAfterWrite(t *testing.T) {
 	})
 }
 
+func TestPcapPostAnalyzer(t *testing.T) {
+	write := func(p *testPcapProcess) error {
+		const fingerprints = `{"timestamp":"2017-08-03T14:34:37.471635Z","client":"192.168.0.5","os":"Linux 3.11"}
+{"timestamp":"2017-08-03T14:34:38.471635Z","client":"192.168.0.51","os":"Windows 10"}
+`
+		return ioutil.WriteFile(filepath.Join(p.wd, "p0f.json"), []byte(fingerprints), 0644)
+	}
+	analyzer := pcapanalyzer.Analyzer{
+		Name:     "p0f",
+		Launcher: testLauncher(nil, write),
+		Format:   "ndjson",
+		Glob:     "*.json",
+		Proc:     zql.MustParseProc("put ts=Time.fromISO(timestamp) | cut ts,client,os"),
+	}
+	conf := zqd.Config{Zeek: testLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"})), Analyzers: []pcapanalyzer.Analyzer{analyzer}}
+	p := testPcapPostWithConfig(t, conf, "testdata/valid.pcap")
+	require.NoError(t, p.err)
+	expected := `
+#0:record[ts:time,client:string,os:string]
+0:[1501770878.471635;192.168.0.51;Windows 10;]
+0:[1501770877.471635;192.168.0.5;Linux 3.11;]
+`
+	assert.Equal(t, test.Trim(expected), searchTzng(t, p.client, p.space.ID, "os != null"))
+	assert.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[3;]`), searchTzng(t, p.client, p.space.ID, "count()"))
+}
+
+func TestPcapPostAnalyzerOnly(t *testing.T) {
+	write := func(p *testPcapProcess) error {
+		if _, err := io.Copy(ioutil.Discard, p.reader); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(p.wd, "out.tzng"), []byte(test.Trim(`
+#0:record[ts:time,note:string]
+0:[1501770877.471635;hello;]`)), 0644)
+	}
+	analyzer := pcapanalyzer.Analyzer{Name: "custom", Launcher: testLauncher(nil, write)}
+	p := testPcapPostWithConfig(t, zqd.Config{Analyzers: []pcapanalyzer.Analyzer{analyzer}}, "testdata/valid.pcap")
+	require.NoError(t, p.err)
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,note:string]
+0:[1501770877.471635;hello;]`), searchTzng(t, p.client, p.space.ID, "*"))
+	// The bytes read by the analyzer are counted without Zeek.
+	info, err := os.Stat("testdata/valid.pcap")
+	require.NoError(t, err)
+	status := p.payloads[len(p.payloads)-2].(*api.PcapPostStatus)
+	assert.Equal(t, info.Size(), status.PcapReadSize)
+}
+
+func TestPcapPostAnalyzerNamedEve(t *testing.T) {
+	suricata := func(p *testPcapProcess) error {
+		const alert = `{"event_type":"alert","timestamp":"2017-08-03T14:34:37.471635Z","src_ip":"192.168.0.5","alert":{"signature":"test"}}` + "\n"
+		return ioutil.WriteFile(filepath.Join(p.wd, "eve.json"), []byte(alert), 0644)
+	}
+	write := func(p *testPcapProcess) error {
+		return ioutil.WriteFile(filepath.Join(p.wd, "out.tzng"), []byte(test.Trim(`
+#0:record[ts:time,note:string]
+0:[1501770877.471635;hello;]`)), 0644)
+	}
+	// The converted logs of an analyzer named eve don't replace those
+	// of Suricata.
+	analyzer := pcapanalyzer.Analyzer{Name: "eve", Launcher: testLauncher(nil, write)}
+	conf := zqd.Config{Suricata: testLauncher(nil, suricata), Analyzers: []pcapanalyzer.Analyzer{analyzer}}
+	p := testPcapPostWithConfig(t, conf, "testdata/valid.pcap")
+	require.NoError(t, p.err)
+	assert.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[1;]`), searchTzng(t, p.client, p.space.ID, "event_type=alert | count()"))
+	assert.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[1;]`), searchTzng(t, p.client, p.space.ID, "note=hello | count()"))
+}
+
 func launcherFromEnv(t *testing.T, key string) pcapanalyzer.Launcher {
 	ln, err := pcapanalyzer.LauncherFromPath(os.Getenv(key))
 	require.NoError(t, err)
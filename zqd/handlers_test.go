@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -17,9 +18,11 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/pkg/httpd"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/pkg/test"
 	"github.com/brimsec/zq/zbuf"
@@ -30,9 +33,13 @@ import (
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/auth"
+	"github.com/brimsec/zq/zqd/jobs"
 	"github.com/brimsec/zq/zqd/pcapanalyzer"
 	"github.com/brimsec/zq/zqd/storage"
 	"github.com/brimsec/zq/zql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -174,19 +181,48 @@ func TestSearchError(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
 		assert.IsType(t, &api.Error{}, errResp.Err)
 	})
-	t.Run("ForwardSearchUnsupported", func(t *testing.T) {
-		req := api.SearchRequest{
-			Space: sp.ID,
-			Proc:  proc,
-			Span:  nano.MaxSpan,
-			Dir:   1,
-		}
-		_, err = client.Search(context.Background(), req, nil)
-		require.Error(t, err)
-		errResp := err.(*api.ErrorResponse)
-		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
-		assert.IsType(t, &api.Error{}, errResp.Err)
+}
+
+func TestForwardSearch(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time]
+0:[conn;2;]
+0:[conn;1;]
+0:[conn;3;]
+`
+	forward := `
+#0:record[_path:string,ts:time]
+0:[conn;1;]
+0:[conn;2;]
+0:[conn;3;]
+`
+	_, client := newCore(t)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "filestore"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	res, _ := searchDir(t, client, sp.ID, "*", 1)
+	require.Equal(t, test.Trim(forward), res)
+
+	sp, err = client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "archivestore",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
 	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+	res, _ = searchDir(t, client, sp.ID, "cut ts", 1)
+	require.Equal(t, reverseLines(searchTzng(t, client, sp.ID, "cut ts")), res)
+}
+
+// reverseLines reverses the order of the records of a tzng string whose
+// records all have the same type.
+func reverseLines(tzng string) string {
+	lines := strings.Split(strings.TrimSuffix(tzng, "\n"), "\n")
+	recs := lines[1:]
+	for i, j := 0, len(recs)-1; i < j; i, j = i+1, j-1 {
+		recs[i], recs[j] = recs[j], recs[i]
+	}
+	return strings.Join(lines, "\n") + "\n"
 }
 
 func TestSpaceList(t *testing.T) {
@@ -995,6 +1031,108 @@ func TestArchiveStat(t *testing.T) {
 	assert.Equal(t, test.Trim(exp), tzngCopy(t, "cut -c log_id", res, "tzng"))
 }
 
+func TestArchiveDelete(t *testing.T) {
+	thresh := int64(20 * 1024)
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name: "TestArchiveDelete",
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+			Archive: &storage.ArchiveConfig{
+				CreateOptions: &storage.ArchiveCreateOptions{
+					LogSizeThreshold: &thresh,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	payload := api.LogPostRequest{Paths: []string{babble}}
+	err = client.LogPost(context.Background(), sp.ID, payload)
+	require.NoError(t, err)
+	err = client.IndexPost(context.Background(), sp.ID, api.IndexPostRequest{
+		Patterns: []string{"v"},
+	})
+	require.NoError(t, err)
+
+	filter, err := json.Marshal(zql.MustParseProc("v=336"))
+	require.NoError(t, err)
+	res, err := client.ArchiveDelete(context.Background(), sp.ID, api.ArchiveDeleteRequest{Filter: filter})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, res.RecordsDeleted)
+
+	exp := `
+#0:record[count:uint64]
+0:[998;]
+`
+	require.Equal(t, test.Trim(exp), searchTzng(t, client, sp.ID, "count()"))
+
+	exp = `
+#0:record[type:string,first:time,last:time,record_count:uint64]
+0:[chunk;1587518620.0622373;1587513611.06391469;495;]
+#1:record[type:string,first:time,last:time,index_id:string,record_count:uint64,keys:record[key:string]]
+1:[index;1587518620.0622373;1587513611.06391469;microindex-field-v.zng;495;[int64;]]
+0:[chunk;1587513592.0625444;1587508830.06852324;503;]
+1:[index;1587513592.0625444;1587508830.06852324;microindex-field-v.zng;503;[int64;]]
+`
+	assert.Equal(t, test.Trim(exp), tzngCopy(t, "cut -c log_id,size", archiveStat(t, client, sp.ID), "tzng"))
+
+	filter, err = json.Marshal(zql.MustParseProc("count()"))
+	require.NoError(t, err)
+	_, err = client.ArchiveDelete(context.Background(), sp.ID, api.ArchiveDeleteRequest{Filter: filter})
+	require.Error(t, err)
+	require.Regexp(t, "archive delete requires a filter", err.Error())
+}
+
+func TestIndexDefs(t *testing.T) {
+	thresh := int64(20 * 1024)
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name: "TestIndexDefs",
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+			Archive: &storage.ArchiveConfig{
+				CreateOptions: &storage.ArchiveCreateOptions{
+					LogSizeThreshold: &thresh,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	def := api.IndexDef{Name: "conns", Keys: []string{"s", "v"}}
+	require.NoError(t, client.IndexDefPost(context.Background(), sp.ID, def))
+	err = client.IndexDefPost(context.Background(), sp.ID, def)
+	require.Error(t, err)
+	require.Regexp(t, "index conns already exists", err.Error())
+
+	// The definition is applied to data posted after it was created.
+	payload := api.LogPostRequest{Paths: []string{babble}}
+	err = client.LogPost(context.Background(), sp.ID, payload)
+	require.NoError(t, err)
+
+	defs, err := client.IndexDefList(context.Background(), sp.ID)
+	require.NoError(t, err)
+	require.Equal(t, []api.IndexDef{def}, defs)
+
+	exp := `
+#0:record[s:string,v:int64,count:uint64,first:time,last:time]
+0:[Potamogalidae-precommissure;51;1;1587513592.0625444;1587508830.06852324;]
+`
+	res, _ := indexSearch(t, client, sp.ID, "conns", []string{"Potamogalidae-precommissure", "51"})
+	assert.Equal(t, test.Trim(exp), tzngCopy(t, "cut -c _log", res, "tzng"))
+
+	require.NoError(t, client.IndexDefDelete(context.Background(), sp.ID, "conns"))
+	defs, err = client.IndexDefList(context.Background(), sp.ID)
+	require.NoError(t, err)
+	require.Len(t, defs, 0)
+	err = client.IndexDefDelete(context.Background(), sp.ID, "conns")
+	require.Error(t, err)
+	require.Regexp(t, "index conns not found", err.Error())
+}
+
 func archiveStat(t *testing.T, client *api.Connection, space api.SpaceID) string {
 	r, err := client.ArchiveStat(context.Background(), space, nil)
 	require.NoError(t, err)
@@ -1025,6 +1163,10 @@ func indexSearch(t *testing.T, client *api.Connection, space api.SpaceID, indexN
 // space, returning the tzng results along with a slice of all control
 // messages that were received.
 func search(t *testing.T, client *api.Connection, space api.SpaceID, prog string) (string, []interface{}) {
+	return searchDir(t, client, space, prog, -1)
+}
+
+func searchDir(t *testing.T, client *api.Connection, space api.SpaceID, prog string, dir int) (string, []interface{}) {
 	parsed, err := zql.ParseProc(prog)
 	require.NoError(t, err)
 	proc, err := json.Marshal(parsed)
@@ -1033,7 +1175,7 @@ func search(t *testing.T, client *api.Connection, space api.SpaceID, prog string
 		Space: space,
 		Proc:  proc,
 		Span:  nano.MaxSpan,
-		Dir:   -1,
+		Dir:   dir,
 	}
 	r, err := client.Search(context.Background(), req, nil)
 	require.NoError(t, err)
@@ -1095,6 +1237,7 @@ func newCoreWithConfig(t *testing.T, conf zqd.Config) (*zqd.Core, *api.Connectio
 	}
 	core, err := zqd.NewCore(conf)
 	require.NoError(t, err)
+	t.Cleanup(core.Shutdown)
 	srv := httptest.NewServer(zqd.NewHandler(core, conf.Logger))
 	t.Cleanup(srv.Close)
 	return core, api.NewConnectionTo(srv.URL)
@@ -1206,3 +1349,719 @@ func writeLogsFn(logs []string) procFn {
 		return err
 	}
 }
+
+func TestAuth(t *testing.T) {
+	a, err := auth.New(auth.Config{
+		Tokens: []auth.TokenConfig{
+			{User: "admin", Token: "admintoken", Permissions: map[string]auth.Permission{auth.AllSpaces: auth.Admin}},
+			{User: "reader", Token: "readertoken"},
+		},
+	})
+	require.NoError(t, err)
+	root := createTempDir(t)
+	core, anon := newCoreWithConfig(t, zqd.Config{Root: root, Auth: a})
+	srv := httptest.NewServer(zqd.NewHandler(core, zap.NewNop()))
+	t.Cleanup(srv.Close)
+	client := func(token string) *api.Connection {
+		c := api.NewConnectionTo(srv.URL)
+		c.SetAuthToken(token)
+		return c
+	}
+	admin, reader := client("admintoken"), client("readertoken")
+	ctx := context.Background()
+
+	_, err = anon.SpaceList(ctx)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, err.(*api.ErrorResponse).StatusCode())
+	_, err = client("badtoken").SpaceList(ctx)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, err.(*api.ErrorResponse).StatusCode())
+	_, err = anon.Version(ctx)
+	require.NoError(t, err)
+
+	sp1, err := admin.SpacePost(ctx, api.SpacePostRequest{Name: "sp1"})
+	require.NoError(t, err)
+	sp2, err := admin.SpacePost(ctx, api.SpacePostRequest{Name: "sp2"})
+	require.NoError(t, err)
+	_, err = reader.SpacePost(ctx, api.SpacePostRequest{Name: "sp3"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+
+	// The reader has no permissions, so it can see no spaces.
+	spaces, err := reader.SpaceList(ctx)
+	require.NoError(t, err)
+	assert.Len(t, spaces, 0)
+	_, err = reader.SpaceInfo(ctx, sp1.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+	_, err = reader.Search(ctx, api.SearchRequest{Space: sp1.ID, Proc: []byte(`{"op":"PassProc"}`), Span: nano.MaxSpan, Dir: -1}, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+
+	// Grant the reader read permission on sp1 only.
+	a, err = auth.New(auth.Config{
+		Tokens: []auth.TokenConfig{
+			{User: "reader", Token: "readertoken", Permissions: map[string]auth.Permission{string(sp1.ID): auth.Read}},
+		},
+	})
+	require.NoError(t, err)
+	core, _ = newCoreWithConfig(t, zqd.Config{Root: root, Auth: a})
+	srv2 := httptest.NewServer(zqd.NewHandler(core, zap.NewNop()))
+	t.Cleanup(srv2.Close)
+	reader = api.NewConnectionTo(srv2.URL)
+	reader.SetAuthToken("readertoken")
+
+	spaces, err = reader.SpaceList(ctx)
+	require.NoError(t, err)
+	require.Len(t, spaces, 1)
+	assert.Equal(t, sp1.ID, spaces[0].ID)
+	_, err = reader.SpaceInfo(ctx, sp1.ID)
+	require.NoError(t, err)
+	_, err = reader.SpaceInfo(ctx, sp2.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+	err = reader.SpaceDelete(ctx, sp1.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+	err = reader.LogPost(ctx, sp1.ID, api.LogPostRequest{Paths: []string{babble}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, err.(*api.ErrorResponse).StatusCode())
+}
+
+func TestUnixConnection(t *testing.T) {
+	root := createTempDir(t)
+	core, err := zqd.NewCore(zqd.Config{Root: root, Version: "v1"})
+	require.NoError(t, err)
+	defer core.Shutdown()
+	path := filepath.Join(root, "zqd.sock")
+	srv := httpd.NewUnix(path, zqd.NewHandler(core, zap.NewNop()))
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, srv.Start(ctx))
+	defer func() {
+		cancel()
+		require.NoError(t, srv.Wait())
+	}()
+	version, err := api.NewUnixConnection(path).Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", version)
+}
+
+func TestSearchListAndCancel(t *testing.T) {
+	_, client := newCore(t)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	// Post enough data that a search whose uncompressed results are not
+	// read blocks writing them.
+	var b strings.Builder
+	b.WriteString("#0:record[ts:time,s:string]\n")
+	pad := strings.Repeat("x", 1024)
+	for i := 0; i < 20000; i++ {
+		fmt.Fprintf(&b, "0:[%d;%s;]\n", i, pad)
+	}
+	postSpaceLogs(t, client, sp.ID, nil, b.String())
+
+	searches, err := client.SearchList(ctx)
+	require.NoError(t, err)
+	assert.Len(t, searches, 0)
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.SearchRequest{Space: sp.ID, Proc: proc, Span: nano.MaxSpan, Dir: -1}
+	r, err := client.SearchRaw(ctx, req, map[string]string{"format": "ndjson"})
+	require.NoError(t, err)
+	defer r.Close()
+
+	searches, err = client.SearchList(ctx)
+	require.NoError(t, err)
+	require.Len(t, searches, 1)
+	assert.Equal(t, "search", searches[0].Kind)
+	assert.Equal(t, sp.ID, searches[0].Space)
+
+	require.NoError(t, client.SearchCancel(ctx, searches[0].ID))
+	body, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Less(t, bytes.Count(body, []byte("\n")), 20000)
+	require.Eventually(t, func() bool {
+		searches, err := client.SearchList(ctx)
+		return err == nil && len(searches) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err = client.SearchCancel(ctx, searches[0].ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(*api.ErrorResponse).StatusCode())
+}
+
+func TestJobs(t *testing.T) {
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "test",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.JobPostRequest{
+		SearchRequest: api.SearchRequest{Space: sp.ID, Proc: proc, Span: nano.MaxSpan, Dir: -1},
+		TTL:           60,
+	}
+	job, err := client.JobPost(ctx, req)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		job, err = client.JobGet(ctx, job.ID)
+		require.NoError(t, err)
+		return job.State != api.JobRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, api.JobDone, job.State, job.Error)
+	assert.EqualValues(t, 1000, job.RecordCount)
+	assert.EqualValues(t, 1000, job.RecordsMatched)
+	assert.Equal(t, job.EndTime.Add(60*int64(time.Second)), job.ExpireTime)
+
+	jobResults := func(c *api.Connection, params map[string]string) string {
+		r, err := c.JobResults(ctx, job.ID, params)
+		require.NoError(t, err)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, zbuf.Copy(tzngio.NewWriter(zio.NopCloser(buf)), r))
+		return buf.String()
+	}
+	all := searchTzng(t, client, sp.ID, "*")
+	assert.Equal(t, all, jobResults(client, nil))
+	assert.Equal(t, searchTzng(t, client, sp.ID, "head 3 | tail 2"), jobResults(client, map[string]string{"offset": "1", "limit": "2"}))
+
+	job, err = client.JobPut(ctx, job.ID, api.JobPutRequest{TTL: 3600})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3600, job.TTL)
+
+	// Jobs survive a restart.
+	_, client2 := newCoreAtDir(t, root)
+	jobs, err := client2.JobList(ctx)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, *job, jobs[0])
+	assert.Equal(t, all, jobResults(client2, nil))
+
+	require.NoError(t, client2.JobDelete(ctx, job.ID))
+	_, err = client2.JobGet(ctx, job.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(*api.ErrorResponse).StatusCode())
+	_, err = os.Stat(filepath.Join(root, string(sp.ID), "jobs", job.ID))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestJobsPurge(t *testing.T) {
+	defer func(interval time.Duration) { jobs.PurgeInterval = interval }(jobs.PurgeInterval)
+	jobs.PurgeInterval = 10 * time.Millisecond
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.JobPostRequest{
+		SearchRequest: api.SearchRequest{Space: sp.ID, Proc: proc, Span: nano.MaxSpan, Dir: -1},
+		TTL:           1,
+	}
+	job, err := client.JobPost(ctx, req)
+	require.NoError(t, err)
+	// An expired job is removed without a request for it or the job list.
+	dir := filepath.Join(root, string(sp.ID), "jobs", job.ID)
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(dir)
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSavedQueries(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[dns;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+
+	q := api.SavedQuery{
+		Name:        "bypath",
+		Query:       "_path=${path} | cut uid",
+		Description: "uids by path",
+		Params:      map[string]string{"path": "conn"},
+	}
+	require.NoError(t, client.QueryPost(ctx, sp.ID, q))
+	err = client.QueryPost(ctx, sp.ID, q)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.(*api.ErrorResponse).StatusCode())
+	err = client.QueryPost(ctx, sp.ID, api.SavedQuery{Name: "a/b", Query: "*"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode())
+	require.NoError(t, client.QueryPost(ctx, sp.ID, api.SavedQuery{Name: "all", Query: "${x}"}))
+
+	run := func(name string, params map[string]string) string {
+		r, err := client.QueryRun(ctx, sp.ID, name, api.QueryRunRequest{Params: params}, nil)
+		require.NoError(t, err)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, zbuf.Copy(tzngio.NewWriter(zio.NopCloser(buf)), r))
+		return buf.String()
+	}
+	assert.Equal(t, test.Trim(`
+#0:record[uid:bstring]
+0:[CBrzd94qfowOqJwCHa;]
+`), run("bypath", nil))
+	assert.Equal(t, test.Trim(`
+#0:record[uid:bstring]
+0:[C8Tful1TvM3Zf5x8fl;]
+`), run("bypath", map[string]string{"path": "dns"}))
+	assert.Equal(t, test.Trim(`
+#0:record[_path:string,ts:time,uid:bstring]
+0:[dns;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`), run("all", map[string]string{"x": "C8Tful1TvM3Zf5x8fl"}))
+	assert.Equal(t, test.Trim(`
+#0:record[uid:bstring]
+0:[C8Tful1TvM3Zf5x8fl;]
+`), run("bypath", map[string]string{"path": `"dns"`}))
+
+	// A parameter is bound as a literal, so its value cannot add procs or
+	// terms to the query.
+	for _, v := range []string{"count()", "conn | head 1", "conn or _path=dns", `"conn" | put x=1`, `conn"`} {
+		_, err = client.QueryRun(ctx, sp.ID, "bypath", api.QueryRunRequest{Params: map[string]string{"path": v}}, nil)
+		require.Error(t, err, v)
+		assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode(), v)
+	}
+	require.NoError(t, client.QueryPost(ctx, sp.ID, api.SavedQuery{Name: "head", Query: "* | head ${n}"}))
+	_, err = client.QueryRun(ctx, sp.ID, "head", api.QueryRunRequest{Params: map[string]string{"n": "1"}}, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode())
+	require.NoError(t, client.QueryDelete(ctx, sp.ID, "head"))
+
+	_, err = client.QueryRun(ctx, sp.ID, "all", api.QueryRunRequest{}, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode())
+	_, err = client.QueryRun(ctx, sp.ID, "missing", api.QueryRunRequest{}, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(*api.ErrorResponse).StatusCode())
+
+	q.Query = "_path=${path} | count()"
+	require.NoError(t, client.QueryPut(ctx, sp.ID, "bypath", q))
+	got, err := client.QueryGet(ctx, sp.ID, "bypath")
+	require.NoError(t, err)
+	assert.Equal(t, q, *got)
+
+	// Saved queries persist across restarts.
+	_, client = newCoreAtDir(t, root)
+	queries, err := client.QueryList(ctx, sp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []api.SavedQuery{{Name: "all", Query: "${x}"}, q}, queries)
+
+	require.NoError(t, client.QueryDelete(ctx, sp.ID, "all"))
+	err = client.QueryDelete(ctx, sp.ID, "all")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(*api.ErrorResponse).StatusCode())
+	queries, err = client.QueryList(ctx, sp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []api.SavedQuery{q}, queries)
+}
+
+func TestTail(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[dns;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	_, client := newCore(t)
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	parsed, err := zql.ParseProc("_path=conn")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := client.Tail(ctx, sp.ID, api.TailRequest{Proc: proc}, nil)
+	require.NoError(t, err)
+
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	buf := bytes.NewBuffer(nil)
+	w := tzngio.NewWriter(zio.NopCloser(buf))
+	for i := 0; i < 2; i++ {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		require.NotNil(t, rec)
+		require.NoError(t, w.Write(rec))
+	}
+	assert.Equal(t, test.Trim(`
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+`), buf.String())
+
+	parsed, err = zql.ParseProc("count()")
+	require.NoError(t, err)
+	proc, err = json.Marshal(parsed)
+	require.NoError(t, err)
+	_, err = client.Tail(ctx, sp.ID, api.TailRequest{Proc: proc}, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode())
+}
+
+func TestMetrics(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[dns;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	reg := prometheus.NewRegistry()
+	_, client := newCoreWithConfig(t, zqd.Config{Registerer: reg})
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	_ = searchTzng(t, client, sp.ID, "_path=conn")
+
+	expected := fmt.Sprintf(`
+# HELP zqd_ingest_bytes_total Bytes of logs and pcaps read by ingest operations.
+# TYPE zqd_ingest_bytes_total counter
+zqd_ingest_bytes_total{kind="log"} %d
+# HELP zqd_scanner_records_total Records read and matched by the scanners of completed searches.
+# TYPE zqd_scanner_records_total counter
+zqd_scanner_records_total{stat="matched"} 1
+zqd_scanner_records_total{stat="read"} 2
+# HELP zqd_searches_active Number of running searches.
+# TYPE zqd_searches_active gauge
+zqd_searches_active 0
+# HELP zqd_searches_total Number of completed searches by kind.
+# TYPE zqd_searches_total counter
+zqd_searches_total{kind="search"} 1
+`, len(src))
+	err = testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"zqd_ingest_bytes_total", "zqd_scanner_records_total", "zqd_searches_active", "zqd_searches_total")
+	require.NoError(t, err)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	values := make(map[string]float64)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			var labels []string
+			for _, l := range m.GetLabel() {
+				labels = append(labels, l.GetName()+"="+l.GetValue())
+			}
+			key := f.GetName() + "{" + strings.Join(labels, ",") + "}"
+			switch {
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			}
+		}
+	}
+	assert.Equal(t, 1.0, values["zqd_http_requests_total{code=200,method=POST,route=/search}"])
+	assert.Equal(t, 1.0, values["zqd_http_requests_total{code=202,method=POST,route=/space/{space}/log}"])
+	key := fmt.Sprintf("zqd_space_size_bytes{kind=data,space_id=%s}", sp.ID)
+	assert.Greater(t, values[key], 0.0)
+}
+
+func TestSearchLimits(t *testing.T) {
+	_, client := newCoreWithConfig(t, zqd.Config{
+		SearchLimits: api.SearchLimits{MaxOutputBytes: 1 << 20},
+	})
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "test",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+
+	run := func(prog string, limits *api.SearchLimits) (string, error) {
+		parsed, err := zql.ParseProc(prog)
+		require.NoError(t, err)
+		proc, err := json.Marshal(parsed)
+		require.NoError(t, err)
+		req := api.SearchRequest{
+			Space:  sp.ID,
+			Proc:   proc,
+			Span:   nano.MaxSpan,
+			Dir:    -1,
+			Limits: limits,
+		}
+		r, err := client.Search(ctx, req, nil)
+		if err != nil {
+			return "", err
+		}
+		buf := bytes.NewBuffer(nil)
+		err = zbuf.Copy(tzngio.NewWriter(zio.NopCloser(buf)), r)
+		return buf.String(), err
+	}
+
+	_, err = run("*", nil)
+	assert.NoError(t, err)
+	_, err = run("*", &api.SearchLimits{MaxRecordsRead: 10})
+	assert.EqualError(t, err, "resource limit exceeded: search read more than 10 records")
+	_, err = run("*", &api.SearchLimits{MaxOutputBytes: 100})
+	assert.EqualError(t, err, "resource limit exceeded: search output exceeded 100 bytes")
+	_, err = run("*", &api.SearchLimits{Timeout: 1})
+	assert.EqualError(t, err, "resource limit exceeded: search exceeded time limit of 1ns")
+
+	_, err = run("*", &api.SearchLimits{MaxOutputBytes: 2 << 20})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*api.ErrorResponse).StatusCode())
+	assert.Contains(t, err.Error(), "exceeds server limit")
+
+	// Groupbys with decomposable reducers spill when they exceed the
+	// groupby limit while those with other reducers fail.
+	expected, err := run("count() by s | sort s", nil)
+	require.NoError(t, err)
+	out, err := run("count() by s | sort s", &api.SearchLimits{GroupByLimit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, expected, out)
+	_, err = run("countdistinct(v) by s", &api.SearchLimits{GroupByLimit: 1})
+	assert.EqualError(t, err, "resource limit exceeded: non-decomposable groupby aggregation exceeded configured cardinality limit (1)")
+}
+
+func TestSearchLookupDirs(t *testing.T) {
+	lookupDir, outsideDir := createTempDir(t), createTempDir(t)
+	_, client := newCoreWithConfig(t, zqd.Config{LookupDirs: []string{lookupDir}})
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, `
+#0:record[ts:time,uid:bstring]
+0:[1;C1;]`)
+	info, err := client.SpaceInfo(ctx, sp.ID)
+	require.NoError(t, err)
+
+	const table = "uid,name\nC1,one\n"
+	write := func(dir string) string {
+		path := filepath.Join(dir, "table.csv")
+		require.NoError(t, ioutil.WriteFile(path, []byte(table), 0644))
+		return path
+	}
+	inLookupDir, inSpace, outside := write(lookupDir), write(info.DataPath.Filepath()), write(outsideDir)
+	link := filepath.Join(lookupDir, "link.csv")
+	require.NoError(t, os.Symlink(outside, link))
+
+	run := func(path string) (string, error) {
+		parsed, err := zql.ParseProc(fmt.Sprintf("lookup -file %q uid", path))
+		require.NoError(t, err)
+		proc, err := json.Marshal(parsed)
+		require.NoError(t, err)
+		req := api.SearchRequest{
+			Space: sp.ID,
+			Proc:  proc,
+			Span:  nano.MaxSpan,
+			Dir:   -1,
+		}
+		r, err := client.Search(ctx, req, nil)
+		if err != nil {
+			return "", err
+		}
+		buf := bytes.NewBuffer(nil)
+		err = zbuf.Copy(tzngio.NewWriter(zio.NopCloser(buf)), r)
+		return buf.String(), err
+	}
+	expected := `
+#0:record[ts:time,uid:bstring,name:string]
+0:[1;C1;one;]`
+	for _, path := range []string{inLookupDir, inSpace} {
+		res, err := run(path)
+		require.NoError(t, err)
+		assert.Equal(t, test.Trim(expected), res)
+	}
+	for _, path := range []string{outside, link, filepath.Join(lookupDir, "..", filepath.Base(outsideDir), "table.csv")} {
+		_, err := run(path)
+		require.Error(t, err)
+		var errResp *api.ErrorResponse
+		require.True(t, errors.As(err, &errResp))
+		assert.Equal(t, http.StatusForbidden, errResp.StatusCode())
+		assert.Contains(t, err.Error(), "is outside the space and the lookup directories")
+	}
+}
+
+func TestDistributedSearch(t *testing.T) {
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "test",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+
+	// Workers load the spaces of the data directory when they start.
+	_, worker1 := newCoreAtDir(t, root)
+	_, worker2 := newCoreAtDir(t, root)
+	// A worker that is down exercises retries.
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	_, coordinator := newCoreWithConfig(t, zqd.Config{
+		Root:    root,
+		Workers: []string{dead.URL, worker1.URL(), worker2.URL()},
+	})
+
+	for _, prog := range []string{"*", "v>100", "count() by s | sort s", "head 5", "tail 5"} {
+		t.Run(prog, func(t *testing.T) {
+			assert.Equal(t, searchTzng(t, client, sp.ID, prog), searchTzng(t, coordinator, sp.ID, prog))
+		})
+	}
+	t.Run("forward", func(t *testing.T) {
+		expected, _ := searchDir(t, client, sp.ID, "cut ts", 1)
+		res, _ := searchDir(t, coordinator, sp.ID, "cut ts", 1)
+		assert.Equal(t, expected, res)
+	})
+
+	// The coordinator reports the stats of the workers' scanners.
+	_, msgs := search(t, coordinator, sp.ID, "*")
+	var stats *api.SearchStats
+	for _, m := range msgs {
+		if s, ok := m.(*api.SearchStats); ok {
+			stats = s
+		}
+	}
+	require.NotNil(t, stats)
+	assert.EqualValues(t, 1000, stats.RecordsMatched)
+}
+
+func TestDistributedSearchWorkerFailure(t *testing.T) {
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "test",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+
+	// The first worker fails after sending part of its response.  The
+	// span cannot be resumed on the second worker once records have been
+	// returned, so the search fails.
+	core, _ := newCoreAtDir(t, root)
+	h := zqd.NewHandler(core, zap.NewNop())
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &truncatingWriter{ResponseWriter: w, n: 4096}
+		h.ServeHTTP(tw, r)
+		if tw.truncated {
+			panic(http.ErrAbortHandler)
+		}
+	}))
+	t.Cleanup(failing.Close)
+	_, worker := newCoreAtDir(t, root)
+	_, coordinator := newCoreWithConfig(t, zqd.Config{
+		Root:    root,
+		Workers: []string{failing.URL, worker.URL()},
+	})
+	r, err := coordinator.Search(ctx, api.SearchRequest{Space: sp.ID, Proc: []byte(`{"op":"PassProc"}`), Span: nano.MaxSpan, Dir: -1}, nil)
+	require.NoError(t, err)
+	err = zbuf.Copy(tzngio.NewWriter(zio.NopCloser(ioutil.Discard)), r)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worker "+failing.URL)
+}
+
+// truncatingWriter writes the first n bytes of a response and then fails.
+type truncatingWriter struct {
+	http.ResponseWriter
+	n         int
+	truncated bool
+}
+
+func (w *truncatingWriter) Write(b []byte) (int, error) {
+	if len(b) > w.n {
+		w.ResponseWriter.Write(b[:w.n])
+		w.Flush()
+		w.n = 0
+		w.truncated = true
+		return 0, errors.New("response truncated")
+	}
+	w.n -= len(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *truncatingWriter) Flush() {
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+func TestDistributedSearchAuthenticatedWorker(t *testing.T) {
+	root := createTempDir(t)
+	_, client := newCoreAtDir(t, root)
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:    "test",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.LogPost(ctx, sp.ID, api.LogPostRequest{Paths: []string{babble}}))
+
+	// The worker serves https and requires a token.
+	a, err := auth.New(auth.Config{
+		Tokens: []auth.TokenConfig{
+			{User: "coordinator", Token: "workertoken", Permissions: map[string]auth.Permission{auth.AllSpaces: auth.Read}},
+		},
+	})
+	require.NoError(t, err)
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel))
+	newWorker := func(limits api.SearchLimits) *httptest.Server {
+		core, err := zqd.NewCore(zqd.Config{Root: root, Auth: a, Logger: logger, SearchLimits: limits})
+		require.NoError(t, err)
+		t.Cleanup(core.Shutdown)
+		srv := httptest.NewTLSServer(zqd.NewHandler(core, logger))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	worker := newWorker(api.SearchLimits{})
+	tlsConf := worker.Client().Transport.(*http.Transport).TLSClientConfig
+
+	run := func(conn *api.Connection) error {
+		r, err := conn.Search(ctx, api.SearchRequest{Space: sp.ID, Proc: []byte(`{"op":"PassProc"}`), Span: nano.MaxSpan, Dir: -1}, nil)
+		if err != nil {
+			return err
+		}
+		return zbuf.Copy(tzngio.NewWriter(zio.NopCloser(ioutil.Discard)), r)
+	}
+
+	_, coordinator := newCoreWithConfig(t, zqd.Config{
+		Root:      root,
+		Workers:   []string{worker.URL},
+		WorkerTLS: tlsConf,
+	})
+	err = run(coordinator)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status code 401")
+
+	_, coordinator = newCoreWithConfig(t, zqd.Config{
+		Root:        root,
+		Workers:     []string{worker.URL},
+		WorkerToken: "workertoken",
+		WorkerTLS:   tlsConf,
+	})
+	prog := "count() by s | sort s"
+	assert.Equal(t, searchTzng(t, client, sp.ID, prog), searchTzng(t, coordinator, sp.ID, prog))
+
+	// The worker applies its limits to the searches it runs.
+	limited := newWorker(api.SearchLimits{MaxRecordsRead: 10})
+	_, coordinator = newCoreWithConfig(t, zqd.Config{
+		Root:        root,
+		Workers:     []string{limited.URL},
+		WorkerToken: "workertoken",
+		WorkerTLS:   tlsConf,
+	})
+	err = run(coordinator)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "search read more than 10 records")
+}
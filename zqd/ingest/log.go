@@ -32,14 +32,17 @@ type LogOp struct {
 
 	warningCh chan string
 	zctx      *resolver.Context
+	pub       *Publisher
 }
 
 // Logs ingests the provided list of files into the provided space.
 // Like ingest.Pcap, this overwrites any existing data in the space.
-func NewLogOp(ctx context.Context, store storage.Storage, req api.LogPostRequest) (*LogOp, error) {
+// Records are published to pub, which may be nil, as they are written.
+func NewLogOp(ctx context.Context, store storage.Storage, req api.LogPostRequest, pub *Publisher) (*LogOp, error) {
 	p := &LogOp{
 		warningCh: make(chan string, 5),
 		zctx:      resolver.NewContext(),
+		pub:       pub,
 	}
 	opts := zio.ReaderOpts{Zng: zngio.ReaderOpts{Validate: true}}
 	//XXX if there is a json config, then the input has to be ndjson
@@ -139,7 +142,7 @@ func (p *LogOp) start(ctx context.Context, store storage.Storage) {
 	}
 	rc := zbuf.NewCombiner(p.readers, zbuf.RecordCompare(store.NativeDirection()))
 	defer rc.Close()
-	p.err = store.Write(ctx, p.zctx, rc)
+	p.err = store.Write(ctx, p.zctx, p.pub.Reader(rc))
 	if err := p.closeFiles(); err != nil && p.err != nil {
 		p.err = err
 	}
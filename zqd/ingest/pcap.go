@@ -12,6 +12,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/fs"
 	"github.com/brimsec/zq/pkg/iosrc"
@@ -30,9 +31,13 @@ import (
 
 //go:generate go run ../../zio/ndjsonio/typegenerator -o ./suricata.go -package ingest -var suricataTC ./suricata-types.json
 
+// ClearableStore is a storage.Storage that can be cleared and that can merge
+// new records with those it already holds, as needed to add the logs of a
+// pcap to those already in a space.
 type ClearableStore interface {
 	storage.Storage
 	Clear(ctx context.Context) error
+	Merge(ctx context.Context, zctx *resolver.Context, zr zbuf.Reader) error
 }
 
 type PcapOp struct {
@@ -45,22 +50,30 @@ type PcapOp struct {
 	pcapuri              iosrc.URI
 	pcapReadSize         int64
 	logdir               string
+	merge                bool
 	done, snap           chan struct{}
 	err                  error
 	slauncher, zlauncher pcapanalyzer.Launcher
+	analyzers            []pcapanalyzer.Analyzer
+	pub                  *Publisher
 }
 
 // NewPcapOp kicks of the process for ingesting a pcap file into a space.
 // Should everything start out successfully, this will return a thread safe
 // Process instance once zeek log files have started to materialize in a tmp
 // directory. If zeekExec is an empty string, this will attempt to resolve zeek
-// from $PATH.
-func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store ClearableStore, pcap string, slauncher, zlauncher pcapanalyzer.Launcher) (*PcapOp, []string, error) {
+// from $PATH.  Each of analyzers is run alongside Zeek and Suricata, and its
+// logs are added once it exits.  If the space already holds records, the logs
+// of the pcap are merged with them once all the logs are written and no
+// earlier snapshots are made; otherwise each snapshot replaces the data in
+// the space.  Either way, only the records of the final snapshot are
+// published to pub, which may be nil.
+func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store ClearableStore, pcap string, slauncher, zlauncher pcapanalyzer.Launcher, analyzers []pcapanalyzer.Analyzer, pub *Publisher) (*PcapOp, []string, error) {
 	pcapuri, err := iosrc.ParseURI(pcap)
 	if err != nil {
 		return nil, nil, err
 	}
-	if slauncher == nil && zlauncher == nil {
+	if slauncher == nil && zlauncher == nil && len(analyzers) == 0 {
 		return nil, nil, fmt.Errorf("must provide at least one launcher")
 	}
 	info, err := iosrc.Stat(ctx, pcapuri)
@@ -69,7 +82,7 @@ func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store Clearabl
 	}
 	warn := make(chan string)
 	go func() {
-		err = pcapstore.Update(ctx, pcapuri, warn)
+		err = pcapstore.Add(ctx, pcapuri, warn)
 		close(warn)
 	}()
 	var warnings []string
@@ -81,6 +94,7 @@ func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store Clearabl
 	}
 	logdir, err := ioutil.TempDir("", "zqd-pcap-ingest-")
 	if err != nil {
+		pcapstore.Remove(context.Background(), pcapuri)
 		return nil, warnings, err
 	}
 	p := &PcapOp{
@@ -94,7 +108,16 @@ func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store Clearabl
 		snap:      make(chan struct{}),
 		slauncher: slauncher,
 		zlauncher: zlauncher,
+		analyzers: analyzers,
+		pub:       pub,
 	}
+	sum, err := store.Summary(ctx)
+	if err != nil {
+		os.RemoveAll(logdir)
+		pcapstore.Remove(context.Background(), pcapuri)
+		return nil, warnings, err
+	}
+	p.merge = sum.DataBytes > 0
 	go func() {
 		p.err = p.run(ctx)
 		close(p.done)
@@ -105,33 +128,48 @@ func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store Clearabl
 
 func (p *PcapOp) run(ctx context.Context) error {
 	var sErr, zErr error
+	aErrs := make([]error, len(p.analyzers))
 	var wg sync.WaitGroup
 	slurpDone := make(chan struct{})
+	// The bytes of the pcap read by one of the analyzers are counted
+	// toward PcapReadSize.
+	countZeek := p.zlauncher != nil
+	countSuricata := !countZeek && p.slauncher != nil
+	countAnalyzer := !countZeek && !countSuricata
 	if p.slauncher != nil {
 		wg.Add(1)
 		go func() {
-			sErr = p.runSuricata(ctx)
+			sErr = p.runSuricata(ctx, countSuricata)
 			wg.Done()
 		}()
 	}
 	if p.zlauncher != nil {
 		wg.Add(1)
 		go func() {
-			zErr = p.runZeek(ctx)
+			zErr = p.runZeek(ctx, countZeek)
 			wg.Done()
 		}()
 	}
+	for i := range p.analyzers {
+		wg.Add(1)
+		go func(i int) {
+			aErrs[i] = p.runAnalyzer(ctx, p.analyzers[i], countAnalyzer && i == 0)
+			wg.Done()
+		}(i)
+	}
 	go func() {
 		wg.Wait()
 		close(slurpDone)
 	}()
 
 	abort := func() {
-		os.RemoveAll(p.logdir)
 		// Don't want to use passed context here because a cancelled context
 		// would cause storage not to be cleared.
-		p.pcapstore.Delete(context.Background())
-		p.store.Clear(context.Background())
+		p.pcapstore.Remove(context.Background(), p.pcapuri)
+		if !p.merge {
+			p.store.Clear(context.Background())
+		}
+		os.RemoveAll(p.logdir)
 	}
 
 	ticker := time.NewTicker(time.Second)
@@ -144,8 +182,8 @@ outer:
 		case <-slurpDone:
 			break outer
 		case t := <-ticker.C:
-			if t.After(start.Add(next)) {
-				if err := p.createSnapshot(ctx); err != nil {
+			if !p.merge && t.After(start.Add(next)) {
+				if err := p.createSnapshot(ctx, false); err != nil {
 					abort()
 					return err
 				}
@@ -165,8 +203,14 @@ outer:
 		abort()
 		return zErr
 	}
+	for _, err := range aErrs {
+		if err != nil {
+			abort()
+			return err
+		}
+	}
 
-	if err := p.createSnapshot(ctx); err != nil {
+	if err := p.createSnapshot(ctx, true); err != nil {
 		abort()
 		return err
 	}
@@ -177,27 +221,35 @@ outer:
 	return nil
 }
 
-func (p *PcapOp) runZeek(ctx context.Context) error {
+// pcapReader returns a buffered reader of pcapfile that, if count is true,
+// adds the bytes read to those reported by PcapReadSize.
+func (p *PcapOp) pcapReader(pcapfile io.Reader, count bool) io.Reader {
+	if count {
+		pcapfile = io.TeeReader(pcapfile, p)
+	}
+	return bufio.NewReader(pcapfile)
+}
+
+func (p *PcapOp) runZeek(ctx context.Context, count bool) error {
 	pcapfile, err := iosrc.NewReader(ctx, p.pcapuri)
 	if err != nil {
 		return err
 	}
 	defer pcapfile.Close()
-	r := io.TeeReader(pcapfile, p)
-	zproc, err := p.zlauncher(ctx, bufio.NewReader(r), p.logdir)
+	zproc, err := p.zlauncher(ctx, p.pcapReader(pcapfile, count), p.logdir)
 	if err != nil {
 		return err
 	}
 	return zproc.Wait()
 }
 
-func (p *PcapOp) runSuricata(ctx context.Context) error {
+func (p *PcapOp) runSuricata(ctx context.Context, count bool) error {
 	pcapfile, err := iosrc.NewReader(ctx, p.pcapuri)
 	if err != nil {
 		return err
 	}
 	defer pcapfile.Close()
-	sproc, err := p.slauncher(ctx, bufio.NewReader(pcapfile), p.logdir)
+	sproc, err := p.slauncher(ctx, p.pcapReader(pcapfile, count), p.logdir)
 	if err != nil {
 		return err
 	}
@@ -207,6 +259,28 @@ func (p *PcapOp) runSuricata(ctx context.Context) error {
 	return p.convertSuricataLog(ctx)
 }
 
+// runAnalyzer runs a in a subdirectory of the log directory and, when it
+// exits, converts its logs into a zng file in the log directory.
+func (p *PcapOp) runAnalyzer(ctx context.Context, a pcapanalyzer.Analyzer, count bool) error {
+	pcapfile, err := iosrc.NewReader(ctx, p.pcapuri)
+	if err != nil {
+		return err
+	}
+	defer pcapfile.Close()
+	dir := filepath.Join(p.logdir, a.Name)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+	proc, err := a.Launcher(ctx, p.pcapReader(pcapfile, count), dir)
+	if err != nil {
+		return err
+	}
+	if err := proc.Wait(); err != nil {
+		return err
+	}
+	return p.convertAnalyzerLogs(ctx, a, dir)
+}
+
 // PcapReadSize returns the total size in bytes of data read from the underlying
 // pcap file.
 func (p *PcapOp) PcapReadSize() int64 {
@@ -254,8 +328,29 @@ func (p *PcapOp) suricataFiles() []string {
 	return []string{path}
 }
 
-func (p *PcapOp) createSnapshot(ctx context.Context) error {
+// analyzerLog returns the path of the converted logs of a.  Analyzer names
+// can't contain '.', so the path can't collide with Zeek's or Suricata's
+// logs.
+func (p *PcapOp) analyzerLog(a pcapanalyzer.Analyzer) string {
+	return filepath.Join(p.logdir, a.Name+".analyzer.zng")
+}
+
+// analyzerFiles returns the converted logs of the analyzers that have
+// finished.
+func (p *PcapOp) analyzerFiles() []string {
+	var files []string
+	for _, a := range p.analyzers {
+		path := p.analyzerLog(a)
+		if _, err := os.Stat(path); err == nil {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+func (p *PcapOp) createSnapshot(ctx context.Context, final bool) error {
 	files := append(p.zeekFiles(), p.suricataFiles()...)
+	files = append(files, p.analyzerFiles()...)
 	if len(files) == 0 {
 		return nil
 	}
@@ -266,7 +361,16 @@ func (p *PcapOp) createSnapshot(ctx context.Context) error {
 		return err
 	}
 	defer zr.Close()
-	if err := p.store.Write(ctx, zctx, zr); err != nil {
+	var r zbuf.Reader = zr
+	if final {
+		r = p.pub.Reader(zr)
+	}
+	if p.merge {
+		err = p.store.Merge(ctx, zctx, r)
+	} else {
+		err = p.store.Write(ctx, zctx, r)
+	}
+	if err != nil {
 		return err
 	}
 	atomic.AddInt32(&p.snapshots, 1)
@@ -289,6 +393,35 @@ func (p *PcapOp) convertSuricataLog(ctx context.Context) error {
 	})
 }
 
+func (p *PcapOp) convertAnalyzerLogs(ctx context.Context, a pcapanalyzer.Analyzer, dir string) error {
+	glob := a.Glob
+	if glob == "" {
+		glob = "*"
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, glob))
+	if err != nil {
+		return fmt.Errorf("pcap analyzer %s: %w", a.Name, err)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	proc := a.Proc
+	if proc == nil {
+		proc = &ast.PassProc{Node: ast.Node{Op: "PassProc"}}
+	}
+	zctx := resolver.NewContext()
+	zr := detector.MultiFileReaderWithContext(ctx, zctx, paths, zio.ReaderOpts{Format: a.Format})
+	defer zr.Close()
+	err = fs.ReplaceFile(p.analyzerLog(a), os.FileMode(0666), func(w io.Writer) error {
+		zw := zngio.NewWriter(zio.NopCloser(w), zngio.WriterOpts{})
+		return driver.Copy(ctx, zw, proc, zctx, zr, driver.Config{})
+	})
+	if err != nil {
+		return fmt.Errorf("pcap analyzer %s: %w", a.Name, err)
+	}
+	return nil
+}
+
 func (p *PcapOp) Write(b []byte) (int, error) {
 	n := len(b)
 	atomic.AddInt64(&p.pcapReadSize, int64(n))
@@ -0,0 +1,132 @@
+package ingest
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zqd/api"
+)
+
+// TailBufferSize is the number of records buffered for each subscription.
+// Records published to a subscription whose buffer is full are dropped so
+// that slow subscribers never hold up ingest.
+const TailBufferSize = 1024
+
+// Tail distributes the records ingested into spaces to the subscribers of
+// each space as the records are written.
+type Tail struct {
+	mu   sync.RWMutex
+	subs map[api.SpaceID]map[*Subscription]struct{}
+}
+
+func NewTail() *Tail {
+	return &Tail{subs: make(map[api.SpaceID]map[*Subscription]struct{})}
+}
+
+// Subscription receives the records ingested into a space.
+type Subscription struct {
+	tail    *Tail
+	space   api.SpaceID
+	ch      chan *zng.Record
+	dropped int64
+}
+
+// Subscribe returns a subscription to the records subsequently ingested into
+// space.  The subscription must be closed when no longer needed.
+func (t *Tail) Subscribe(space api.SpaceID) *Subscription {
+	s := &Subscription{
+		tail:  t,
+		space: space,
+		ch:    make(chan *zng.Record, TailBufferSize),
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	subs, ok := t.subs[space]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		t.subs[space] = subs
+	}
+	subs[s] = struct{}{}
+	return s
+}
+
+// Records returns the channel on which the subscription receives records.
+// Records from different ingest operations have types from different
+// type contexts.
+func (s *Subscription) Records() <-chan *zng.Record {
+	return s.ch
+}
+
+// Dropped returns the number of records dropped since the last call to
+// Dropped because the subscription's buffer was full.
+func (s *Subscription) Dropped() int64 {
+	return atomic.SwapInt64(&s.dropped, 0)
+}
+
+func (s *Subscription) Close() {
+	t := s.tail
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if subs, ok := t.subs[s.space]; ok {
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(t.subs, s.space)
+		}
+	}
+}
+
+func (t *Tail) publish(space api.SpaceID, rec *zng.Record) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	subs := t.subs[space]
+	if len(subs) == 0 {
+		return
+	}
+	rec = rec.Keep()
+	for s := range subs {
+		select {
+		case s.ch <- rec:
+		default:
+			atomic.AddInt64(&s.dropped, 1)
+		}
+	}
+}
+
+// Publisher returns a Publisher of records ingested into space.  A nil
+// Tail returns a nil Publisher, which publishes nothing.
+func (t *Tail) Publisher(space api.SpaceID) *Publisher {
+	if t == nil {
+		return nil
+	}
+	return &Publisher{tail: t, space: space}
+}
+
+// Publisher publishes the records ingested into a space to the space's
+// subscribers.
+type Publisher struct {
+	tail  *Tail
+	space api.SpaceID
+}
+
+// Reader returns a reader that publishes each record read from r.
+func (p *Publisher) Reader(r zbuf.Reader) zbuf.Reader {
+	if p == nil {
+		return r
+	}
+	return &publishReader{Reader: r, pub: p}
+}
+
+type publishReader struct {
+	zbuf.Reader
+	pub *Publisher
+}
+
+func (p *publishReader) Read() (*zng.Record, error) {
+	rec, err := p.Reader.Read()
+	if rec != nil {
+		p.pub.tail.publish(p.pub.space, rec)
+	}
+	return rec, err
+}
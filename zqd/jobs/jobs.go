@@ -0,0 +1,451 @@
+// Package jobs runs searches in the background and stores their results so
+// that clients can retrieve them later without holding a connection open
+// while the search runs.
+//
+// Each job is kept in its own directory beneath the "jobs" directory of its
+// space, holding the job's description in job.json and its results in
+// results.zng.  Jobs are therefore removed along with their space.  A job
+// expires some time after it ends, at which point its directory is removed.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/search"
+	"github.com/brimsec/zq/zqe"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+const (
+	dirName         = "jobs"
+	infoFilename    = "job.json"
+	resultsFilename = "results.zng"
+)
+
+// DefaultTTL is how long a job is kept after it ends if its request does
+// not say otherwise.
+var DefaultTTL = 24 * time.Hour
+
+// PurgeInterval is how often a Manager removes expired jobs.
+var PurgeInterval = time.Minute
+
+// A Runner runs a job's search, writing results to out.  It must return
+// when ctx is canceled.
+type Runner func(ctx context.Context, out *Output) error
+
+// A Manager keeps track of jobs.  It removes expired jobs in the background
+// until it is closed.
+type Manager struct {
+	logger *zap.Logger
+	stop   chan struct{}
+
+	mu   sync.Mutex
+	jobs map[string]*job
+}
+
+type job struct {
+	dir    iosrc.URI
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu   sync.Mutex
+	info api.JobInfo
+}
+
+func NewManager(logger *zap.Logger) *Manager {
+	m := &Manager{
+		logger: logger.Named("jobs"),
+		stop:   make(chan struct{}),
+		jobs:   make(map[string]*job),
+	}
+	go m.purgeLoop(PurgeInterval)
+	return m
+}
+
+// Close stops the removal of expired jobs.  Running jobs are not affected.
+func (m *Manager) Close() {
+	close(m.stop)
+}
+
+func (m *Manager) purgeLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.purge()
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func newJobID() string {
+	return "job_" + ksuid.New().String()
+}
+
+// Load adds the jobs stored beneath the space directory dir.  Jobs of
+// spaces for which valid returns false are removed, as are expired jobs.
+// Jobs that were running when zqd exited are marked as failed.
+func (m *Manager) Load(ctx context.Context, dir iosrc.URI, valid func(api.SpaceID) bool) error {
+	jobsDir := dir.AppendPath(dirName)
+	entries, err := iosrc.ReadDir(ctx, jobsDir)
+	if err != nil {
+		if zqe.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	now := nano.Now()
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		jdir := jobsDir.AppendPath(e.Name())
+		b, err := iosrc.ReadFile(ctx, jdir.AppendPath(infoFilename))
+		if err != nil {
+			m.logger.Warn("Removing unreadable job", zap.String("uri", jdir.String()), zap.Error(err))
+			m.remove(jdir)
+			continue
+		}
+		var info api.JobInfo
+		if err := json.Unmarshal(b, &info); err != nil || info.ID != e.Name() {
+			m.logger.Warn("Removing unreadable job", zap.String("uri", jdir.String()), zap.Error(err))
+			m.remove(jdir)
+			continue
+		}
+		if !valid(info.Space) {
+			m.remove(jdir)
+			continue
+		}
+		if info.State == api.JobRunning {
+			info.State = api.JobFailed
+			info.Error = "job interrupted by zqd restart"
+			info.EndTime = now
+			info.ExpireTime = now.Add(info.TTL * int64(time.Second))
+			if err := writeInfo(jdir, info); err != nil {
+				return err
+			}
+		}
+		if info.ExpireTime <= now {
+			m.remove(jdir)
+			continue
+		}
+		done := make(chan struct{})
+		close(done)
+		m.mu.Lock()
+		m.jobs[info.ID] = &job{dir: jdir, cancel: func() {}, done: done, info: info}
+		m.mu.Unlock()
+	}
+	return nil
+}
+
+// Start creates a job for req in the space directory dir and runs it in
+// the background with run.  ctx governs the job and should be canceled when
+// the space is deleted.  cancel is called when the job ends.
+func (m *Manager) Start(ctx context.Context, cancel context.CancelFunc, dir iosrc.URI, user string, req api.JobPostRequest, run Runner) (api.JobInfo, error) {
+	if req.TTL < 0 {
+		return api.JobInfo{}, zqe.E(zqe.Invalid, "job ttl must not be negative")
+	}
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = int64(DefaultTTL / time.Second)
+	}
+	info := api.JobInfo{
+		ID:        newJobID(),
+		Space:     req.Space,
+		User:      user,
+		State:     api.JobRunning,
+		Request:   req.SearchRequest,
+		TTL:       ttl,
+		StartTime: nano.Now(),
+	}
+	jdir := dir.AppendPath(dirName, info.ID)
+	if err := mkdirAll(jdir); err != nil {
+		return api.JobInfo{}, err
+	}
+	if err := writeInfo(jdir, info); err != nil {
+		m.remove(jdir)
+		return api.JobInfo{}, err
+	}
+	w, err := iosrc.NewWriter(ctx, jdir.AppendPath(resultsFilename))
+	if err != nil {
+		m.remove(jdir)
+		return api.JobInfo{}, err
+	}
+	ctx, jobCancel := context.WithCancel(ctx)
+	j := &job{
+		dir: jdir,
+		cancel: func() {
+			jobCancel()
+			cancel()
+		},
+		done: make(chan struct{}),
+		info: info,
+	}
+	m.mu.Lock()
+	m.jobs[info.ID] = j
+	m.mu.Unlock()
+	go m.run(ctx, j, w, run)
+	return info, nil
+}
+
+func (m *Manager) run(ctx context.Context, j *job, w io.WriteCloser, run Runner) {
+	defer close(j.done)
+	defer j.cancel()
+	out := &Output{job: j, writer: zngio.NewWriter(w, zngio.WriterOpts{})}
+	err := run(ctx, out)
+	if closeErr := out.writer.Close(); err == nil {
+		err = closeErr
+	}
+	j.mu.Lock()
+	now := nano.Now()
+	switch {
+	case err == nil:
+		j.info.State = api.JobDone
+	case ctx.Err() != nil || errors.Is(err, context.Canceled):
+		j.info.State = api.JobCanceled
+		j.info.Error = err.Error()
+	default:
+		j.info.State = api.JobFailed
+		j.info.Error = err.Error()
+	}
+	j.info.EndTime = now
+	j.info.ExpireTime = now.Add(j.info.TTL * int64(time.Second))
+	info := j.info
+	j.mu.Unlock()
+	if err := writeInfo(j.dir, info); err != nil {
+		m.logger.Warn("Error writing job info", zap.String("job_id", info.ID), zap.Error(err))
+	}
+}
+
+// Get returns the job with the given ID.
+func (m *Manager) Get(id string) (api.JobInfo, error) {
+	m.purge()
+	j, err := m.lookup(id)
+	if err != nil {
+		return api.JobInfo{}, err
+	}
+	return j.snapshot(), nil
+}
+
+// List returns all jobs sorted by start time.
+func (m *Manager) List() []api.JobInfo {
+	m.purge()
+	m.mu.Lock()
+	infos := make([]api.JobInfo, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		infos = append(infos, j.snapshot())
+	}
+	m.mu.Unlock()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].StartTime < infos[j].StartTime
+	})
+	return infos
+}
+
+// SetTTL changes the TTL of a job.  The expiration time of an ended job
+// becomes ttl seconds from now.
+func (m *Manager) SetTTL(id string, ttl int64) (api.JobInfo, error) {
+	if ttl <= 0 {
+		return api.JobInfo{}, zqe.E(zqe.Invalid, "job ttl must be positive")
+	}
+	j, err := m.lookup(id)
+	if err != nil {
+		return api.JobInfo{}, err
+	}
+	j.mu.Lock()
+	j.info.TTL = ttl
+	if j.info.State != api.JobRunning {
+		j.info.ExpireTime = nano.Now().Add(ttl * int64(time.Second))
+	}
+	info := j.info
+	j.mu.Unlock()
+	if info.State != api.JobRunning {
+		// A running job writes its info when it ends.
+		if err := writeInfo(j.dir, info); err != nil {
+			return api.JobInfo{}, err
+		}
+	}
+	return info, nil
+}
+
+// Delete cancels a job if it is running, waits for it to end, and removes
+// it and its results.
+func (m *Manager) Delete(id string) error {
+	j, err := m.lookup(id)
+	if err != nil {
+		return err
+	}
+	j.cancel()
+	<-j.done
+	m.mu.Lock()
+	delete(m.jobs, id)
+	m.mu.Unlock()
+	return iosrc.RemoveAll(context.Background(), j.dir)
+}
+
+// DeleteSpace forgets the jobs of a deleted space.  Their files are
+// removed with the space.
+func (m *Manager) DeleteSpace(space api.SpaceID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, j := range m.jobs {
+		if j.snapshot().Space == space {
+			j.cancel()
+			delete(m.jobs, id)
+		}
+	}
+}
+
+// Results returns a reader of the results of the job with the given ID
+// that skips the first offset records and returns at most limit records
+// or, if limit is zero, all remaining records.  Results are not available
+// while the job is running.
+func (m *Manager) Results(ctx context.Context, id string, offset, limit int64) (zbuf.ReadCloser, error) {
+	j, err := m.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	if j.snapshot().State == api.JobRunning {
+		return nil, zqe.E(zqe.Conflict, "job %s is running", id)
+	}
+	r, err := iosrc.NewReader(ctx, j.dir.AppendPath(resultsFilename))
+	if err != nil {
+		return nil, err
+	}
+	zr := zngio.NewReader(r, resolver.NewContext())
+	return &pageReader{Reader: zr, Closer: r, offset: offset, limit: limit}, nil
+}
+
+func (m *Manager) lookup(id string) (*job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, zqe.E(zqe.NotFound, "job %s not found", id)
+	}
+	return j, nil
+}
+
+// purge removes expired jobs.
+func (m *Manager) purge() {
+	now := nano.Now()
+	var expired []*job
+	m.mu.Lock()
+	for id, j := range m.jobs {
+		info := j.snapshot()
+		if info.State != api.JobRunning && info.ExpireTime <= now {
+			expired = append(expired, j)
+			delete(m.jobs, id)
+		}
+	}
+	m.mu.Unlock()
+	for _, j := range expired {
+		m.remove(j.dir)
+	}
+}
+
+func (m *Manager) remove(dir iosrc.URI) {
+	if err := iosrc.RemoveAll(context.Background(), dir); err != nil {
+		m.logger.Warn("Error removing job", zap.String("uri", dir.String()), zap.Error(err))
+	}
+}
+
+func (j *job) snapshot() api.JobInfo {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.info
+}
+
+func mkdirAll(dir iosrc.URI) error {
+	src, err := iosrc.GetSource(dir)
+	if err != nil {
+		return err
+	}
+	if dirmk, ok := src.(iosrc.DirMaker); ok {
+		return dirmk.MkdirAll(dir, 0755)
+	}
+	return nil
+}
+
+func writeInfo(dir iosrc.URI, info api.JobInfo) error {
+	return iosrc.Replace(context.Background(), dir.AppendPath(infoFilename), func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(info)
+	})
+}
+
+// Output is a search.Output that stores a job's results.
+type Output struct {
+	job    *job
+	writer *zngio.Writer
+}
+
+func (o *Output) SendBatch(_ int, batch zbuf.Batch) error {
+	defer batch.Unref()
+	for _, rec := range batch.Records() {
+		if err := o.writer.Write(rec); err != nil {
+			return err
+		}
+	}
+	o.job.mu.Lock()
+	o.job.info.RecordCount += int64(batch.Length())
+	o.job.mu.Unlock()
+	return nil
+}
+
+func (o *Output) SendControl(v interface{}) error {
+	if stats, ok := v.(api.SearchStats); ok {
+		o.job.mu.Lock()
+		o.job.info.ScannerStats = stats.ScannerStats
+		o.job.mu.Unlock()
+	}
+	return nil
+}
+
+func (o *Output) End(v interface{}) error {
+	return o.SendControl(v)
+}
+
+func (o *Output) ContentType() string {
+	return search.MimeTypeZNG
+}
+
+type pageReader struct {
+	zbuf.Reader
+	io.Closer
+	offset int64
+	limit  int64
+	n      int64
+}
+
+func (p *pageReader) Read() (*zng.Record, error) {
+	for ; p.offset > 0; p.offset-- {
+		rec, err := p.Reader.Read()
+		if rec == nil || err != nil {
+			return nil, err
+		}
+	}
+	if p.limit > 0 && p.n >= p.limit {
+		return nil, nil
+	}
+	rec, err := p.Reader.Read()
+	if rec != nil {
+		p.n++
+	}
+	return rec, err
+}
@@ -0,0 +1,117 @@
+package zqd
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/brimsec/zq/proc/sort"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+)
+
+// serverLimits returns the search limits of a server configured with conf.
+// Sorts are always bounded by sort.MemMaxBytes so that searches cannot
+// request more memory than the server allows.
+func serverLimits(conf Config) api.SearchLimits {
+	limits := conf.SearchLimits
+	if limits.SortMemMaxBytes == 0 {
+		limits.SortMemMaxBytes = int64(sort.MemMaxBytes)
+	}
+	return limits
+}
+
+// searchLimits returns the limits of a search requesting the limits req,
+// which may tighten but not loosen the limits of the server.
+func (c *Core) searchLimits(req *api.SearchLimits) (*api.SearchLimits, error) {
+	limits := c.limits
+	if req == nil {
+		return &limits, nil
+	}
+	var err error
+	if limits.Timeout, err = tighten("timeout", limits.Timeout, req.Timeout); err != nil {
+		return nil, err
+	}
+	if limits.MaxRecordsRead, err = tighten("max_records_read", limits.MaxRecordsRead, req.MaxRecordsRead); err != nil {
+		return nil, err
+	}
+	if limits.MaxOutputBytes, err = tighten("max_output_bytes", limits.MaxOutputBytes, req.MaxOutputBytes); err != nil {
+		return nil, err
+	}
+	if limits.SortMemMaxBytes, err = tighten("sort_mem_max_bytes", limits.SortMemMaxBytes, req.SortMemMaxBytes); err != nil {
+		return nil, err
+	}
+	if limits.GroupByLimit, err = tighten("groupby_limit", limits.GroupByLimit, req.GroupByLimit); err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func tighten(name string, server, req int64) (int64, error) {
+	switch {
+	case req < 0:
+		return 0, zqe.E(zqe.Invalid, "search limit %s must not be negative", name)
+	case req == 0:
+		return server, nil
+	case server != 0 && req > server:
+		return 0, zqe.E(zqe.Invalid, "search limit %s of %d exceeds server limit of %d", name, req, server)
+	}
+	return req, nil
+}
+
+// admission limits the number of searches running at once.  Searches that
+// cannot run immediately wait in a queue of bounded length.
+type admission struct {
+	slots     chan struct{}
+	maxQueued int64
+	queued    int64
+}
+
+// newAdmission returns an admission running up to max searches at once,
+// with up to maxQueued more waiting.  If max is not positive, it returns
+// nil, which admits every search immediately.
+func newAdmission(max, maxQueued int) *admission {
+	if max <= 0 {
+		return nil
+	}
+	return &admission{
+		slots:     make(chan struct{}, max),
+		maxQueued: int64(maxQueued),
+	}
+}
+
+// acquire waits until a search may run and returns a function that must be
+// called when the search is done.  If the queue is full, acquire returns an
+// error of kind zqe.Unavailable.
+func (a *admission) acquire(ctx context.Context) (func(), error) {
+	if a == nil {
+		return func() {}, nil
+	}
+	select {
+	case a.slots <- struct{}{}:
+		return a.release, nil
+	default:
+	}
+	if atomic.AddInt64(&a.queued, 1) > a.maxQueued {
+		atomic.AddInt64(&a.queued, -1)
+		return nil, zqe.E(zqe.Unavailable, "too many searches: %d running and %d queued", cap(a.slots), a.maxQueued)
+	}
+	defer atomic.AddInt64(&a.queued, -1)
+	select {
+	case a.slots <- struct{}{}:
+		return a.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (a *admission) release() {
+	<-a.slots
+}
+
+// len returns the number of queued searches.
+func (a *admission) len() int {
+	if a == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&a.queued))
+}
@@ -0,0 +1,42 @@
+package zqd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/zqe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmission(t *testing.T) {
+	a := newAdmission(1, 1)
+	release, err := a.acquire(context.Background())
+	require.NoError(t, err)
+
+	admitted := make(chan struct{})
+	go func() {
+		release, err := a.acquire(context.Background())
+		if err == nil {
+			release()
+		}
+		close(admitted)
+	}()
+	require.Eventually(t, func() bool { return a.len() == 1 }, 5*time.Second, time.Millisecond)
+
+	_, err = a.acquire(context.Background())
+	assert.True(t, zqe.IsUnavailable(err), "unexpected error: %v", err)
+
+	release()
+	<-admitted
+	assert.Equal(t, 0, a.len())
+
+	release, err = a.acquire(context.Background())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = a.acquire(ctx)
+	assert.Equal(t, context.Canceled, err)
+	release()
+}
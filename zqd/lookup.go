@@ -0,0 +1,86 @@
+package zqd
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zqd/space"
+	"github.com/brimsec/zq/zqe"
+)
+
+// checkLookups returns an error of kind zqe.Forbidden if a lookup proc of
+// p, the proc of a search of space s, reads a table outside the directories
+// of s and those configured by Config.LookupDirs.  Tables are opened with
+// the permissions of the server, so a search must not name arbitrary paths.
+func (c *Core) checkLookups(ctx context.Context, s space.Space, p ast.Proc) error {
+	lookups := findLookups(p, nil)
+	if len(lookups) == 0 {
+		return nil
+	}
+	info, err := s.Info(ctx)
+	if err != nil {
+		return err
+	}
+	dirs := append([]iosrc.URI{s.Path(), info.DataPath}, c.lookupDirs...)
+	for _, l := range lookups {
+		table, err := iosrc.ParseURI(l.Table)
+		if err != nil {
+			return zqe.E(zqe.Invalid, "lookup: %w", err)
+		}
+		if !inDirs(table, dirs) {
+			return zqe.E(zqe.Forbidden, "lookup: table %s is outside the space and the lookup directories", l.Table)
+		}
+	}
+	return nil
+}
+
+func findLookups(p ast.Proc, lookups []*ast.LookupProc) []*ast.LookupProc {
+	switch p := p.(type) {
+	case *ast.LookupProc:
+		lookups = append(lookups, p)
+	case *ast.SequentialProc:
+		for _, p := range p.Procs {
+			lookups = findLookups(p, lookups)
+		}
+	case *ast.ParallelProc:
+		for _, p := range p.Procs {
+			lookups = findLookups(p, lookups)
+		}
+	}
+	return lookups
+}
+
+// inDirs returns true if u lies within one of dirs.  The symbolic links of
+// local files are resolved so that a link cannot point outside of a
+// directory.
+func inDirs(u iosrc.URI, dirs []iosrc.URI) bool {
+	if u.Scheme == "file" {
+		u.Path = filepath.ToSlash(resolveLinks(u.Filepath()))
+	}
+	for _, d := range dirs {
+		if d.IsZero() || d.Scheme != u.Scheme || d.Host != u.Host {
+			continue
+		}
+		if d.Scheme == "file" {
+			d.Path = filepath.ToSlash(resolveLinks(d.Filepath()))
+		}
+		dir := path.Clean(d.Path)
+		if strings.HasPrefix(path.Clean(u.Path), strings.TrimSuffix(dir, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLinks returns path with its symbolic links resolved or, if path
+// does not exist, cleaned.
+func resolveLinks(path string) string {
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		return p
+	}
+	return filepath.Clean(path)
+}
@@ -0,0 +1,181 @@
+package zqd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/pcapanalyzer"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// metrics holds the prometheus metrics of a Core.
+type metrics struct {
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	searches        *prometheus.CounterVec
+	scannerBytes    *prometheus.CounterVec
+	scannerRecords  *prometheus.CounterVec
+	ingestBytes     *prometheus.CounterVec
+	analyzers       *prometheus.GaugeVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zqd_http_requests_total",
+			Help: "Number of HTTP requests by route, method, and status code.",
+		}, []string{"route", "method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "zqd_http_request_duration_seconds",
+			Help:    "Duration of HTTP requests by route and method.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"route", "method"}),
+		searches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zqd_searches_total",
+			Help: "Number of completed searches by kind.",
+		}, []string{"kind"}),
+		scannerBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zqd_scanner_bytes_total",
+			Help: "Bytes read and matched by the scanners of completed searches.",
+		}, []string{"stat"}),
+		scannerRecords: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zqd_scanner_records_total",
+			Help: "Records read and matched by the scanners of completed searches.",
+		}, []string{"stat"}),
+		ingestBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zqd_ingest_bytes_total",
+			Help: "Bytes of logs and pcaps read by ingest operations.",
+		}, []string{"kind"}),
+		analyzers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "zqd_pcap_analyzer_processes",
+			Help: "Number of running pcap analyzer processes.",
+		}, []string{"analyzer"}),
+	}
+}
+
+// register registers the metrics of c with reg.
+func (m *metrics) register(reg prometheus.Registerer, c *Core) error {
+	collectors := []prometheus.Collector{
+		m.requests,
+		m.requestDuration,
+		m.searches,
+		m.scannerBytes,
+		m.scannerRecords,
+		m.ingestBytes,
+		m.analyzers,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "zqd_searches_active",
+			Help: "Number of running searches.",
+		}, func() float64 {
+			return float64(c.searches.len())
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "zqd_searches_queued",
+			Help: "Number of searches waiting to run.",
+		}, func() float64 {
+			return float64(c.admission.len())
+		}),
+		&spaceCollector{core: c},
+	}
+	for _, col := range collectors {
+		if err := reg.Register(col); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *metrics) observeSearch(kind string, stats api.ScannerStats) {
+	m.searches.WithLabelValues(kind).Inc()
+	m.scannerBytes.WithLabelValues("read").Add(float64(stats.BytesRead))
+	m.scannerBytes.WithLabelValues("matched").Add(float64(stats.BytesMatched))
+	m.scannerRecords.WithLabelValues("read").Add(float64(stats.RecordsRead))
+	m.scannerRecords.WithLabelValues("matched").Add(float64(stats.RecordsMatched))
+}
+
+// launcher returns a Launcher that starts processes with l and counts them
+// as running processes of analyzer until they exit.  A nil l returns nil.
+func (m *metrics) launcher(analyzer string, l pcapanalyzer.Launcher) pcapanalyzer.Launcher {
+	if l == nil {
+		return nil
+	}
+	gauge := m.analyzers.WithLabelValues(analyzer)
+	return func(ctx context.Context, r io.Reader, dir string) (pcapanalyzer.ProcessWaiter, error) {
+		p, err := l(ctx, r, dir)
+		if err != nil {
+			return p, err
+		}
+		gauge.Inc()
+		return &countedProcess{ProcessWaiter: p, gauge: gauge}, nil
+	}
+}
+
+type countedProcess struct {
+	pcapanalyzer.ProcessWaiter
+	gauge prometheus.Gauge
+}
+
+func (p *countedProcess) Wait() error {
+	defer p.gauge.Dec()
+	return p.ProcessWaiter.Wait()
+}
+
+// metricsMiddleware counts the requests to each route and records their
+// durations.  Routes are identified by their path templates so that the
+// number of label values stays bounded.
+func metricsMiddleware(m *metrics) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := "unknown"
+			if cur := mux.CurrentRoute(r); cur != nil {
+				if tmpl, err := cur.GetPathTemplate(); err == nil {
+					route = tmpl
+				}
+			}
+			recorder := newRecordingResponseWriter(w)
+			start := time.Now()
+			next.ServeHTTP(recorder, r)
+			m.requests.WithLabelValues(route, r.Method, strconv.Itoa(recorder.statusCode)).Inc()
+			m.requestDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+var spaceSizeDesc = prometheus.NewDesc(
+	"zqd_space_size_bytes",
+	"Size of the data of each space.",
+	// Space names are chosen by users and /metrics is served without
+	// authentication, so spaces are identified only by ID.
+	[]string{"space_id", "kind"}, nil,
+)
+
+// spaceCollector collects the sizes of the spaces of a Core.
+type spaceCollector struct {
+	core *Core
+}
+
+func (s *spaceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- spaceSizeDesc
+}
+
+func (s *spaceCollector) Collect(ch chan<- prometheus.Metric) {
+	infos, err := s.core.spaces.List(context.Background())
+	if err != nil {
+		s.core.logger.Warn("Error listing spaces for metrics", zap.Error(err))
+		return
+	}
+	for _, info := range infos {
+		ch <- prometheus.MustNewConstMetric(spaceSizeDesc, prometheus.GaugeValue,
+			float64(info.Size), string(info.ID), "data")
+		if info.PcapSize != 0 {
+			ch <- prometheus.MustNewConstMetric(spaceSizeDesc, prometheus.GaugeValue,
+				float64(info.PcapSize), string(info.ID), "pcap")
+		}
+	}
+}
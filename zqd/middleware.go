@@ -43,7 +43,10 @@ func accessLogMiddleware(logger *zap.Logger) mux.MiddlewareFunc {
 	logger = logger.Named("http.access")
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			logger := logger.With(zap.String("request_id", getRequestID(r.Context())))
+			logger := logger.With(
+				zap.String("request_id", getRequestID(r.Context())),
+				zap.String("user", getUser(r.Context())),
+			)
 			detailedLogger := logger.With(
 				zap.String("host", r.Host),
 				zap.String("method", r.Method),
@@ -0,0 +1,50 @@
+package pcapanalyzer
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/brimsec/zq/ast"
+)
+
+// Analyzer is a pcap analyzer run alongside Zeek and Suricata on each
+// imported pcap.  Its Launcher is started in a directory of its own, and
+// when it exits, the files it wrote there that match Glob are read as
+// logs of the given Format and passed through Proc to be added to the
+// space.
+type Analyzer struct {
+	// Name identifies the analyzer in errors and metrics and names its
+	// output directory.
+	Name     string
+	Launcher Launcher
+	// Format is the format of the analyzer's logs as accepted by
+	// zio.ReaderOpts.  If empty, the format is detected.
+	Format string
+	// Glob selects the log files in the output directory.  If empty,
+	// every file is read.
+	Glob string
+	// Proc, if not nil, is applied to the records read from the logs.
+	Proc ast.Proc
+}
+
+var analyzerName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// CheckAnalyzers returns an error if an analyzer lacks a launcher, if its
+// name is not a nonempty string of letters, digits, '-', and '_', or if it
+// repeats the name of another analyzer or that of Zeek or Suricata.
+func CheckAnalyzers(analyzers []Analyzer) error {
+	names := map[string]bool{"zeek": true, "suricata": true}
+	for _, a := range analyzers {
+		if !analyzerName.MatchString(a.Name) {
+			return fmt.Errorf("invalid pcap analyzer name %q", a.Name)
+		}
+		if names[a.Name] {
+			return fmt.Errorf("duplicate pcap analyzer name %q", a.Name)
+		}
+		names[a.Name] = true
+		if a.Launcher == nil {
+			return fmt.Errorf("pcap analyzer %q has no launcher", a.Name)
+		}
+	}
+	return nil
+}
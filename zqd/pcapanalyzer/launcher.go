@@ -21,6 +21,12 @@ type Launcher func(context.Context, io.Reader, string) (ProcessWaiter, error)
 // - expects to receive a pcap file on stdin
 // - writes the resulting logs into its working directory
 func LauncherFromPath(path string) (Launcher, error) {
+	return LauncherFromCommand(path)
+}
+
+// LauncherFromCommand is like LauncherFromPath but passes args to the
+// command.
+func LauncherFromCommand(path string, args ...string) (Launcher, error) {
 	var cmdline []string
 
 	if runtime.GOOS == "windows" {
@@ -34,6 +40,7 @@ func LauncherFromPath(path string) (Launcher, error) {
 	} else {
 		cmdline = []string{path}
 	}
+	cmdline = append(cmdline, args...)
 
 	return func(ctx context.Context, r io.Reader, dir string) (ProcessWaiter, error) {
 		cmd := exec.CommandContext(ctx, cmdline[0], cmdline[1:]...)
@@ -20,6 +20,8 @@ const (
 	MetaFile = "pcap.json"
 )
 
+// Store tracks the pcaps imported into a space along with a time index of
+// each so that packets may be extracted from any of them.
 type Store struct {
 	metaMu sync.Mutex
 	meta   meta
@@ -27,13 +29,19 @@ type Store struct {
 }
 
 type meta struct {
+	Pcaps []pcapMeta
+}
+
+type pcapMeta struct {
 	PcapURI iosrc.URI
 	Span    nano.Span
 	Index   pcap.Index
 }
 
 type Info struct {
+	// PcapURI is the most recently added pcap.
 	PcapURI  iosrc.URI
+	PcapURIs []iosrc.URI
 	PcapSize int64
 	Span     nano.Span
 }
@@ -51,19 +59,32 @@ func Load(ctx context.Context, u iosrc.URI) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	var m meta
+	// A store holding a single pcap was once described by a pcapMeta,
+	// so decode both forms.
+	var m struct {
+		meta
+		pcapMeta
+	}
 	if err := json.Unmarshal(b, &m); err != nil {
 		return nil, err
 	}
+	if len(m.Pcaps) == 0 && !m.PcapURI.IsZero() {
+		m.Pcaps = []pcapMeta{m.pcapMeta}
+	}
 	return &Store{
 		root: u,
-		meta: m,
+		meta: m.meta,
 	}, nil
 }
 
-func (s *Store) Update(ctx context.Context, pcapuri iosrc.URI, warningCh chan<- string) error {
+// Add indexes the pcap at pcapuri and adds it to the store.  A pcap may be
+// added only once.
+func (s *Store) Add(ctx context.Context, pcapuri iosrc.URI, warningCh chan<- string) error {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
+	if s.find(pcapuri) >= 0 {
+		return zqe.E(zqe.Conflict, "pcap %s already exists in space", pcapuri)
+	}
 	pcapfile, err := iosrc.NewReader(ctx, pcapuri)
 	if err != nil {
 		return err
@@ -73,12 +94,43 @@ func (s *Store) Update(ctx context.Context, pcapuri iosrc.URI, warningCh chan<-
 	if err != nil {
 		return err
 	}
-	m := meta{
+	pcaps := append(s.meta.Pcaps[:len(s.meta.Pcaps):len(s.meta.Pcaps)], pcapMeta{
 		PcapURI: pcapuri,
 		Span:    idx.Span(),
 		Index:   idx,
+	})
+	return s.sync(ctx, meta{Pcaps: pcaps})
+}
+
+// Remove removes the pcap at pcapuri from the store.
+func (s *Store) Remove(ctx context.Context, pcapuri iosrc.URI) error {
+	s.metaMu.Lock()
+	defer s.metaMu.Unlock()
+	i := s.find(pcapuri)
+	if i < 0 {
+		return zqe.E(zqe.NotFound, "pcap %s not found in space", pcapuri)
+	}
+	var pcaps []pcapMeta
+	pcaps = append(pcaps, s.meta.Pcaps[:i]...)
+	pcaps = append(pcaps, s.meta.Pcaps[i+1:]...)
+	if len(pcaps) == 0 {
+		s.meta = meta{}
+		return iosrc.Remove(ctx, s.root.AppendPath(MetaFile))
+	}
+	return s.sync(ctx, meta{Pcaps: pcaps})
+}
+
+func (s *Store) find(pcapuri iosrc.URI) int {
+	for i, p := range s.meta.Pcaps {
+		if p.PcapURI == pcapuri {
+			return i
+		}
 	}
-	err = iosrc.Replace(ctx, s.root.AppendPath(MetaFile), func(w io.Writer) error {
+	return -1
+}
+
+func (s *Store) sync(ctx context.Context, m meta) error {
+	err := iosrc.Replace(ctx, s.root.AppendPath(MetaFile), func(w io.Writer) error {
 		return json.NewEncoder(w).Encode(m)
 	})
 	if err != nil {
@@ -91,27 +143,38 @@ func (s *Store) Update(ctx context.Context, pcapuri iosrc.URI, warningCh chan<-
 func (s *Store) Empty() bool {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	return s.meta.PcapURI.IsZero()
+	return len(s.meta.Pcaps) == 0
 }
 
 func (s *Store) Info(ctx context.Context) (Info, error) {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	fi, err := iosrc.Stat(ctx, s.meta.PcapURI)
-	if err != nil {
-		return Info{}, err
+	var info Info
+	for i, p := range s.meta.Pcaps {
+		fi, err := iosrc.Stat(ctx, p.PcapURI)
+		if err != nil {
+			return Info{}, err
+		}
+		info.PcapURI = p.PcapURI
+		info.PcapURIs = append(info.PcapURIs, p.PcapURI)
+		info.PcapSize += fi.Size()
+		if i == 0 {
+			info.Span = p.Span
+		} else {
+			info.Span = info.Span.Union(p.Span)
+		}
 	}
-	return Info{
-		PcapURI:  s.meta.PcapURI,
-		PcapSize: fi.Size(),
-		Span:     s.meta.Span,
-	}, nil
+	return info, nil
 }
 
-func (s *Store) PcapURI() iosrc.URI {
+func (s *Store) PcapURIs() []iosrc.URI {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	return s.meta.PcapURI
+	var uris []iosrc.URI
+	for _, p := range s.meta.Pcaps {
+		uris = append(uris, p.PcapURI)
+	}
+	return uris
 }
 
 func (s *Store) Delete(ctx context.Context) error {
@@ -121,14 +184,42 @@ func (s *Store) Delete(ctx context.Context) error {
 	return iosrc.Remove(ctx, s.root.AppendPath(MetaFile))
 }
 
+// Search streams the packets of a pcap search.  When the packets come from
+// more than one pcap, they are merged in time order into a pcap-ng stream.
 type Search struct {
-	*pcap.SearchReader
-	io.Closer
+	io.Reader
+	id      string
+	closers []io.Closer
+	// pipe and merged are set when the packets are merged from more than
+	// one pcap.  merged is closed when the merge has finished.
+	pipe   *io.PipeReader
+	merged chan struct{}
+}
+
+func (s *Search) ID() string {
+	return s.id
+}
+
+func (s *Search) Close() error {
+	var err error
+	if s.pipe != nil {
+		// Closing the pipe stops the merge, which must finish reading
+		// before its files are closed.
+		err = s.pipe.Close()
+		<-s.merged
+	}
+	for _, c := range s.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
 
 // NewSearch returns a *Search that streams all the packets meeting
-// the provided search request. If pcaps are not supported in this Space,
-// ErrPcapOpsNotSupported is returned.
+// the provided search request from each pcap whose time span overlaps the
+// request.  If the request has both a flow and a BPF filter, the packets must
+// match both.  If no packets match, pcap.ErrNoPcapsFound is returned.
 func (s *Store) NewSearch(ctx context.Context, req api.PcapSearch) (*Search, error) {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
@@ -148,29 +239,84 @@ func (s *Store) NewSearch(ctx context.Context, req api.PcapSearch) (*Search, err
 		search = pcap.NewUDPSearch(span, flow)
 	case "icmp":
 		search = pcap.NewICMPSearch(span, req.SrcHost, req.DstHost)
+	case "":
+		if req.Filter == "" {
+			return nil, fmt.Errorf("unsupported proto type: %s", req.Proto)
+		}
+		search = pcap.NewRangeSearch(span)
 	default:
 		return nil, fmt.Errorf("unsupported proto type: %s", req.Proto)
 	}
-	f, err := iosrc.NewReader(ctx, s.meta.PcapURI)
+	if req.Filter != "" {
+		if err := search.AddBPF(req.Filter); err != nil {
+			return nil, err
+		}
+	}
+	out := &Search{id: search.ID()}
+	var readers []*pcap.SearchReader
+	for _, p := range s.meta.Pcaps {
+		if !p.Span.Overlaps(span) {
+			continue
+		}
+		r, f, err := p.search(ctx, search, span)
+		if err == pcap.ErrNoPcapsFound {
+			continue
+		}
+		if err != nil {
+			out.Close()
+			return nil, err
+		}
+		out.closers = append(out.closers, f)
+		readers = append(readers, r)
+	}
+	switch len(readers) {
+	case 0:
+		return nil, pcap.ErrNoPcapsFound
+	case 1:
+		out.Reader = readers[0]
+		return out, nil
+	}
+	var pcapReaders []pcapio.Reader
+	for _, r := range readers {
+		pr, err := pcapio.NewReader(r)
+		if err != nil {
+			out.Close()
+			return nil, err
+		}
+		pcapReaders = append(pcapReaders, pr)
+	}
+	pr, pw := io.Pipe()
+	out.Reader = pr
+	out.pipe = pr
+	out.merged = make(chan struct{})
+	go func() {
+		pw.CloseWithError(pcap.Merge(ctx, pw, pcapReaders))
+		close(out.merged)
+	}()
+	return out, nil
+}
+
+func (p pcapMeta) search(ctx context.Context, search *pcap.Search, span nano.Span) (*pcap.SearchReader, io.Closer, error) {
+	f, err := iosrc.NewReader(ctx, p.PcapURI)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	slicer, err := pcap.NewSlicer(f, s.meta.Index, span)
+	slicer, err := pcap.NewSlicer(f, p.Index, span)
 	if err != nil {
 		f.Close()
-		return nil, err
+		return nil, nil, err
 	}
 	pcapReader, err := pcapio.NewReader(slicer)
 	if err != nil {
 		f.Close()
-		return nil, err
+		return nil, nil, err
 	}
 	r, err := search.Reader(ctx, pcapReader)
 	if err != nil {
 		f.Close()
-		return nil, err
+		return nil, nil, err
 	}
-	return &Search{r, f}, nil
+	return r, f, nil
 }
 
 const metaFileV0 = "packets.idx.json"
@@ -185,9 +331,11 @@ func MigrateV3(u iosrc.URI, pcapuri iosrc.URI) error {
 		return err
 	}
 	m := meta{
-		PcapURI: pcapuri,
-		Span:    idx.Span(),
-		Index:   idx,
+		Pcaps: []pcapMeta{{
+			PcapURI: pcapuri,
+			Span:    idx.Span(),
+			Index:   idx,
+		}},
 	}
 	out, err := json.Marshal(m)
 	if err != nil {
@@ -0,0 +1,167 @@
+package zqd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+	"github.com/brimsec/zq/zql"
+)
+
+var queryParamRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// queryParamMark begins the value of the string literal that stands for a
+// parameter while a saved query is parsed.
+const queryParamMark = "\x00"
+
+// A queryParam is the value of a parameter of a saved query as a literal.
+type queryParam struct {
+	text    string
+	literal interface{}
+	bound   int
+}
+
+// compileQuery parses q and returns its AST as JSON with each ${name}
+// parameter bound to its value in params or, if it has none, to its default
+// in q.Params.  A parameter stands for a single literal and its value must
+// parse as one, so that a value cannot change the structure of the query.
+func compileQuery(q api.SavedQuery, params map[string]string) ([]byte, error) {
+	qparams := make(map[string]*queryParam)
+	var missing []string
+	var uses int
+	var err error
+	text := queryParamRegexp.ReplaceAllStringFunc(q.Query, func(m string) string {
+		name := queryParamRegexp.FindStringSubmatch(m)[1]
+		if _, ok := qparams[name]; !ok {
+			v, ok := params[name]
+			if !ok {
+				v, ok = q.Params[name]
+			}
+			if !ok {
+				missing = append(missing, name)
+				return m
+			}
+			p, perr := parseQueryParam(v)
+			if perr != nil {
+				if err == nil {
+					err = zqe.E(zqe.Invalid, "query %q: parameter %s: %w", q.Name, name, perr)
+				}
+				return m
+			}
+			qparams[name] = p
+		}
+		uses++
+		return `"\u0000` + name + `"`
+	})
+	if len(missing) > 0 {
+		return nil, zqe.E(zqe.Invalid, "query %q: no value for parameter %s", q.Name, strings.Join(missing, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	proc, err := zql.ParseProc(text)
+	if err != nil {
+		return nil, zqe.E(zqe.Invalid, "query %q: %s", q.Name, err)
+	}
+	b, err := json.Marshal(proc)
+	if err != nil {
+		return nil, err
+	}
+	if uses == 0 {
+		return b, nil
+	}
+	// Bind the parameters in the generic form of the AST so that every
+	// kind of node is covered.  Numbers are kept as written.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var node interface{}
+	if err := dec.Decode(&node); err != nil {
+		return nil, err
+	}
+	node = bindQueryParams(node, qparams)
+	var bound int
+	var unbound []string
+	for name, p := range qparams {
+		bound += p.bound
+		if p.bound == 0 {
+			unbound = append(unbound, name)
+		}
+	}
+	if bound != uses {
+		sort.Strings(unbound)
+		return nil, zqe.E(zqe.Invalid, "query %q: parameters must stand for whole literals: %s", q.Name, strings.Join(unbound, ", "))
+	}
+	return json.Marshal(node)
+}
+
+// parseQueryParam parses v as the value of a search comparison and returns
+// it if it is a single literal.
+func parseQueryParam(v string) (*queryParam, error) {
+	proc, err := zql.ParseProc("x=" + v)
+	if err != nil {
+		return nil, fmt.Errorf("value %q is not a literal", v)
+	}
+	if f, ok := proc.(*ast.FilterProc); ok {
+		if c, ok := f.Filter.(*ast.CompareField); ok && c.Comparator == "=" {
+			if field, ok := c.Field.(*ast.Field); ok && field.Field == "x" {
+				b, err := json.Marshal(c.Value)
+				if err != nil {
+					return nil, err
+				}
+				var literal interface{}
+				if err := json.Unmarshal(b, &literal); err != nil {
+					return nil, err
+				}
+				return &queryParam{text: strings.TrimSpace(v), literal: literal}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("value %q is not a literal", v)
+}
+
+// bindQueryParams replaces the placeholder literals in node with the
+// literals of params.
+func bindQueryParams(node interface{}, params map[string]*queryParam) interface{} {
+	switch node := node.(type) {
+	case map[string]interface{}:
+		if p := lookupQueryParam(node, params); p != nil {
+			p.bound++
+			return p.literal
+		}
+		if node["op"] == "Search" {
+			if v, ok := node["value"].(map[string]interface{}); ok {
+				if p := lookupQueryParam(v, params); p != nil {
+					p.bound++
+					node["text"] = p.text
+					node["value"] = p.literal
+					return node
+				}
+			}
+		}
+		for k, v := range node {
+			node[k] = bindQueryParams(v, params)
+		}
+	case []interface{}:
+		for i, v := range node {
+			node[i] = bindQueryParams(v, params)
+		}
+	}
+	return node
+}
+
+func lookupQueryParam(node map[string]interface{}, params map[string]*queryParam) *queryParam {
+	if node["op"] != "Literal" || node["type"] != "string" {
+		return nil
+	}
+	v, ok := node["value"].(string)
+	if !ok || !strings.HasPrefix(v, queryParamMark) {
+		return nil
+	}
+	return params[strings.TrimPrefix(v, queryParamMark)]
+}
@@ -0,0 +1,233 @@
+package search
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/storage/archivestore"
+	"go.uber.org/zap"
+)
+
+// WorkerPool is a pool of worker zqd instances that scan the chunks of
+// archive spaces on behalf of a coordinating zqd.  The workers must serve
+// the same data directory as the coordinator.
+type WorkerPool struct {
+	conns  []*api.Connection
+	logger *zap.Logger
+	next   uint32
+}
+
+// NewWorkerPool returns a pool of the workers listening at urls.  If token
+// is not empty, it is sent as the bearer token of each request to a worker
+// and must grant read permission on the spaces searched.  If tlsConf is not
+// nil, it is used to connect to workers at https URLs.
+func NewWorkerPool(urls []string, token string, tlsConf *tls.Config, logger *zap.Logger) *WorkerPool {
+	conns := make([]*api.Connection, len(urls))
+	for i, u := range urls {
+		conns[i] = api.NewConnectionTo(u)
+		if token != "" {
+			conns[i].SetAuthToken(token)
+		}
+		if tlsConf != nil {
+			conns[i].SetTLSConfig(tlsConf)
+		}
+	}
+	return &WorkerPool{conns: conns, logger: logger}
+}
+
+// Len returns the number of workers in the pool.
+func (p *WorkerPool) Len() int {
+	return len(p.conns)
+}
+
+// source returns a driver.MultiSource that sends each span of the archive
+// st to a worker, which scans the span's chunks for records matching the
+// search filter and returns them ordered by time in direction dir.  Each
+// worker search is subject to limits.
+func (p *WorkerPool) source(st *archivestore.Storage, space api.SpaceID, dir zbuf.Direction, limits api.SearchLimits) driver.MultiSource {
+	// The output limit applies to the output of the whole search, which
+	// the coordinator enforces, and not to the records a worker returns
+	// for the coordinator to process.
+	limits.MaxOutputBytes = 0
+	return &workerSource{pool: p, store: st, space: space, dir: dir, limits: limits}
+}
+
+// pick returns the index of the worker to try first for the next span.
+func (p *WorkerPool) pick() int {
+	return int(atomic.AddUint32(&p.next, 1)-1) % len(p.conns)
+}
+
+type workerSource struct {
+	pool   *WorkerPool
+	store  *archivestore.Storage
+	space  api.SpaceID
+	dir    zbuf.Direction
+	limits api.SearchLimits
+}
+
+func (s *workerSource) OrderInfo() (string, bool) {
+	return "ts", s.dir == zbuf.DirTimeReverse
+}
+
+func (s *workerSource) SendSources(ctx context.Context, zctx *resolver.Context, sf driver.SourceFilter, srcChan chan driver.SourceOpener) error {
+	// The workers apply the filter lifted by the driver, and the rest of
+	// the flowgraph runs on the coordinator.
+	var p ast.Proc = &ast.PassProc{Node: ast.Node{Op: "PassProc"}}
+	if sf.FilterExpr != nil {
+		p = &ast.FilterProc{Node: ast.Node{Op: "FilterProc"}, Filter: sf.FilterExpr}
+	}
+	proc, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return s.store.SpanWalk(ctx, sf.Span, s.dir, func(si archive.SpanInfo) error {
+		req := api.WorkerRequest{
+			SearchRequest: api.SearchRequest{
+				Space:  s.space,
+				Proc:   proc,
+				Span:   si.Span,
+				Dir:    s.dir.Int(),
+				Limits: &s.limits,
+			},
+			Chunks: make([]api.Chunk, len(si.Chunks)),
+		}
+		for i, c := range si.Chunks {
+			req.Chunks[i] = api.Chunk{
+				Id:          c.Id.String(),
+				First:       c.First,
+				Last:        c.Last,
+				FileKind:    string(c.DataFileKind),
+				RecordCount: c.RecordCount,
+			}
+		}
+		so := func() (driver.ScannerCloser, error) {
+			return &workerScanner{
+				ctx:   ctx,
+				pool:  s.pool,
+				zctx:  zctx,
+				req:   req,
+				first: s.pool.pick(),
+			}, nil
+		}
+		select {
+		case srcChan <- so:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// workerScanner scans a span on a worker.  If the worker fails before
+// returning a record, the span is scanned on the next worker of the pool
+// until every worker has been tried.  A failure after a record has been
+// returned fails the span, since records with equal timestamps may come in
+// a different order from another scan.
+type workerScanner struct {
+	ctx   context.Context
+	pool  *WorkerPool
+	zctx  *resolver.Context
+	req   api.WorkerRequest
+	first int
+
+	attempt  int
+	body     io.ReadCloser
+	search   *api.ZngSearch
+	returned int64
+
+	mu        sync.Mutex // protects below
+	prevStats scanner.ScannerStats
+	stats     scanner.ScannerStats
+}
+
+func (w *workerScanner) Pull() (zbuf.Batch, error) {
+	return zbuf.ReadBatch(w, scanner.BatchSize)
+}
+
+func (w *workerScanner) Read() (*zng.Record, error) {
+	for {
+		err := w.open()
+		if err == nil {
+			var rec *zng.Record
+			rec, err = w.search.Read()
+			if err == nil {
+				if rec != nil {
+					w.returned++
+				}
+				return rec, nil
+			}
+		}
+		if w.ctx.Err() != nil {
+			return nil, w.ctx.Err()
+		}
+		conn := w.conn()
+		w.Close()
+		w.attempt++
+		if w.returned > 0 || w.attempt >= w.pool.Len() {
+			return nil, fmt.Errorf("worker %s: %w", conn.URL(), err)
+		}
+		w.pool.logger.Warn("Worker failed, retrying span on another worker",
+			zap.String("worker", conn.URL()),
+			zap.Stringer("span", w.req.Span),
+			zap.Error(err))
+	}
+}
+
+func (w *workerScanner) conn() *api.Connection {
+	return w.pool.conns[(w.first+w.attempt)%w.pool.Len()]
+}
+
+func (w *workerScanner) open() error {
+	if w.search != nil {
+		return nil
+	}
+	body, err := w.conn().WorkerRaw(w.ctx, w.req, nil)
+	if err != nil {
+		return err
+	}
+	w.body = body
+	w.search = api.NewZngSearchWithContext(body, w.zctx)
+	w.search.SetOnCtrl(func(ctrl interface{}) {
+		if stats, ok := ctrl.(*api.SearchStats); ok {
+			w.mu.Lock()
+			w.stats = scanner.ScannerStats(stats.ScannerStats)
+			w.mu.Unlock()
+		}
+	})
+	return nil
+}
+
+func (w *workerScanner) Stats() *scanner.ScannerStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	stats := w.prevStats
+	stats.Accumulate(&w.stats)
+	return &stats
+}
+
+func (w *workerScanner) Close() error {
+	if w.body == nil {
+		return nil
+	}
+	w.mu.Lock()
+	w.prevStats.Accumulate(&w.stats)
+	w.stats = scanner.ScannerStats{}
+	w.mu.Unlock()
+	err := w.body.Close()
+	w.body = nil
+	w.search = nil
+	return err
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc/sort"
+	"github.com/brimsec/zq/proc/spill"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
@@ -35,7 +37,9 @@ const (
 )
 
 type SearchOp struct {
-	query *Query
+	query   *Query
+	limits  api.SearchLimits
+	workers *WorkerPool
 }
 
 func NewSearchOp(req api.SearchRequest) (*SearchOp, error) {
@@ -45,28 +49,50 @@ func NewSearchOp(req api.SearchRequest) (*SearchOp, error) {
 	if req.Span.Dur < 0 {
 		return nil, errors.New("time span must have non-negative duration")
 	}
-	// XXX zqd only supports backwards searches, remove once this has been
-	// fixed.
-	if req.Dir == 1 {
-		return nil, zqe.E(zqe.Invalid, "forward searches not yet supported")
-	}
-	if req.Dir != -1 {
+	if req.Dir != 1 && req.Dir != -1 {
 		return nil, zqe.E(zqe.Invalid, "time direction must be 1 or -1")
 	}
 	query, err := UnpackQuery(req)
 	if err != nil {
 		return nil, err
 	}
-	return &SearchOp{query: query}, nil
+	op := &SearchOp{query: query}
+	if req.Limits != nil {
+		op.limits = *req.Limits
+	}
+	return op, nil
+}
+
+// Proc returns the proc of the search.
+func (s *SearchOp) Proc() ast.Proc {
+	return s.query.Proc
+}
+
+// SetWorkers distributes the scanning of archive spaces across the workers
+// of p.  Spaces of other kinds are searched locally.
+func (s *SearchOp) SetWorkers(p *WorkerPool) {
+	s.workers = p
 }
 
+// Run runs the search on store, sending its results to output.  A search
+// that exceeds one of its limits fails with an error of kind
+// zqe.LimitExceeded.
 func (s *SearchOp) Run(ctx context.Context, store storage.Storage, output Output) (err error) {
+	if s.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(s.limits.Timeout))
+		defer cancel()
+	}
 	d := &searchdriver{
 		output:    output,
 		startTime: nano.Now(),
+		limits:    s.limits,
 	}
 	d.start(0)
 	defer func() {
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = zqe.E(zqe.LimitExceeded, "search exceeded time limit of %s", time.Duration(s.limits.Timeout))
+		}
 		if err != nil {
 			d.abort(0, err)
 			return
@@ -80,9 +106,17 @@ func (s *SearchOp) Run(ctx context.Context, store storage.Storage, output Output
 
 	switch st := store.(type) {
 	case *archivestore.Storage:
-		return driver.MultiRun(ctx, d, s.query.Proc, zctx, st.MultiSource(), driver.MultiConfig{
-			Span:      s.query.Span,
-			StatsTick: statsTicker.C,
+		dir := direction(s.query.Dir)
+		msrc, parallelism := st.MultiSource(dir, int(s.limits.SortMemMaxBytes)), 0
+		if s.workers != nil {
+			msrc, parallelism = s.workers.source(st, s.query.Space, dir, s.limits), s.workers.Len()
+		}
+		return driver.MultiRun(ctx, d, s.query.Proc, zctx, msrc, driver.MultiConfig{
+			Parallelism:     parallelism,
+			Span:            s.query.Span,
+			StatsTick:       statsTicker.C,
+			SortMemMaxBytes: int(s.limits.SortMemMaxBytes),
+			GroupByLimit:    int(s.limits.GroupByLimit),
 		})
 	case *filestore.Storage:
 		rc, err := st.Open(ctx, zctx, s.query.Span)
@@ -90,18 +124,40 @@ func (s *SearchOp) Run(ctx context.Context, store storage.Storage, output Output
 			return err
 		}
 		defer rc.Close()
+		var r zbuf.Reader = rc
+		if s.query.Dir == 1 {
+			// The records of a filestore are sorted in reverse.
+			memMaxBytes := sort.MemMaxBytes
+			if s.limits.SortMemMaxBytes > 0 {
+				memMaxBytes = int(s.limits.SortMemMaxBytes)
+			}
+			sorted := spill.NewSortedReader(rc, spill.TimeCompareFn(zbuf.DirTimeForward), memMaxBytes)
+			defer sorted.Close()
+			r = sorted
+		}
 
-		return driver.Run(ctx, d, s.query.Proc, zctx, rc, driver.Config{
+		return driver.Run(ctx, d, s.query.Proc, zctx, r, driver.Config{
 			ReaderSortKey:     "ts",
-			ReaderSortReverse: true,
+			ReaderSortReverse: s.query.Dir != 1,
 			Span:              s.query.Span,
 			StatsTick:         statsTicker.C,
+			SortMemMaxBytes:   int(s.limits.SortMemMaxBytes),
+			GroupByLimit:      int(s.limits.GroupByLimit),
 		})
 	default:
 		return fmt.Errorf("unknown storage type %T", st)
 	}
 }
 
+// direction returns the zbuf.Direction of the time direction dir of a
+// search request.
+func direction(dir int) zbuf.Direction {
+	if dir == 1 {
+		return zbuf.DirTimeForward
+	}
+	return zbuf.DirTimeReverse
+}
+
 // A Query is the internal representation of search query describing a source
 // of tuples, a "search" applied to the tuples producing a set of matched
 // tuples, and a proc to the process the tuples
@@ -128,8 +184,10 @@ func UnpackQuery(req api.SearchRequest) (*Query, error) {
 
 // searchdriver implements driver.Driver.
 type searchdriver struct {
-	output    Output
-	startTime nano.Ts
+	output      Output
+	startTime   nano.Ts
+	limits      api.SearchLimits
+	outputBytes int64
 }
 
 func (d *searchdriver) start(id int64) error {
@@ -142,6 +200,10 @@ func (d *searchdriver) end(id int64) error {
 
 func (d *searchdriver) abort(id int64, err error) error {
 	verr := &api.Error{Type: "INTERNAL", Message: err.Error()}
+	var zerr *zqe.Error
+	if errors.As(err, &zerr) {
+		verr.Kind = zerr.Kind.String()
+	}
 	return d.output.SendControl(&api.TaskEnd{"TaskEnd", id, verr})
 }
 
@@ -154,6 +216,14 @@ func (d *searchdriver) Warn(warning string) error {
 }
 
 func (d *searchdriver) Write(cid int, batch zbuf.Batch) error {
+	if max := d.limits.MaxOutputBytes; max > 0 {
+		for _, rec := range batch.Records() {
+			d.outputBytes += int64(len(rec.Raw))
+		}
+		if d.outputBytes > max {
+			return zqe.E(zqe.LimitExceeded, "search output exceeded %d bytes", max)
+		}
+	}
 	return d.output.SendBatch(cid, batch)
 }
 
@@ -164,7 +234,15 @@ func (d *searchdriver) Stats(stats api.ScannerStats) error {
 		UpdateTime:   nano.Now(),
 		ScannerStats: stats,
 	}
-	return d.output.SendControl(v)
+	if err := d.output.SendControl(v); err != nil {
+		return err
+	}
+	// Records read are counted by the scanners, so this limit is checked
+	// each time stats are sent.
+	if max := d.limits.MaxRecordsRead; max > 0 && stats.RecordsRead > max {
+		return zqe.E(zqe.LimitExceeded, "search read more than %d records", max)
+	}
+	return nil
 }
 
 func (d *searchdriver) ChannelEnd(cid int) error {
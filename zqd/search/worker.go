@@ -2,6 +2,7 @@ package search
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,18 +20,14 @@ import (
 )
 
 type WorkerOp struct {
-	si   archive.SpanInfo
-	proc ast.Proc
-	dir  int
+	si     archive.SpanInfo
+	proc   ast.Proc
+	dir    int
+	limits api.SearchLimits
 }
 
 func NewWorkerOp(req api.WorkerRequest) (*WorkerOp, error) {
-	// XXX zqd only supports backwards searches, remove once this has been
-	// fixed.
-	if req.Dir == 1 {
-		return nil, zqe.E(zqe.Invalid, "forward searches not yet supported")
-	}
-	if req.Dir != -1 {
+	if req.Dir != 1 && req.Dir != -1 {
 		return nil, zqe.E(zqe.Invalid, "time direction must be 1 or -1")
 	}
 
@@ -57,16 +54,37 @@ func NewWorkerOp(req api.WorkerRequest) (*WorkerOp, error) {
 		return nil, err
 	}
 
-	return &WorkerOp{si: si, proc: proc, dir: req.Dir}, nil
+	op := &WorkerOp{si: si, proc: proc, dir: req.Dir}
+	if req.Limits != nil {
+		op.limits = *req.Limits
+	}
+	return op, nil
+}
+
+// Proc returns the proc of the worker search.
+func (w *WorkerOp) Proc() ast.Proc {
+	return w.proc
 }
 
+// Run runs the worker search on store, sending its results to output.
+// Like SearchOp.Run, it fails with an error of kind zqe.LimitExceeded if
+// the search exceeds one of its limits.
 func (w *WorkerOp) Run(ctx context.Context, store storage.Storage, output Output) (err error) {
+	if w.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(w.limits.Timeout))
+		defer cancel()
+	}
 	d := &searchdriver{
 		output:    output,
 		startTime: nano.Now(),
+		limits:    w.limits,
 	}
 	d.start(0)
 	defer func() {
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = zqe.E(zqe.LimitExceeded, "search exceeded time limit of %s", time.Duration(w.limits.Timeout))
+		}
 		if err != nil {
 			d.abort(0, err)
 			return
@@ -80,9 +98,11 @@ func (w *WorkerOp) Run(ctx context.Context, store storage.Storage, output Output
 
 	switch st := store.(type) {
 	case *archivestore.Storage:
-		return driver.MultiRun(ctx, d, w.proc, zctx, st.StaticSource(w.si), driver.MultiConfig{
-			Span:      w.si.Span,
-			StatsTick: statsTicker.C,
+		return driver.MultiRun(ctx, d, w.proc, zctx, st.StaticSource(w.si, direction(w.dir), int(w.limits.SortMemMaxBytes)), driver.MultiConfig{
+			Span:            w.si.Span,
+			StatsTick:       statsTicker.C,
+			SortMemMaxBytes: int(w.limits.SortMemMaxBytes),
+			GroupByLimit:    int(w.limits.GroupByLimit),
 		})
 	default:
 		return fmt.Errorf("unknown storage type %T", st)
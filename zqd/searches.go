@@ -0,0 +1,128 @@
+package zqd
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/search"
+)
+
+// searchRegistry tracks the searches running on a Core so that they can be
+// listed and canceled.
+type searchRegistry struct {
+	mu       sync.Mutex
+	searches map[int64]*activeSearch
+}
+
+type activeSearch struct {
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	info api.SearchInfo
+}
+
+func newSearchRegistry() *searchRegistry {
+	return &searchRegistry{searches: make(map[int64]*activeSearch)}
+}
+
+// add registers a search and returns the context in which it must run.
+func (s *searchRegistry) add(ctx context.Context, info api.SearchInfo) (context.Context, *activeSearch) {
+	ctx, cancel := context.WithCancel(ctx)
+	as := &activeSearch{cancel: cancel, info: info}
+	s.mu.Lock()
+	s.searches[info.ID] = as
+	s.mu.Unlock()
+	return ctx, as
+}
+
+func (s *searchRegistry) remove(id int64) {
+	s.mu.Lock()
+	as, ok := s.searches[id]
+	delete(s.searches, id)
+	s.mu.Unlock()
+	if ok {
+		as.cancel()
+	}
+}
+
+func (s *searchRegistry) get(id int64) (*activeSearch, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	as, ok := s.searches[id]
+	return as, ok
+}
+
+func (s *searchRegistry) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.searches)
+}
+
+// list returns the searches sorted by ID.
+func (s *searchRegistry) list() []api.SearchInfo {
+	s.mu.Lock()
+	infos := make([]api.SearchInfo, 0, len(s.searches))
+	for _, as := range s.searches {
+		infos = append(infos, as.snapshot())
+	}
+	s.mu.Unlock()
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+func (a *activeSearch) snapshot() api.SearchInfo {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	info := a.info
+	info.Elapsed = nano.Now().SubTs(info.StartTime)
+	return info
+}
+
+// statsOutput is a search.Output that records the scanner statistics sent
+// by a search in its activeSearch.
+type statsOutput struct {
+	search.Output
+	search *activeSearch
+}
+
+func (s *statsOutput) SendControl(v interface{}) error {
+	if stats, ok := v.(api.SearchStats); ok {
+		s.search.mu.Lock()
+		s.search.info.ScannerStats = stats.ScannerStats
+		s.search.mu.Unlock()
+	}
+	return s.Output.SendControl(v)
+}
+
+// registerSearch registers a search described by req that writes to out.
+// It returns the search's ID, the context and output the search must use,
+// and a function to be called when the search finishes.
+func (c *Core) registerSearch(ctx context.Context, kind, user string, req api.SearchRequest, out search.Output) (int64, context.Context, search.Output, func()) {
+	id := c.getTaskID()
+	ctx, as := c.searches.add(ctx, api.SearchInfo{
+		ID:        id,
+		Kind:      kind,
+		Space:     req.Space,
+		User:      user,
+		Span:      req.Span,
+		StartTime: nano.Now(),
+	})
+	return id, ctx, &statsOutput{Output: out, search: as}, func() {
+		c.searches.remove(id)
+		c.metrics.observeSearch(kind, as.snapshot().ScannerStats)
+	}
+}
+
+// trackSearch registers a search made by request r as registerSearch does
+// and sends the search's ID to the client in the api.SearchIDHeader response
+// header.
+func (c *Core) trackSearch(ctx context.Context, w http.ResponseWriter, r *http.Request, kind string, req api.SearchRequest, out search.Output) (context.Context, search.Output, func()) {
+	id, ctx, out, done := c.registerSearch(ctx, kind, getUser(r.Context()), req, out)
+	w.Header().Set(api.SearchIDHeader, strconv.FormatInt(id, 10))
+	return ctx, out, done
+}
@@ -33,6 +33,10 @@ func (s *archiveSpace) Info(ctx context.Context) (api.SpaceInfo, error) {
 	return si, nil
 }
 
+func (s *archiveSpace) Path() iosrc.URI {
+	return s.path
+}
+
 func (s *archiveSpace) Name() string {
 	s.confMu.Lock()
 	defer s.confMu.Unlock()
@@ -52,6 +56,24 @@ func (s *archiveSpace) update(req api.SpacePutRequest) error {
 	return s.updateConfigWithLock(conf)
 }
 
+func (s *archiveSpace) Queries() ([]api.SavedQuery, error) {
+	s.confMu.Lock()
+	defer s.confMu.Unlock()
+	return s.conf.Queries, nil
+}
+
+func (s *archiveSpace) updateQueries(fn func([]api.SavedQuery) ([]api.SavedQuery, error)) error {
+	s.confMu.Lock()
+	defer s.confMu.Unlock()
+	queries, err := fn(s.conf.Queries)
+	if err != nil {
+		return err
+	}
+	conf := s.conf.clone()
+	conf.Queries = queries
+	return s.updateConfigWithLock(conf)
+}
+
 func (s *archiveSpace) updateConfigWithLock(conf config) error {
 	if err := writeConfig(s.path, conf); err != nil {
 		return err
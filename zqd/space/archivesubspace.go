@@ -61,6 +61,31 @@ func (s *archiveSubspace) delete(_ context.Context) error {
 	})
 }
 
+func (s *archiveSubspace) Queries() ([]api.SavedQuery, error) {
+	var queries []api.SavedQuery
+	err := s.findConfig(func(i int) error {
+		queries = s.parent.conf.Subspaces[i].Queries
+		return nil
+	})
+	return queries, err
+}
+
+func (s *archiveSubspace) updateQueries(fn func([]api.SavedQuery) ([]api.SavedQuery, error)) error {
+	return s.findConfig(func(i int) error {
+		queries, err := fn(s.parent.conf.Subspaces[i].Queries)
+		if err != nil {
+			return err
+		}
+		conf := s.parent.conf.clone()
+		conf.Subspaces[i].Queries = queries
+		return s.parent.updateConfigWithLock(conf)
+	})
+}
+
+func (s *archiveSubspace) Path() iosrc.URI {
+	return s.parent.Path()
+}
+
 func (s *archiveSubspace) Name() string {
 	var name string
 	err := s.findConfig(func(i int) error {
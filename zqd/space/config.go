@@ -33,6 +33,7 @@ type config struct {
 	DataURI   iosrc.URI        `json:"data_uri"`
 	Storage   storage.Config   `json:"storage"`
 	Subspaces []subspaceConfig `json:"subspaces"`
+	Queries   []api.SavedQuery `json:"queries,omitempty"`
 }
 
 type configV2 struct {
@@ -65,6 +66,7 @@ type subspaceConfig struct {
 	ID          api.SpaceID                `json:"id"`
 	Name        string                     `json:"name"`
 	OpenOptions storage.ArchiveOpenOptions `json:"open_options"`
+	Queries     []api.SavedQuery           `json:"queries,omitempty"`
 }
 
 func (c config) clone() config {
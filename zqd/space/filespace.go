@@ -42,6 +42,10 @@ func (s *fileSpace) dataURI() iosrc.URI {
 	return du
 }
 
+func (s *fileSpace) Path() iosrc.URI {
+	return s.path
+}
+
 func (s *fileSpace) Name() string {
 	s.confMu.Lock()
 	defer s.confMu.Unlock()
@@ -59,6 +63,24 @@ func (s *fileSpace) update(req api.SpacePutRequest) error {
 	return s.updateConfigWithLock(conf)
 }
 
+func (s *fileSpace) Queries() ([]api.SavedQuery, error) {
+	s.confMu.Lock()
+	defer s.confMu.Unlock()
+	return s.conf.Queries, nil
+}
+
+func (s *fileSpace) updateQueries(fn func([]api.SavedQuery) ([]api.SavedQuery, error)) error {
+	s.confMu.Lock()
+	defer s.confMu.Unlock()
+	queries, err := fn(s.conf.Queries)
+	if err != nil {
+		return err
+	}
+	conf := s.conf.clone()
+	conf.Queries = queries
+	return s.updateConfigWithLock(conf)
+}
+
 func (s *fileSpace) updateConfigWithLock(conf config) error {
 	if err := writeConfig(s.path, conf); err != nil {
 		return err
@@ -0,0 +1,100 @@
+package space
+
+import (
+	"sort"
+
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+)
+
+func validateQuery(q api.SavedQuery) error {
+	if q.Name == "" {
+		return zqe.E(zqe.Invalid, "query name must not be empty")
+	}
+	if !validSpaceName(q.Name) {
+		return zqe.E(zqe.Invalid, "query name may not contain '/' or non-printable characters")
+	}
+	if q.Query == "" {
+		return zqe.E(zqe.Invalid, "query must not be empty")
+	}
+	return nil
+}
+
+func queryIndex(queries []api.SavedQuery, name string) int {
+	for i, q := range queries {
+		if q.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetQuery returns the saved query of s named name.
+func GetQuery(s Space, name string) (api.SavedQuery, error) {
+	queries, err := s.Queries()
+	if err != nil {
+		return api.SavedQuery{}, err
+	}
+	i := queryIndex(queries, name)
+	if i == -1 {
+		return api.SavedQuery{}, zqe.E(zqe.NotFound, "query %q not found", name)
+	}
+	return queries[i], nil
+}
+
+// ListQueries returns the saved queries of s sorted by name.
+func ListQueries(s Space) ([]api.SavedQuery, error) {
+	queries, err := s.Queries()
+	if err != nil {
+		return nil, err
+	}
+	queries = append([]api.SavedQuery{}, queries...)
+	sort.Slice(queries, func(i, j int) bool { return queries[i].Name < queries[j].Name })
+	return queries, nil
+}
+
+// CreateQuery saves q in s.  It is an error if s already has a query with
+// the same name.
+func CreateQuery(s Space, q api.SavedQuery) error {
+	if err := validateQuery(q); err != nil {
+		return err
+	}
+	return s.updateQueries(func(queries []api.SavedQuery) ([]api.SavedQuery, error) {
+		if queryIndex(queries, q.Name) != -1 {
+			return nil, zqe.E(zqe.Conflict, "query %q already exists", q.Name)
+		}
+		return append(append([]api.SavedQuery{}, queries...), q), nil
+	})
+}
+
+// UpdateQuery replaces the saved query of s named name with q, which may
+// have a different name.
+func UpdateQuery(s Space, name string, q api.SavedQuery) error {
+	if err := validateQuery(q); err != nil {
+		return err
+	}
+	return s.updateQueries(func(queries []api.SavedQuery) ([]api.SavedQuery, error) {
+		i := queryIndex(queries, name)
+		if i == -1 {
+			return nil, zqe.E(zqe.NotFound, "query %q not found", name)
+		}
+		if j := queryIndex(queries, q.Name); j != -1 && j != i {
+			return nil, zqe.E(zqe.Conflict, "query %q already exists", q.Name)
+		}
+		queries = append([]api.SavedQuery{}, queries...)
+		queries[i] = q
+		return queries, nil
+	})
+}
+
+// DeleteQuery removes the saved query of s named name.
+func DeleteQuery(s Space, name string) error {
+	return s.updateQueries(func(queries []api.SavedQuery) ([]api.SavedQuery, error) {
+		i := queryIndex(queries, name)
+		if i == -1 {
+			return nil, zqe.E(zqe.NotFound, "query %q not found", name)
+		}
+		out := append([]api.SavedQuery{}, queries[:i]...)
+		return append(out, queries[i+1:]...), nil
+	})
+}
@@ -34,6 +34,14 @@ type Space interface {
 	Storage() storage.Storage
 	Info(context.Context) (api.SpaceInfo, error)
 
+	// Path returns the directory holding the space's configuration.  It is
+	// removed when the space is deleted.  Subspaces share the directory of
+	// their parent.
+	Path() iosrc.URI
+
+	// Queries returns the space's saved queries.
+	Queries() ([]api.SavedQuery, error)
+
 	// StartOp is called to register an operation is in progress; the
 	// returned cancel function must be called when the operation is done.
 	StartOp(context.Context) (context.Context, context.CancelFunc, error)
@@ -43,6 +51,9 @@ type Space interface {
 	// Intended to be called from Manager.Delete().
 	delete(context.Context) error
 	update(api.SpacePutRequest) error
+	// updateQueries replaces the space's saved queries with the result of
+	// fn, which must not modify its argument.
+	updateQueries(fn func([]api.SavedQuery) ([]api.SavedQuery, error)) error
 }
 
 // PcapSpace denotes that a space is capable of storing pcap files and
@@ -221,11 +232,12 @@ func (s *spaceBase) Info(ctx context.Context) (api.SpaceInfo, error) {
 				s.logger.Error("Error getting pcap store summary", zap.Error(err))
 				return api.SpaceInfo{}, err
 			}
-			s.logger.Info("Pcap not found", zap.String("pcap_uri", s.pcapstore.PcapURI().String()))
+			s.logger.Info("Pcap not found", zap.Any("pcap_uris", s.pcapstore.PcapURIs()))
 		} else {
 			spaceInfo.PcapSize = pcapinfo.PcapSize
 			spaceInfo.PcapSupport = true
 			spaceInfo.PcapPath = pcapinfo.PcapURI
+			spaceInfo.PcapPaths = pcapinfo.PcapURIs
 			if span == nil {
 				span = &pcapinfo.Span
 			} else {
@@ -20,6 +20,7 @@ func Load(ctx context.Context, path iosrc.URI, cfg *storage.ArchiveConfig) (*Sto
 	co := &archive.CreateOptions{}
 	if cfg != nil && cfg.CreateOptions != nil {
 		co.LogSizeThreshold = cfg.CreateOptions.LogSizeThreshold
+		co.DataFormat = cfg.CreateOptions.DataFormat
 	}
 	oo := &archive.OpenOptions{}
 	if cfg != nil && cfg.OpenOptions != nil {
@@ -48,12 +49,21 @@ func (s *Storage) NativeDirection() zbuf.Direction {
 	return s.ark.DataSortDirection
 }
 
-func (s *Storage) MultiSource() driver.MultiSource {
-	return archive.NewMultiSource(s.ark, nil)
+// MultiSource returns a source of the archive's records ordered by time in
+// direction dir, sorting them with up to sortMemMaxBytes of memory when dir
+// is not the archive's native direction.
+func (s *Storage) MultiSource(dir zbuf.Direction, sortMemMaxBytes int) driver.MultiSource {
+	return archive.NewMultiSourceDir(s.ark, dir, sortMemMaxBytes)
 }
 
-func (s *Storage) StaticSource(si archive.SpanInfo) driver.MultiSource {
-	return archive.NewStaticSource(s.ark, si)
+func (s *Storage) StaticSource(si archive.SpanInfo, dir zbuf.Direction, sortMemMaxBytes int) driver.MultiSource {
+	return archive.NewStaticSource(s.ark, si, dir, sortMemMaxBytes)
+}
+
+// SpanWalk calls v with each SpanInfo of the archive within span, in time
+// order given by dir.
+func (s *Storage) SpanWalk(ctx context.Context, span nano.Span, dir zbuf.Direction, v func(archive.SpanInfo) error) error {
+	return archive.SpanWalk(ctx, s.ark, span, dir, v)
 }
 
 func (s *Storage) Summary(ctx context.Context) (storage.Summary, error) {
@@ -109,6 +119,43 @@ func (s *Storage) IndexCreate(ctx context.Context, req api.IndexPostRequest) err
 	return archive.IndexDirTree(ctx, s.ark, rules, inputFile, nil)
 }
 
+func (s *Storage) IndexDefCreate(ctx context.Context, req api.IndexDef) error {
+	def, err := archive.NewIndexDef(req.Name, req.Pattern, req.Keys, req.ZQL, req.Framesize)
+	if err != nil {
+		return err
+	}
+	if err := archive.AddIndexDef(ctx, s.ark, *def); err != nil {
+		return err
+	}
+	rule, err := def.Rule()
+	if err != nil {
+		return err
+	}
+	return archive.IndexDirTree(ctx, s.ark, []archive.Rule{*rule}, "_", nil)
+}
+
+func (s *Storage) IndexDefList(ctx context.Context) ([]api.IndexDef, error) {
+	defs, err := archive.ReadIndexDefs(ctx, s.ark)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.IndexDef, 0, len(defs))
+	for _, d := range defs {
+		out = append(out, api.IndexDef{
+			Name:      d.Name,
+			Pattern:   d.Pattern,
+			Keys:      d.Keys,
+			ZQL:       d.ZQL,
+			Framesize: d.Framesize,
+		})
+	}
+	return out, nil
+}
+
+func (s *Storage) IndexDefDrop(ctx context.Context, name string) error {
+	return archive.DropIndexDef(ctx, s.ark, name, nil)
+}
+
 func (s *Storage) IndexSearch(ctx context.Context, zctx *resolver.Context, query archive.IndexQuery) (zbuf.ReadCloser, error) {
 	return archive.FindReadCloser(ctx, zctx, s.ark, query, archive.AddPath(archive.DefaultAddPathField, false))
 }
@@ -116,3 +163,7 @@ func (s *Storage) IndexSearch(ctx context.Context, zctx *resolver.Context, query
 func (s *Storage) ArchiveStat(ctx context.Context, zctx *resolver.Context) (zbuf.ReadCloser, error) {
 	return archive.Stat(ctx, zctx, s.ark)
 }
+
+func (s *Storage) Delete(ctx context.Context, filterExpr ast.BooleanExpr) (int64, error) {
+	return archive.Delete(ctx, s.ark, filterExpr, nil)
+}
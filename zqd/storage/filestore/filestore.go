@@ -117,7 +117,26 @@ func (s *Storage) Write(ctx context.Context, zctx *resolver.Context, zr zbuf.Rea
 		return zqe.E(zqe.Conflict, ErrWriteInProgress)
 	}
 	defer s.wsem.Release(1)
+	return s.write(ctx, zctx, zr)
+}
+
+// Merge adds the records of zr to those already in storage.  It waits for
+// any ongoing write operation and holds off others until the merged records
+// are written, so no write is lost between reading and replacing the data.
+func (s *Storage) Merge(ctx context.Context, zctx *resolver.Context, zr zbuf.Reader) error {
+	if err := s.wsem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer s.wsem.Release(1)
+	prior, err := s.Open(ctx, zctx, nano.MaxSpan)
+	if err != nil {
+		return err
+	}
+	defer prior.Close()
+	return s.write(ctx, zctx, zbuf.NewCombiner([]zbuf.Reader{prior, zr}, zbuf.RecordCompare(s.NativeDirection())))
+}
 
+func (s *Storage) write(ctx context.Context, zctx *resolver.Context, zr zbuf.Reader) error {
 	spanWriter := &spanWriter{}
 	if err := fs.ReplaceFile(s.join(allZngFile), 0600, func(w io.Writer) error {
 		fileWriter := zngio.NewWriter(bufwriter.New(zio.NopCloser(w)), zngio.WriterOpts{
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/stretchr/testify/require"
@@ -78,3 +80,43 @@ func TestWriteNoRecords(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sp, sum.Span)
 }
+
+func TestMerge(t *testing.T) {
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+	u, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+	store, err := Load(u)
+	require.NoError(t, err)
+
+	zctx := resolver.NewContext()
+	const prior = `
+#0:record[ts:time]
+0:[1;]
+0:[3;]`
+	err = store.Write(context.Background(), zctx, tzngio.NewReader(strings.NewReader(prior), zctx))
+	require.NoError(t, err)
+	err = store.Merge(context.Background(), zctx, tzngio.NewReader(strings.NewReader("#0:record[ts:time]\n0:[2;]"), zctx))
+	require.NoError(t, err)
+
+	zr, err := store.Open(context.Background(), zctx, nano.MaxSpan)
+	require.NoError(t, err)
+	defer zr.Close()
+	var ts []nano.Ts
+	for {
+		rec, err := zr.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		ts = append(ts, rec.Ts())
+	}
+	require.Equal(t, []nano.Ts{3e9, 2e9, 1e9}, ts)
+
+	sum, err := store.Summary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, nano.NewSpanTs(1e9, 3e9+1), sum.Span)
+}
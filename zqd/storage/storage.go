@@ -51,6 +51,7 @@ type ArchiveOpenOptions struct {
 
 type ArchiveCreateOptions struct {
 	LogSizeThreshold *int64 `json:"log_size_threshold,omitempty"`
+	DataFormat       string `json:"data_format,omitempty"`
 }
 
 type Summary struct {
@@ -21,6 +21,10 @@ const (
 	Exists
 	Invalid
 	NotFound
+	Unauthorized
+	Forbidden
+	LimitExceeded
+	Unavailable
 )
 
 func (k Kind) String() string {
@@ -35,6 +39,14 @@ func (k Kind) String() string {
 		return "item already exists"
 	case NotFound:
 		return "item does not exist"
+	case Unauthorized:
+		return "unauthorized"
+	case Forbidden:
+		return "forbidden"
+	case LimitExceeded:
+		return "resource limit exceeded"
+	case Unavailable:
+		return "service unavailable"
 	}
 	return "unknown error kind"
 }
@@ -132,11 +144,15 @@ func IsKind(err error, k Kind) bool {
 	return errors.As(err, &zerr) && zerr.Kind == k
 }
 
-func IsOther(err error) bool    { return IsKind(err, Other) }
-func IsConflict(err error) bool { return IsKind(err, Conflict) }
-func IsExists(err error) bool   { return IsKind(err, Exists) }
-func IsInvalid(err error) bool  { return IsKind(err, Invalid) }
-func IsNotFound(err error) bool { return IsKind(err, NotFound) }
+func IsOther(err error) bool         { return IsKind(err, Other) }
+func IsConflict(err error) bool      { return IsKind(err, Conflict) }
+func IsExists(err error) bool        { return IsKind(err, Exists) }
+func IsInvalid(err error) bool       { return IsKind(err, Invalid) }
+func IsNotFound(err error) bool      { return IsKind(err, NotFound) }
+func IsUnauthorized(err error) bool  { return IsKind(err, Unauthorized) }
+func IsForbidden(err error) bool     { return IsKind(err, Forbidden) }
+func IsLimitExceeded(err error) bool { return IsKind(err, LimitExceeded) }
+func IsUnavailable(err error) bool   { return IsKind(err, Unavailable) }
 
 func ErrOther(args ...interface{}) error    { return errKind(Other, args) }
 func ErrConflict(args ...interface{}) error { return errKind(Conflict, args) }
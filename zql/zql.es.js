@@ -638,273 +638,338 @@ function peg$parse(input, options) {
       peg$c183 = function() {
             return {"op": "FuseProc"}
         },
-      peg$c184 = function(f, e) {
+      peg$c184 = "lookup",
+      peg$c185 = peg$literalExpectation("lookup", true),
+      peg$c186 = function(args, key) {
+            let argm = args;
+            let proc = {"op": "LookupProc", "key": key, "table": "", "index": false, "miss": "keep"};
+            if ( "file" in argm) {
+              proc["table"] = argm["file"];
+            }
+            if ( "index" in argm) {
+              proc["table"] = argm["index"];
+              proc["index"] = true;
+            }
+            if ( "key" in argm) {
+              proc["key_field"] = argm["key"];
+            }
+            if ( "miss" in argm) {
+              proc["miss"] = argm["miss"];
+            }
+            return proc
+          },
+      peg$c187 = "-file",
+      peg$c188 = peg$literalExpectation("-file", false),
+      peg$c189 = function(path) { return {"name": "file", "value": path} },
+      peg$c190 = "-index",
+      peg$c191 = peg$literalExpectation("-index", false),
+      peg$c192 = function(path) { return {"name": "index", "value": path} },
+      peg$c193 = "-key",
+      peg$c194 = peg$literalExpectation("-key", false),
+      peg$c195 = function(field) { return {"name": "key", "value": field} },
+      peg$c196 = "-miss",
+      peg$c197 = peg$literalExpectation("-miss", false),
+      peg$c198 = "drop",
+      peg$c199 = peg$literalExpectation("drop", false),
+      peg$c200 = "keep",
+      peg$c201 = peg$literalExpectation("keep", false),
+      peg$c202 = function(miss) { return {"name": "miss", "value": miss} },
+      peg$c203 = /^[ \t\r\n|;()]/,
+      peg$c204 = peg$classExpectation([" ", "\t", "\r", "\n", "|", ";", "(", ")"], false, false),
+      peg$c205 = "\"",
+      peg$c206 = peg$literalExpectation("\"", false),
+      peg$c207 = peg$anyExpectation(),
+      peg$c208 = "window",
+      peg$c209 = peg$literalExpectation("window", true),
+      peg$c210 = function(args, reducers, k) { return k },
+      peg$c211 = function(args, reducers, keys) {
+            let argm = args;
+            let proc = {"op": "WindowProc", "keys": keys, "reducers": reducers};
+            if ( "rows" in argm) {
+              proc["rows"] = argm["rows"];
+            }
+            if ( "range" in argm) {
+              proc["range"] = argm["range"];
+            }
+            return proc
+          },
+      peg$c212 = "-rows",
+      peg$c213 = peg$literalExpectation("-rows", false),
+      peg$c214 = function(n) { return {"name": "rows", "value": n} },
+      peg$c215 = "-range",
+      peg$c216 = peg$literalExpectation("-range", false),
+      peg$c217 = function(dur) { return {"name": "range", "value": dur} },
+      peg$c218 = function(f, e) {
             return {"target": f, "expression": e}
           },
-      peg$c185 = function(l, r) {
+      peg$c219 = ".",
+      peg$c220 = peg$literalExpectation(".", false),
+      peg$c221 = function(l, r) {
             return {"target": l, "source": r}
           },
-      peg$c186 = "?",
-      peg$c187 = peg$literalExpectation("?", false),
-      peg$c188 = ":",
-      peg$c189 = peg$literalExpectation(":", false),
-      peg$c190 = function(condition, thenClause, elseClause) {
+      peg$c222 = "{",
+      peg$c223 = peg$literalExpectation("{", false),
+      peg$c224 = function(first, f) { return f },
+      peg$c225 = "}",
+      peg$c226 = peg$literalExpectation("}", false),
+      peg$c227 = function(first, rest) {
+            return {"op": "RecordExpr", "fields": [first, ... rest]}
+          },
+      peg$c228 = ":",
+      peg$c229 = peg$literalExpectation(":", false),
+      peg$c230 = "[",
+      peg$c231 = peg$literalExpectation("[", false),
+      peg$c232 = function(first, e) { return e },
+      peg$c233 = "]",
+      peg$c234 = peg$literalExpectation("]", false),
+      peg$c235 = function(first, rest) {
+            return {"op": "ArrayExpr", "exprs": [first, ... rest]}
+          },
+      peg$c236 = "?",
+      peg$c237 = peg$literalExpectation("?", false),
+      peg$c238 = function(condition, thenClause, elseClause) {
           return {"op": "ConditionalExpr", "condition": condition, "then": thenClause, "else": elseClause}
         },
-      peg$c191 = function(first, op, expr) { return [op, expr] },
-      peg$c192 = function(first, rest) {
+      peg$c239 = function(first, op, expr) { return [op, expr] },
+      peg$c240 = function(first, rest) {
               return makeBinaryExprChain(first, rest)
           },
-      peg$c193 = function(first, comp, expr) { return [comp, expr] },
-      peg$c194 = "=~",
-      peg$c195 = peg$literalExpectation("=~", false),
-      peg$c196 = "!~",
-      peg$c197 = peg$literalExpectation("!~", false),
-      peg$c198 = "!=",
-      peg$c199 = peg$literalExpectation("!=", false),
-      peg$c200 = peg$literalExpectation("in", false),
-      peg$c201 = "<=",
-      peg$c202 = peg$literalExpectation("<=", false),
-      peg$c203 = "<",
-      peg$c204 = peg$literalExpectation("<", false),
-      peg$c205 = ">=",
-      peg$c206 = peg$literalExpectation(">=", false),
-      peg$c207 = ">",
-      peg$c208 = peg$literalExpectation(">", false),
-      peg$c209 = "+",
-      peg$c210 = peg$literalExpectation("+", false),
-      peg$c211 = "/",
-      peg$c212 = peg$literalExpectation("/", false),
-      peg$c213 = function(e) {
+      peg$c241 = function(first, comp, expr) { return [comp, expr] },
+      peg$c242 = "=~",
+      peg$c243 = peg$literalExpectation("=~", false),
+      peg$c244 = "!~",
+      peg$c245 = peg$literalExpectation("!~", false),
+      peg$c246 = "!=",
+      peg$c247 = peg$literalExpectation("!=", false),
+      peg$c248 = peg$literalExpectation("in", false),
+      peg$c249 = "<=",
+      peg$c250 = peg$literalExpectation("<=", false),
+      peg$c251 = "<",
+      peg$c252 = peg$literalExpectation("<", false),
+      peg$c253 = ">=",
+      peg$c254 = peg$literalExpectation(">=", false),
+      peg$c255 = ">",
+      peg$c256 = peg$literalExpectation(">", false),
+      peg$c257 = "+",
+      peg$c258 = peg$literalExpectation("+", false),
+      peg$c259 = "/",
+      peg$c260 = peg$literalExpectation("/", false),
+      peg$c261 = function(e) {
               return {"op": "UnaryExpr", "operator": "!", "operand": e}
           },
-      peg$c214 = function(e, ct) {
+      peg$c262 = function(e, ct) {
             return {"op": "CastExpr", "expr": e, "type": ct}
         },
-      peg$c215 = "bytes",
-      peg$c216 = peg$literalExpectation("bytes", false),
-      peg$c217 = "uint8",
-      peg$c218 = peg$literalExpectation("uint8", false),
-      peg$c219 = "uint16",
-      peg$c220 = peg$literalExpectation("uint16", false),
-      peg$c221 = "uint32",
-      peg$c222 = peg$literalExpectation("uint32", false),
-      peg$c223 = "uint64",
-      peg$c224 = peg$literalExpectation("uint64", false),
-      peg$c225 = "int8",
-      peg$c226 = peg$literalExpectation("int8", false),
-      peg$c227 = "int16",
-      peg$c228 = peg$literalExpectation("int16", false),
-      peg$c229 = "int32",
-      peg$c230 = peg$literalExpectation("int32", false),
-      peg$c231 = "int64",
-      peg$c232 = peg$literalExpectation("int64", false),
-      peg$c233 = "duration",
-      peg$c234 = peg$literalExpectation("duration", false),
-      peg$c235 = "time",
-      peg$c236 = peg$literalExpectation("time", false),
-      peg$c237 = "float64",
-      peg$c238 = peg$literalExpectation("float64", false),
-      peg$c239 = "bool",
-      peg$c240 = peg$literalExpectation("bool", false),
-      peg$c241 = "string",
-      peg$c242 = peg$literalExpectation("string", false),
-      peg$c243 = "bstring",
-      peg$c244 = peg$literalExpectation("bstring", false),
-      peg$c245 = "ip",
-      peg$c246 = peg$literalExpectation("ip", false),
-      peg$c247 = "net",
-      peg$c248 = peg$literalExpectation("net", false),
-      peg$c249 = "type",
-      peg$c250 = peg$literalExpectation("type", false),
-      peg$c251 = "error",
-      peg$c252 = peg$literalExpectation("error", false),
-      peg$c253 = function(fn, args) {
+      peg$c263 = "bytes",
+      peg$c264 = peg$literalExpectation("bytes", false),
+      peg$c265 = "uint8",
+      peg$c266 = peg$literalExpectation("uint8", false),
+      peg$c267 = "uint16",
+      peg$c268 = peg$literalExpectation("uint16", false),
+      peg$c269 = "uint32",
+      peg$c270 = peg$literalExpectation("uint32", false),
+      peg$c271 = "uint64",
+      peg$c272 = peg$literalExpectation("uint64", false),
+      peg$c273 = "int8",
+      peg$c274 = peg$literalExpectation("int8", false),
+      peg$c275 = "int16",
+      peg$c276 = peg$literalExpectation("int16", false),
+      peg$c277 = "int32",
+      peg$c278 = peg$literalExpectation("int32", false),
+      peg$c279 = "int64",
+      peg$c280 = peg$literalExpectation("int64", false),
+      peg$c281 = "duration",
+      peg$c282 = peg$literalExpectation("duration", false),
+      peg$c283 = "time",
+      peg$c284 = peg$literalExpectation("time", false),
+      peg$c285 = "float64",
+      peg$c286 = peg$literalExpectation("float64", false),
+      peg$c287 = "bool",
+      peg$c288 = peg$literalExpectation("bool", false),
+      peg$c289 = "string",
+      peg$c290 = peg$literalExpectation("string", false),
+      peg$c291 = "bstring",
+      peg$c292 = peg$literalExpectation("bstring", false),
+      peg$c293 = "ip",
+      peg$c294 = peg$literalExpectation("ip", false),
+      peg$c295 = "net",
+      peg$c296 = peg$literalExpectation("net", false),
+      peg$c297 = "type",
+      peg$c298 = peg$literalExpectation("type", false),
+      peg$c299 = "error",
+      peg$c300 = peg$literalExpectation("error", false),
+      peg$c301 = function(fn, args) {
               return {"op": "FunctionCall", "function": fn, "args": args}
           },
-      peg$c254 = /^[A-Za-z]/,
-      peg$c255 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
-      peg$c256 = /^[.0-9]/,
-      peg$c257 = peg$classExpectation([".", ["0", "9"]], false, false),
-      peg$c258 = function(first, e) { return e },
-      peg$c259 = function(first, rest) {
+      peg$c302 = /^[A-Za-z]/,
+      peg$c303 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
+      peg$c304 = /^[.0-9]/,
+      peg$c305 = peg$classExpectation([".", ["0", "9"]], false, false),
+      peg$c306 = function(first, rest) {
             return [first, ... rest]
         },
-      peg$c260 = function() { return [] },
-      peg$c261 = function(base, derefs) {
+      peg$c307 = function() { return [] },
+      peg$c308 = function(base, derefs) {
               return makeBinaryExprChain(base, derefs)
          },
-      peg$c262 = "[",
-      peg$c263 = peg$literalExpectation("[", false),
-      peg$c264 = "]",
-      peg$c265 = peg$literalExpectation("]", false),
-      peg$c266 = function(index) {
+      peg$c309 = function(index) {
           return ["[", index]
         },
-      peg$c267 = ".",
-      peg$c268 = peg$literalExpectation(".", false),
-      peg$c269 = function(field) {
+      peg$c310 = function(field) {
           return [".", field]
         },
-      peg$c270 = peg$literalExpectation("and", false),
-      peg$c271 = "seconds",
-      peg$c272 = peg$literalExpectation("seconds", false),
-      peg$c273 = "second",
-      peg$c274 = peg$literalExpectation("second", false),
-      peg$c275 = "secs",
-      peg$c276 = peg$literalExpectation("secs", false),
-      peg$c277 = "sec",
-      peg$c278 = peg$literalExpectation("sec", false),
-      peg$c279 = "s",
-      peg$c280 = peg$literalExpectation("s", false),
-      peg$c281 = "minutes",
-      peg$c282 = peg$literalExpectation("minutes", false),
-      peg$c283 = "minute",
-      peg$c284 = peg$literalExpectation("minute", false),
-      peg$c285 = "mins",
-      peg$c286 = peg$literalExpectation("mins", false),
-      peg$c287 = peg$literalExpectation("min", false),
-      peg$c288 = "m",
-      peg$c289 = peg$literalExpectation("m", false),
-      peg$c290 = "hours",
-      peg$c291 = peg$literalExpectation("hours", false),
-      peg$c292 = "hrs",
-      peg$c293 = peg$literalExpectation("hrs", false),
-      peg$c294 = "hr",
-      peg$c295 = peg$literalExpectation("hr", false),
-      peg$c296 = "h",
-      peg$c297 = peg$literalExpectation("h", false),
-      peg$c298 = "hour",
-      peg$c299 = peg$literalExpectation("hour", false),
-      peg$c300 = "days",
-      peg$c301 = peg$literalExpectation("days", false),
-      peg$c302 = "day",
-      peg$c303 = peg$literalExpectation("day", false),
-      peg$c304 = "d",
-      peg$c305 = peg$literalExpectation("d", false),
-      peg$c306 = "weeks",
-      peg$c307 = peg$literalExpectation("weeks", false),
-      peg$c308 = "week",
-      peg$c309 = peg$literalExpectation("week", false),
-      peg$c310 = "wks",
-      peg$c311 = peg$literalExpectation("wks", false),
-      peg$c312 = "wk",
-      peg$c313 = peg$literalExpectation("wk", false),
-      peg$c314 = "w",
-      peg$c315 = peg$literalExpectation("w", false),
-      peg$c316 = function() { return {"type": "Duration", "seconds": 1} },
-      peg$c317 = function(num) { return {"type": "Duration", "seconds": num} },
-      peg$c318 = function() { return {"type": "Duration", "seconds": 60} },
-      peg$c319 = function(num) { return {"type": "Duration", "seconds": num*60} },
-      peg$c320 = function() { return {"type": "Duration", "seconds": 3600} },
-      peg$c321 = function(num) { return {"type": "Duration", "seconds": num*3600} },
-      peg$c322 = function() { return {"type": "Duration", "seconds": 3600*24} },
-      peg$c323 = function(num) { return {"type": "Duration", "seconds": (num*3600*24)} },
-      peg$c324 = function() { return {"type": "Duration", "seconds": 3600*24*7} },
-      peg$c325 = function(num) { return {"type": "Duration", "seconds": num*3600*24*7} },
-      peg$c326 = function(a) { return text() },
-      peg$c327 = function(a, b) {
+      peg$c311 = peg$literalExpectation("and", false),
+      peg$c312 = "seconds",
+      peg$c313 = peg$literalExpectation("seconds", false),
+      peg$c314 = "second",
+      peg$c315 = peg$literalExpectation("second", false),
+      peg$c316 = "secs",
+      peg$c317 = peg$literalExpectation("secs", false),
+      peg$c318 = "sec",
+      peg$c319 = peg$literalExpectation("sec", false),
+      peg$c320 = "s",
+      peg$c321 = peg$literalExpectation("s", false),
+      peg$c322 = "minutes",
+      peg$c323 = peg$literalExpectation("minutes", false),
+      peg$c324 = "minute",
+      peg$c325 = peg$literalExpectation("minute", false),
+      peg$c326 = "mins",
+      peg$c327 = peg$literalExpectation("mins", false),
+      peg$c328 = peg$literalExpectation("min", false),
+      peg$c329 = "m",
+      peg$c330 = peg$literalExpectation("m", false),
+      peg$c331 = "hours",
+      peg$c332 = peg$literalExpectation("hours", false),
+      peg$c333 = "hrs",
+      peg$c334 = peg$literalExpectation("hrs", false),
+      peg$c335 = "hr",
+      peg$c336 = peg$literalExpectation("hr", false),
+      peg$c337 = "h",
+      peg$c338 = peg$literalExpectation("h", false),
+      peg$c339 = "hour",
+      peg$c340 = peg$literalExpectation("hour", false),
+      peg$c341 = "days",
+      peg$c342 = peg$literalExpectation("days", false),
+      peg$c343 = "day",
+      peg$c344 = peg$literalExpectation("day", false),
+      peg$c345 = "d",
+      peg$c346 = peg$literalExpectation("d", false),
+      peg$c347 = "weeks",
+      peg$c348 = peg$literalExpectation("weeks", false),
+      peg$c349 = "week",
+      peg$c350 = peg$literalExpectation("week", false),
+      peg$c351 = "wks",
+      peg$c352 = peg$literalExpectation("wks", false),
+      peg$c353 = "wk",
+      peg$c354 = peg$literalExpectation("wk", false),
+      peg$c355 = "w",
+      peg$c356 = peg$literalExpectation("w", false),
+      peg$c357 = function() { return {"type": "Duration", "seconds": 1} },
+      peg$c358 = function(num) { return {"type": "Duration", "seconds": num} },
+      peg$c359 = function() { return {"type": "Duration", "seconds": 60} },
+      peg$c360 = function(num) { return {"type": "Duration", "seconds": num*60} },
+      peg$c361 = function() { return {"type": "Duration", "seconds": 3600} },
+      peg$c362 = function(num) { return {"type": "Duration", "seconds": num*3600} },
+      peg$c363 = function() { return {"type": "Duration", "seconds": 3600*24} },
+      peg$c364 = function(num) { return {"type": "Duration", "seconds": (num*3600*24)} },
+      peg$c365 = function() { return {"type": "Duration", "seconds": 3600*24*7} },
+      peg$c366 = function(num) { return {"type": "Duration", "seconds": num*3600*24*7} },
+      peg$c367 = function(a) { return text() },
+      peg$c368 = function(a, b) {
             return joinChars(a) + b
           },
-      peg$c328 = "::",
-      peg$c329 = peg$literalExpectation("::", false),
-      peg$c330 = function(a, b, d, e) {
+      peg$c369 = "::",
+      peg$c370 = peg$literalExpectation("::", false),
+      peg$c371 = function(a, b, d, e) {
             return a + joinChars(b) + "::" + joinChars(d) + e
           },
-      peg$c331 = function(a, b) {
+      peg$c372 = function(a, b) {
             return "::" + joinChars(a) + b
           },
-      peg$c332 = function(a, b) {
+      peg$c373 = function(a, b) {
             return a + joinChars(b) + "::"
           },
-      peg$c333 = function() {
+      peg$c374 = function() {
             return "::"
           },
-      peg$c334 = function(v) { return ":" + v },
-      peg$c335 = function(v) { return v + ":" },
-      peg$c336 = function(a, m) {
+      peg$c375 = function(v) { return ":" + v },
+      peg$c376 = function(v) { return v + ":" },
+      peg$c377 = function(a, m) {
             return a + "/" + m.toString();
           },
-      peg$c337 = function(a, m) {
+      peg$c378 = function(a, m) {
             return a + "/" + m;
           },
-      peg$c338 = function(s) { return parseInt(s) },
-      peg$c339 = /^[+\-]/,
-      peg$c340 = peg$classExpectation(["+", "-"], false, false),
-      peg$c342 = function() {
+      peg$c379 = function(s) { return parseInt(s) },
+      peg$c380 = /^[+\-]/,
+      peg$c381 = peg$classExpectation(["+", "-"], false, false),
+      peg$c383 = function() {
             return text()
           },
-      peg$c343 = "0",
-      peg$c344 = peg$literalExpectation("0", false),
-      peg$c345 = /^[1-9]/,
-      peg$c346 = peg$classExpectation([["1", "9"]], false, false),
-      peg$c347 = "e",
-      peg$c348 = peg$literalExpectation("e", true),
-      peg$c349 = function(chars) { return text() },
-      peg$c350 = /^[0-9a-fA-F]/,
-      peg$c351 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
-      peg$c352 = function(chars) { return joinChars(chars) },
-      peg$c353 = "\\",
-      peg$c354 = peg$literalExpectation("\\", false),
-      peg$c355 = /^[\0-\x1F\\(),!><="|';:]/,
-      peg$c356 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";", ":"], false, false),
-      peg$c357 = peg$anyExpectation(),
-      peg$c358 = "\"",
-      peg$c359 = peg$literalExpectation("\"", false),
-      peg$c360 = function(v) { return joinChars(v) },
-      peg$c361 = "'",
-      peg$c362 = peg$literalExpectation("'", false),
-      peg$c363 = "x",
-      peg$c364 = peg$literalExpectation("x", false),
-      peg$c365 = function() { return "\\" + text() },
-      peg$c366 = "b",
-      peg$c367 = peg$literalExpectation("b", false),
-      peg$c368 = function() { return "\b" },
-      peg$c369 = "f",
-      peg$c370 = peg$literalExpectation("f", false),
-      peg$c371 = function() { return "\f" },
-      peg$c372 = "n",
-      peg$c373 = peg$literalExpectation("n", false),
-      peg$c374 = function() { return "\n" },
-      peg$c375 = "r",
-      peg$c376 = peg$literalExpectation("r", false),
-      peg$c377 = function() { return "\r" },
-      peg$c378 = "t",
-      peg$c379 = peg$literalExpectation("t", false),
-      peg$c380 = function() { return "\t" },
-      peg$c381 = "v",
-      peg$c382 = peg$literalExpectation("v", false),
-      peg$c383 = function() { return "\v" },
-      peg$c384 = function() { return "=" },
-      peg$c385 = function() { return "\\*" },
-      peg$c386 = "u",
-      peg$c387 = peg$literalExpectation("u", false),
-      peg$c388 = function(chars) {
+      peg$c384 = "0",
+      peg$c385 = peg$literalExpectation("0", false),
+      peg$c386 = /^[1-9]/,
+      peg$c387 = peg$classExpectation([["1", "9"]], false, false),
+      peg$c388 = "e",
+      peg$c389 = peg$literalExpectation("e", true),
+      peg$c390 = function(chars) { return text() },
+      peg$c391 = /^[0-9a-fA-F]/,
+      peg$c392 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
+      peg$c393 = function(chars) { return joinChars(chars) },
+      peg$c394 = "\\",
+      peg$c395 = peg$literalExpectation("\\", false),
+      peg$c396 = /^[\0-\x1F\\(),!><="|';:]/,
+      peg$c397 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";", ":"], false, false),
+      peg$c398 = function(v) { return joinChars(v) },
+      peg$c399 = "'",
+      peg$c400 = peg$literalExpectation("'", false),
+      peg$c401 = "x",
+      peg$c402 = peg$literalExpectation("x", false),
+      peg$c403 = function() { return "\\" + text() },
+      peg$c404 = "b",
+      peg$c405 = peg$literalExpectation("b", false),
+      peg$c406 = function() { return "\b" },
+      peg$c407 = "f",
+      peg$c408 = peg$literalExpectation("f", false),
+      peg$c409 = function() { return "\f" },
+      peg$c410 = "n",
+      peg$c411 = peg$literalExpectation("n", false),
+      peg$c412 = function() { return "\n" },
+      peg$c413 = "r",
+      peg$c414 = peg$literalExpectation("r", false),
+      peg$c415 = function() { return "\r" },
+      peg$c416 = "t",
+      peg$c417 = peg$literalExpectation("t", false),
+      peg$c418 = function() { return "\t" },
+      peg$c419 = "v",
+      peg$c420 = peg$literalExpectation("v", false),
+      peg$c421 = function() { return "\v" },
+      peg$c422 = function() { return "=" },
+      peg$c423 = function() { return "\\*" },
+      peg$c424 = "u",
+      peg$c425 = peg$literalExpectation("u", false),
+      peg$c426 = function(chars) {
             return makeUnicodeChar(chars)
           },
-      peg$c389 = "{",
-      peg$c390 = peg$literalExpectation("{", false),
-      peg$c391 = "}",
-      peg$c392 = peg$literalExpectation("}", false),
-      peg$c393 = /^[^\/\\]/,
-      peg$c394 = peg$classExpectation(["/", "\\"], true, false),
-      peg$c395 = "\\/",
-      peg$c396 = peg$literalExpectation("\\/", false),
-      peg$c397 = /^[\0-\x1F\\]/,
-      peg$c398 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
-      peg$c399 = "\t",
-      peg$c400 = peg$literalExpectation("\t", false),
-      peg$c401 = "\x0B",
-      peg$c402 = peg$literalExpectation("\x0B", false),
-      peg$c403 = "\f",
-      peg$c404 = peg$literalExpectation("\f", false),
-      peg$c405 = " ",
-      peg$c406 = peg$literalExpectation(" ", false),
-      peg$c407 = "\xA0",
-      peg$c408 = peg$literalExpectation("\xA0", false),
-      peg$c409 = "\uFEFF",
-      peg$c410 = peg$literalExpectation("\uFEFF", false),
-      peg$c411 = peg$otherExpectation("whitespace"),
+      peg$c427 = /^[^\/\\]/,
+      peg$c428 = peg$classExpectation(["/", "\\"], true, false),
+      peg$c429 = "\\/",
+      peg$c430 = peg$literalExpectation("\\/", false),
+      peg$c431 = /^[\0-\x1F\\]/,
+      peg$c432 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
+      peg$c433 = "\t",
+      peg$c434 = peg$literalExpectation("\t", false),
+      peg$c435 = "\x0B",
+      peg$c436 = peg$literalExpectation("\x0B", false),
+      peg$c437 = "\f",
+      peg$c438 = peg$literalExpectation("\f", false),
+      peg$c439 = " ",
+      peg$c440 = peg$literalExpectation(" ", false),
+      peg$c441 = "\xA0",
+      peg$c442 = peg$literalExpectation("\xA0", false),
+      peg$c443 = "\uFEFF",
+      peg$c444 = peg$literalExpectation("\uFEFF", false),
+      peg$c445 = peg$otherExpectation("whitespace"),
 
       peg$currPos          = 0,
       peg$savedPos         = 0,
@@ -3527,6 +3592,12 @@ function peg$parse(input, options) {
                     s0 = peg$parserename();
                     if (s0 === peg$FAILED) {
                       s0 = peg$parsefuse();
+                      if (s0 === peg$FAILED) {
+                        s0 = peg$parselookup();
+                        if (s0 === peg$FAILED) {
+                          s0 = peg$parsewindow();
+                        }
+                      }
                     }
                   }
                 }
@@ -4485,33 +4556,27 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parseExpressionAssignment() {
-    var s0, s1, s2, s3, s4, s5;
+  function peg$parselookup() {
+    var s0, s1, s2, s3, s4;
 
     s0 = peg$currPos;
-    s1 = peg$parsefieldName();
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c184) {
+      s1 = input.substr(peg$currPos, 6);
+      peg$currPos += 6;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c185); }
+    }
     if (s1 !== peg$FAILED) {
-      s2 = peg$parse__();
+      s2 = peg$parselookupArgs();
       if (s2 !== peg$FAILED) {
-        if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c124;
-          peg$currPos++;
-        } else {
-          s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c125); }
-        }
+        s3 = peg$parse_();
         if (s3 !== peg$FAILED) {
-          s4 = peg$parse__();
+          s4 = peg$parseConditionalExpression();
           if (s4 !== peg$FAILED) {
-            s5 = peg$parseConditionalExpression();
-            if (s5 !== peg$FAILED) {
-              peg$savedPos = s0;
-              s1 = peg$c184(s1, s5);
-              s0 = s1;
-            } else {
-              peg$currPos = s0;
-              s0 = peg$FAILED;
-            }
+            peg$savedPos = s0;
+            s1 = peg$c186(s2, s4);
+            s0 = s1;
           } else {
             peg$currPos = s0;
             s0 = peg$FAILED;
@@ -4532,28 +4597,131 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parseFieldAssignment() {
-    var s0, s1, s2, s3, s4, s5;
+  function peg$parselookupArgs() {
+    var s0, s1, s2, s3, s4;
 
     s0 = peg$currPos;
-    s1 = peg$parseDotExprText();
+    s1 = [];
+    s2 = peg$currPos;
+    s3 = peg$parse_();
+    if (s3 !== peg$FAILED) {
+      s4 = peg$parselookupArg();
+      if (s4 !== peg$FAILED) {
+        peg$savedPos = s2;
+        s3 = peg$c132(s4);
+        s2 = s3;
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s2;
+      s2 = peg$FAILED;
+    }
+    while (s2 !== peg$FAILED) {
+      s1.push(s2);
+      s2 = peg$currPos;
+      s3 = peg$parse_();
+      if (s3 !== peg$FAILED) {
+        s4 = peg$parselookupArg();
+        if (s4 !== peg$FAILED) {
+          peg$savedPos = s2;
+          s3 = peg$c132(s4);
+          s2 = s3;
+        } else {
+          peg$currPos = s2;
+          s2 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    }
     if (s1 !== peg$FAILED) {
-      s2 = peg$parse__();
+      peg$savedPos = s0;
+      s1 = peg$c133(s1);
+    }
+    s0 = s1;
+
+    return s0;
+  }
+
+  function peg$parselookupArg() {
+    var s0, s1, s2, s3, s4;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 5) === peg$c187) {
+      s1 = peg$c187;
+      peg$currPos += 5;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c188); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
       if (s2 !== peg$FAILED) {
-        if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c124;
-          peg$currPos++;
+        s3 = peg$parselookupPath();
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c189(s3);
+          s0 = s1;
         } else {
-          s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c125); }
+          peg$currPos = s0;
+          s0 = peg$FAILED;
         }
-        if (s3 !== peg$FAILED) {
-          s4 = peg$parse__();
-          if (s4 !== peg$FAILED) {
-            s5 = peg$parseDotExpr();
-            if (s5 !== peg$FAILED) {
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      if (input.substr(peg$currPos, 6) === peg$c190) {
+        s1 = peg$c190;
+        peg$currPos += 6;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c191); }
+      }
+      if (s1 !== peg$FAILED) {
+        s2 = peg$parse_();
+        if (s2 !== peg$FAILED) {
+          s3 = peg$parselookupPath();
+          if (s3 !== peg$FAILED) {
+            peg$savedPos = s0;
+            s1 = peg$c192(s3);
+            s0 = s1;
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+      if (s0 === peg$FAILED) {
+        s0 = peg$currPos;
+        if (input.substr(peg$currPos, 4) === peg$c193) {
+          s1 = peg$c193;
+          peg$currPos += 4;
+        } else {
+          s1 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c194); }
+        }
+        if (s1 !== peg$FAILED) {
+          s2 = peg$parse_();
+          if (s2 !== peg$FAILED) {
+            s3 = peg$parsefieldName();
+            if (s3 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c185(s1, s5);
+              s1 = peg$c195(s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -4567,90 +4735,64 @@ function peg$parse(input, options) {
           peg$currPos = s0;
           s0 = peg$FAILED;
         }
-      } else {
-        peg$currPos = s0;
-        s0 = peg$FAILED;
-      }
-    } else {
-      peg$currPos = s0;
-      s0 = peg$FAILED;
-    }
-
-    return s0;
-  }
-
-  function peg$parsePrimaryExpression() {
-    var s0, s1, s2, s3, s4, s5;
-
-    s0 = peg$parseStringLiteral();
-    if (s0 === peg$FAILED) {
-      s0 = peg$parseRegexpLiteral();
-      if (s0 === peg$FAILED) {
-        s0 = peg$parseSubnetLiteral();
         if (s0 === peg$FAILED) {
-          s0 = peg$parseAddressLiteral();
-          if (s0 === peg$FAILED) {
-            s0 = peg$parseFloatLiteral();
-            if (s0 === peg$FAILED) {
-              s0 = peg$parseIntegerLiteral();
-              if (s0 === peg$FAILED) {
-                s0 = peg$parseBooleanLiteral();
-                if (s0 === peg$FAILED) {
-                  s0 = peg$parseNullLiteral();
-                  if (s0 === peg$FAILED) {
-                    s0 = peg$parseField();
-                    if (s0 === peg$FAILED) {
-                      s0 = peg$currPos;
-                      if (input.charCodeAt(peg$currPos) === 40) {
-                        s1 = peg$c19;
-                        peg$currPos++;
-                      } else {
-                        s1 = peg$FAILED;
-                        if (peg$silentFails === 0) { peg$fail(peg$c20); }
-                      }
-                      if (s1 !== peg$FAILED) {
-                        s2 = peg$parse__();
-                        if (s2 !== peg$FAILED) {
-                          s3 = peg$parseConditionalExpression();
-                          if (s3 !== peg$FAILED) {
-                            s4 = peg$parse__();
-                            if (s4 !== peg$FAILED) {
-                              if (input.charCodeAt(peg$currPos) === 41) {
-                                s5 = peg$c21;
-                                peg$currPos++;
-                              } else {
-                                s5 = peg$FAILED;
-                                if (peg$silentFails === 0) { peg$fail(peg$c22); }
-                              }
-                              if (s5 !== peg$FAILED) {
-                                peg$savedPos = s0;
-                                s1 = peg$c23(s3);
-                                s0 = s1;
-                              } else {
-                                peg$currPos = s0;
-                                s0 = peg$FAILED;
-                              }
-                            } else {
-                              peg$currPos = s0;
-                              s0 = peg$FAILED;
-                            }
-                          } else {
-                            peg$currPos = s0;
-                            s0 = peg$FAILED;
-                          }
-                        } else {
-                          peg$currPos = s0;
-                          s0 = peg$FAILED;
-                        }
-                      } else {
-                        peg$currPos = s0;
-                        s0 = peg$FAILED;
-                      }
-                    }
+          s0 = peg$currPos;
+          if (input.substr(peg$currPos, 5) === peg$c196) {
+            s1 = peg$c196;
+            peg$currPos += 5;
+          } else {
+            s1 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c197); }
+          }
+          if (s1 !== peg$FAILED) {
+            s2 = peg$parse_();
+            if (s2 !== peg$FAILED) {
+              s3 = peg$currPos;
+              if (input.substr(peg$currPos, 4) === peg$c198) {
+                s4 = peg$c198;
+                peg$currPos += 4;
+              } else {
+                s4 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c199); }
+              }
+              if (s4 === peg$FAILED) {
+                if (input.substr(peg$currPos, 4) === peg$c200) {
+                  s4 = peg$c200;
+                  peg$currPos += 4;
+                } else {
+                  s4 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c201); }
+                }
+                if (s4 === peg$FAILED) {
+                  if (input.substr(peg$currPos, 4) === peg$c50) {
+                    s4 = peg$c50;
+                    peg$currPos += 4;
+                  } else {
+                    s4 = peg$FAILED;
+                    if (peg$silentFails === 0) { peg$fail(peg$c51); }
                   }
                 }
               }
+              if (s4 !== peg$FAILED) {
+                peg$savedPos = s3;
+                s4 = peg$c70();
+              }
+              s3 = s4;
+              if (s3 !== peg$FAILED) {
+                peg$savedPos = s0;
+                s1 = peg$c202(s3);
+                s0 = s1;
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
             }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
           }
         }
       }
@@ -4659,13 +4801,861 @@ function peg$parse(input, options) {
     return s0;
   }
 
-  function peg$parseExpression() {
-    var s0;
-
-    s0 = peg$parseConditionalExpression();
+  function peg$parselookupPath() {
+    var s0, s1, s2, s3, s4;
 
-    return s0;
-  }
+    s0 = peg$parsequotedString();
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      s1 = [];
+      s2 = peg$currPos;
+      s3 = peg$currPos;
+      peg$silentFails++;
+      if (peg$c203.test(input.charAt(peg$currPos))) {
+        s4 = input.charAt(peg$currPos);
+        peg$currPos++;
+      } else {
+        s4 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c204); }
+      }
+      if (s4 === peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 34) {
+          s4 = peg$c205;
+          peg$currPos++;
+        } else {
+          s4 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c206); }
+        }
+      }
+      peg$silentFails--;
+      if (s4 === peg$FAILED) {
+        s3 = void 0;
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      if (s3 !== peg$FAILED) {
+        if (input.length > peg$currPos) {
+          s4 = input.charAt(peg$currPos);
+          peg$currPos++;
+        } else {
+          s4 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c207); }
+        }
+        if (s4 !== peg$FAILED) {
+          s3 = [s3, s4];
+          s2 = s3;
+        } else {
+          peg$currPos = s2;
+          s2 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+      if (s2 !== peg$FAILED) {
+        while (s2 !== peg$FAILED) {
+          s1.push(s2);
+          s2 = peg$currPos;
+          s3 = peg$currPos;
+          peg$silentFails++;
+          if (peg$c203.test(input.charAt(peg$currPos))) {
+            s4 = input.charAt(peg$currPos);
+            peg$currPos++;
+          } else {
+            s4 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c204); }
+          }
+          if (s4 === peg$FAILED) {
+            if (input.charCodeAt(peg$currPos) === 34) {
+              s4 = peg$c205;
+              peg$currPos++;
+            } else {
+              s4 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c206); }
+            }
+          }
+          peg$silentFails--;
+          if (s4 === peg$FAILED) {
+            s3 = void 0;
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+          if (s3 !== peg$FAILED) {
+            if (input.length > peg$currPos) {
+              s4 = input.charAt(peg$currPos);
+              peg$currPos++;
+            } else {
+              s4 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c207); }
+            }
+            if (s4 !== peg$FAILED) {
+              s3 = [s3, s4];
+              s2 = s3;
+            } else {
+              peg$currPos = s2;
+              s2 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s2;
+            s2 = peg$FAILED;
+          }
+        }
+      } else {
+        s1 = peg$FAILED;
+      }
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c70();
+      }
+      s0 = s1;
+    }
+
+    return s0;
+  }
+
+  function peg$parsewindow() {
+    var s0, s1, s2, s3, s4, s5, s6, s7;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c208) {
+      s1 = input.substr(peg$currPos, 6);
+      peg$currPos += 6;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c209); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parsewindowArgs();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parse_();
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parsereducerList();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$currPos;
+            s6 = peg$parse_();
+            if (s6 !== peg$FAILED) {
+              s7 = peg$parsegroupByKeys();
+              if (s7 !== peg$FAILED) {
+                peg$savedPos = s5;
+                s6 = peg$c210(s2, s4, s7);
+                s5 = s6;
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+            if (s5 === peg$FAILED) {
+              s5 = null;
+            }
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c211(s2, s4, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsewindowArgs() {
+    var s0, s1, s2, s3, s4;
+
+    s0 = peg$currPos;
+    s1 = [];
+    s2 = peg$currPos;
+    s3 = peg$parse_();
+    if (s3 !== peg$FAILED) {
+      s4 = peg$parsewindowArg();
+      if (s4 !== peg$FAILED) {
+        peg$savedPos = s2;
+        s3 = peg$c132(s4);
+        s2 = s3;
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s2;
+      s2 = peg$FAILED;
+    }
+    while (s2 !== peg$FAILED) {
+      s1.push(s2);
+      s2 = peg$currPos;
+      s3 = peg$parse_();
+      if (s3 !== peg$FAILED) {
+        s4 = peg$parsewindowArg();
+        if (s4 !== peg$FAILED) {
+          peg$savedPos = s2;
+          s3 = peg$c132(s4);
+          s2 = s3;
+        } else {
+          peg$currPos = s2;
+          s2 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c133(s1);
+    }
+    s0 = s1;
+
+    return s0;
+  }
+
+  function peg$parsewindowArg() {
+    var s0, s1, s2, s3;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 5) === peg$c212) {
+      s1 = peg$c212;
+      peg$currPos += 5;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c213); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parseunsignedInteger();
+        if (s3 !== peg$FAILED) {
+          peg$savedPos = s0;
+          s1 = peg$c214(s3);
+          s0 = s1;
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      if (input.substr(peg$currPos, 6) === peg$c215) {
+        s1 = peg$c215;
+        peg$currPos += 6;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c216); }
+      }
+      if (s1 !== peg$FAILED) {
+        s2 = peg$parse_();
+        if (s2 !== peg$FAILED) {
+          s3 = peg$parseduration();
+          if (s3 !== peg$FAILED) {
+            peg$savedPos = s0;
+            s1 = peg$c217(s3);
+            s0 = s1;
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    }
+
+    return s0;
+  }
+
+  function peg$parseExpressionAssignment() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldPath();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 61) {
+          s3 = peg$c124;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c125); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse__();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parseConditionalExpression();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c218(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsefieldPath() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldName();
+    if (s1 !== peg$FAILED) {
+      s2 = [];
+      s3 = peg$currPos;
+      if (input.charCodeAt(peg$currPos) === 46) {
+        s4 = peg$c219;
+        peg$currPos++;
+      } else {
+        s4 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c220); }
+      }
+      if (s4 !== peg$FAILED) {
+        s5 = peg$parsefieldName();
+        if (s5 !== peg$FAILED) {
+          s4 = [s4, s5];
+          s3 = s4;
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s3;
+        s3 = peg$FAILED;
+      }
+      while (s3 !== peg$FAILED) {
+        s2.push(s3);
+        s3 = peg$currPos;
+        if (input.charCodeAt(peg$currPos) === 46) {
+          s4 = peg$c219;
+          peg$currPos++;
+        } else {
+          s4 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c220); }
+        }
+        if (s4 !== peg$FAILED) {
+          s5 = peg$parsefieldName();
+          if (s5 !== peg$FAILED) {
+            s4 = [s4, s5];
+            s3 = s4;
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s3;
+          s3 = peg$FAILED;
+        }
+      }
+      if (s2 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c70();
+        s0 = s1;
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseFieldAssignment() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parseDotExprText();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 61) {
+          s3 = peg$c124;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c125); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse__();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parseDotExpr();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c221(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsePrimaryExpression() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$parseStringLiteral();
+    if (s0 === peg$FAILED) {
+      s0 = peg$parseRegexpLiteral();
+      if (s0 === peg$FAILED) {
+        s0 = peg$parseSubnetLiteral();
+        if (s0 === peg$FAILED) {
+          s0 = peg$parseAddressLiteral();
+          if (s0 === peg$FAILED) {
+            s0 = peg$parseFloatLiteral();
+            if (s0 === peg$FAILED) {
+              s0 = peg$parseIntegerLiteral();
+              if (s0 === peg$FAILED) {
+                s0 = peg$parseBooleanLiteral();
+                if (s0 === peg$FAILED) {
+                  s0 = peg$parseNullLiteral();
+                  if (s0 === peg$FAILED) {
+                    s0 = peg$parseField();
+                    if (s0 === peg$FAILED) {
+                      s0 = peg$parseRecordExpression();
+                      if (s0 === peg$FAILED) {
+                        s0 = peg$parseArrayExpression();
+                        if (s0 === peg$FAILED) {
+                          s0 = peg$currPos;
+                          if (input.charCodeAt(peg$currPos) === 40) {
+                            s1 = peg$c19;
+                            peg$currPos++;
+                          } else {
+                            s1 = peg$FAILED;
+                            if (peg$silentFails === 0) { peg$fail(peg$c20); }
+                          }
+                          if (s1 !== peg$FAILED) {
+                            s2 = peg$parse__();
+                            if (s2 !== peg$FAILED) {
+                              s3 = peg$parseConditionalExpression();
+                              if (s3 !== peg$FAILED) {
+                                s4 = peg$parse__();
+                                if (s4 !== peg$FAILED) {
+                                  if (input.charCodeAt(peg$currPos) === 41) {
+                                    s5 = peg$c21;
+                                    peg$currPos++;
+                                  } else {
+                                    s5 = peg$FAILED;
+                                    if (peg$silentFails === 0) { peg$fail(peg$c22); }
+                                  }
+                                  if (s5 !== peg$FAILED) {
+                                    peg$savedPos = s0;
+                                    s1 = peg$c23(s3);
+                                    s0 = s1;
+                                  } else {
+                                    peg$currPos = s0;
+                                    s0 = peg$FAILED;
+                                  }
+                                } else {
+                                  peg$currPos = s0;
+                                  s0 = peg$FAILED;
+                                }
+                              } else {
+                                peg$currPos = s0;
+                                s0 = peg$FAILED;
+                              }
+                            } else {
+                              peg$currPos = s0;
+                              s0 = peg$FAILED;
+                            }
+                          } else {
+                            peg$currPos = s0;
+                            s0 = peg$FAILED;
+                          }
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+
+    return s0;
+  }
+
+  function peg$parseRecordExpression() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
+
+    s0 = peg$currPos;
+    if (input.charCodeAt(peg$currPos) === 123) {
+      s1 = peg$c222;
+      peg$currPos++;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c223); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parseRecordField();
+        if (s3 !== peg$FAILED) {
+          s4 = [];
+          s5 = peg$currPos;
+          s6 = peg$parse__();
+          if (s6 !== peg$FAILED) {
+            if (input.charCodeAt(peg$currPos) === 44) {
+              s7 = peg$c60;
+              peg$currPos++;
+            } else {
+              s7 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c61); }
+            }
+            if (s7 !== peg$FAILED) {
+              s8 = peg$parse__();
+              if (s8 !== peg$FAILED) {
+                s9 = peg$parseRecordField();
+                if (s9 !== peg$FAILED) {
+                  peg$savedPos = s5;
+                  s6 = peg$c224(s3, s9);
+                  s5 = s6;
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s5;
+            s5 = peg$FAILED;
+          }
+          while (s5 !== peg$FAILED) {
+            s4.push(s5);
+            s5 = peg$currPos;
+            s6 = peg$parse__();
+            if (s6 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 44) {
+                s7 = peg$c60;
+                peg$currPos++;
+              } else {
+                s7 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c61); }
+              }
+              if (s7 !== peg$FAILED) {
+                s8 = peg$parse__();
+                if (s8 !== peg$FAILED) {
+                  s9 = peg$parseRecordField();
+                  if (s9 !== peg$FAILED) {
+                    peg$savedPos = s5;
+                    s6 = peg$c224(s3, s9);
+                    s5 = s6;
+                  } else {
+                    peg$currPos = s5;
+                    s5 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+          }
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parse__();
+            if (s5 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 125) {
+                s6 = peg$c225;
+                peg$currPos++;
+              } else {
+                s6 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c226); }
+              }
+              if (s6 !== peg$FAILED) {
+                peg$savedPos = s0;
+                s1 = peg$c227(s3, s4);
+                s0 = s1;
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseRecordField() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldName();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 58) {
+          s3 = peg$c228;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c229); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse__();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parseConditionalExpression();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c218(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseArrayExpression() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
+
+    s0 = peg$currPos;
+    if (input.charCodeAt(peg$currPos) === 91) {
+      s1 = peg$c230;
+      peg$currPos++;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c231); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parseConditionalExpression();
+        if (s3 !== peg$FAILED) {
+          s4 = [];
+          s5 = peg$currPos;
+          s6 = peg$parse__();
+          if (s6 !== peg$FAILED) {
+            if (input.charCodeAt(peg$currPos) === 44) {
+              s7 = peg$c60;
+              peg$currPos++;
+            } else {
+              s7 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c61); }
+            }
+            if (s7 !== peg$FAILED) {
+              s8 = peg$parse__();
+              if (s8 !== peg$FAILED) {
+                s9 = peg$parseConditionalExpression();
+                if (s9 !== peg$FAILED) {
+                  peg$savedPos = s5;
+                  s6 = peg$c232(s3, s9);
+                  s5 = s6;
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s5;
+            s5 = peg$FAILED;
+          }
+          while (s5 !== peg$FAILED) {
+            s4.push(s5);
+            s5 = peg$currPos;
+            s6 = peg$parse__();
+            if (s6 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 44) {
+                s7 = peg$c60;
+                peg$currPos++;
+              } else {
+                s7 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c61); }
+              }
+              if (s7 !== peg$FAILED) {
+                s8 = peg$parse__();
+                if (s8 !== peg$FAILED) {
+                  s9 = peg$parseConditionalExpression();
+                  if (s9 !== peg$FAILED) {
+                    peg$savedPos = s5;
+                    s6 = peg$c232(s3, s9);
+                    s5 = s6;
+                  } else {
+                    peg$currPos = s5;
+                    s5 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s5;
+                  s5 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s5;
+                s5 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s5;
+              s5 = peg$FAILED;
+            }
+          }
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parse__();
+            if (s5 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 93) {
+                s6 = peg$c233;
+                peg$currPos++;
+              } else {
+                s6 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c234); }
+              }
+              if (s6 !== peg$FAILED) {
+                peg$savedPos = s0;
+                s1 = peg$c235(s3, s4);
+                s0 = s1;
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parseExpression() {
+    var s0;
+
+    s0 = peg$parseConditionalExpression();
+
+    return s0;
+  }
 
   function peg$parseConditionalExpression() {
     var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
@@ -4676,11 +5666,11 @@ function peg$parse(input, options) {
       s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 63) {
-          s3 = peg$c186;
+          s3 = peg$c236;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c187); }
+          if (peg$silentFails === 0) { peg$fail(peg$c237); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse__();
@@ -4690,11 +5680,11 @@ function peg$parse(input, options) {
               s6 = peg$parse__();
               if (s6 !== peg$FAILED) {
                 if (input.charCodeAt(peg$currPos) === 58) {
-                  s7 = peg$c188;
+                  s7 = peg$c228;
                   peg$currPos++;
                 } else {
                   s7 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c189); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c229); }
                 }
                 if (s7 !== peg$FAILED) {
                   s8 = peg$parse__();
@@ -4702,7 +5692,7 @@ function peg$parse(input, options) {
                     s9 = peg$parseConditionalExpression();
                     if (s9 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c190(s1, s5, s9);
+                      s1 = peg$c238(s1, s5, s9);
                       s0 = s1;
                     } else {
                       peg$currPos = s0;
@@ -4764,7 +5754,7 @@ function peg$parse(input, options) {
             s7 = peg$parseLogicalANDExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c191(s1, s5, s7);
+              s4 = peg$c239(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4794,7 +5784,7 @@ function peg$parse(input, options) {
               s7 = peg$parseLogicalANDExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c191(s1, s5, s7);
+                s4 = peg$c239(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4815,7 +5805,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4846,7 +5836,7 @@ function peg$parse(input, options) {
             s7 = peg$parseEqualityCompareExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c191(s1, s5, s7);
+              s4 = peg$c239(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4876,7 +5866,7 @@ function peg$parse(input, options) {
               s7 = peg$parseEqualityCompareExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c191(s1, s5, s7);
+                s4 = peg$c239(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4897,7 +5887,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4928,7 +5918,7 @@ function peg$parse(input, options) {
             s7 = peg$parseRelativeExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c193(s1, s5, s7);
+              s4 = peg$c241(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4958,7 +5948,7 @@ function peg$parse(input, options) {
               s7 = peg$parseRelativeExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c193(s1, s5, s7);
+                s4 = peg$c241(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4979,7 +5969,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4997,20 +5987,20 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c194) {
-      s1 = peg$c194;
+    if (input.substr(peg$currPos, 2) === peg$c242) {
+      s1 = peg$c242;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c195); }
+      if (peg$silentFails === 0) { peg$fail(peg$c243); }
     }
     if (s1 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c196) {
-        s1 = peg$c196;
+      if (input.substr(peg$currPos, 2) === peg$c244) {
+        s1 = peg$c244;
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c197); }
+        if (peg$silentFails === 0) { peg$fail(peg$c245); }
       }
       if (s1 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
@@ -5021,12 +6011,12 @@ function peg$parse(input, options) {
           if (peg$silentFails === 0) { peg$fail(peg$c125); }
         }
         if (s1 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c198) {
-            s1 = peg$c198;
+          if (input.substr(peg$currPos, 2) === peg$c246) {
+            s1 = peg$c246;
             peg$currPos += 2;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c199); }
+            if (peg$silentFails === 0) { peg$fail(peg$c247); }
           }
         }
       }
@@ -5051,7 +6041,7 @@ function peg$parse(input, options) {
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c200); }
+        if (peg$silentFails === 0) { peg$fail(peg$c248); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -5080,7 +6070,7 @@ function peg$parse(input, options) {
             s7 = peg$parseAdditiveExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c191(s1, s5, s7);
+              s4 = peg$c239(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5110,7 +6100,7 @@ function peg$parse(input, options) {
               s7 = peg$parseAdditiveExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c191(s1, s5, s7);
+                s4 = peg$c239(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5131,7 +6121,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5149,36 +6139,36 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c201) {
-      s1 = peg$c201;
+    if (input.substr(peg$currPos, 2) === peg$c249) {
+      s1 = peg$c249;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c202); }
+      if (peg$silentFails === 0) { peg$fail(peg$c250); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 60) {
-        s1 = peg$c203;
+        s1 = peg$c251;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c204); }
+        if (peg$silentFails === 0) { peg$fail(peg$c252); }
       }
       if (s1 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c205) {
-          s1 = peg$c205;
+        if (input.substr(peg$currPos, 2) === peg$c253) {
+          s1 = peg$c253;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c206); }
+          if (peg$silentFails === 0) { peg$fail(peg$c254); }
         }
         if (s1 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 62) {
-            s1 = peg$c207;
+            s1 = peg$c255;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c208); }
+            if (peg$silentFails === 0) { peg$fail(peg$c256); }
           }
         }
       }
@@ -5209,7 +6199,7 @@ function peg$parse(input, options) {
             s7 = peg$parseMultiplicativeExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c191(s1, s5, s7);
+              s4 = peg$c239(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5239,7 +6229,7 @@ function peg$parse(input, options) {
               s7 = peg$parseMultiplicativeExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c191(s1, s5, s7);
+                s4 = peg$c239(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5260,7 +6250,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5279,11 +6269,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 43) {
-      s1 = peg$c209;
+      s1 = peg$c257;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c210); }
+      if (peg$silentFails === 0) { peg$fail(peg$c258); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 45) {
@@ -5320,7 +6310,7 @@ function peg$parse(input, options) {
             s7 = peg$parseNotExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c191(s1, s5, s7);
+              s4 = peg$c239(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5350,7 +6340,7 @@ function peg$parse(input, options) {
               s7 = peg$parseNotExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c191(s1, s5, s7);
+                s4 = peg$c239(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5371,7 +6361,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c192(s1, s2);
+        s1 = peg$c240(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5398,11 +6388,11 @@ function peg$parse(input, options) {
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s1 = peg$c211;
+        s1 = peg$c259;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c212); }
+        if (peg$silentFails === 0) { peg$fail(peg$c260); }
       }
     }
     if (s1 !== peg$FAILED) {
@@ -5431,7 +6421,7 @@ function peg$parse(input, options) {
         s3 = peg$parseNotExpression();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c213(s3);
+          s1 = peg$c261(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -5459,17 +6449,17 @@ function peg$parse(input, options) {
     s1 = peg$parseCallExpression();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 58) {
-        s2 = peg$c188;
+        s2 = peg$c228;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c189); }
+        if (peg$silentFails === 0) { peg$fail(peg$c229); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parsePrimitiveType();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c214(s1, s3);
+          s1 = peg$c262(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -5494,164 +6484,164 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 5) === peg$c215) {
-      s1 = peg$c215;
+    if (input.substr(peg$currPos, 5) === peg$c263) {
+      s1 = peg$c263;
       peg$currPos += 5;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c216); }
+      if (peg$silentFails === 0) { peg$fail(peg$c264); }
     }
     if (s1 === peg$FAILED) {
-      if (input.substr(peg$currPos, 5) === peg$c217) {
-        s1 = peg$c217;
+      if (input.substr(peg$currPos, 5) === peg$c265) {
+        s1 = peg$c265;
         peg$currPos += 5;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c218); }
+        if (peg$silentFails === 0) { peg$fail(peg$c266); }
       }
       if (s1 === peg$FAILED) {
-        if (input.substr(peg$currPos, 6) === peg$c219) {
-          s1 = peg$c219;
+        if (input.substr(peg$currPos, 6) === peg$c267) {
+          s1 = peg$c267;
           peg$currPos += 6;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c220); }
+          if (peg$silentFails === 0) { peg$fail(peg$c268); }
         }
         if (s1 === peg$FAILED) {
-          if (input.substr(peg$currPos, 6) === peg$c221) {
-            s1 = peg$c221;
+          if (input.substr(peg$currPos, 6) === peg$c269) {
+            s1 = peg$c269;
             peg$currPos += 6;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c222); }
+            if (peg$silentFails === 0) { peg$fail(peg$c270); }
           }
           if (s1 === peg$FAILED) {
-            if (input.substr(peg$currPos, 6) === peg$c223) {
-              s1 = peg$c223;
+            if (input.substr(peg$currPos, 6) === peg$c271) {
+              s1 = peg$c271;
               peg$currPos += 6;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c224); }
+              if (peg$silentFails === 0) { peg$fail(peg$c272); }
             }
             if (s1 === peg$FAILED) {
-              if (input.substr(peg$currPos, 4) === peg$c225) {
-                s1 = peg$c225;
+              if (input.substr(peg$currPos, 4) === peg$c273) {
+                s1 = peg$c273;
                 peg$currPos += 4;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c226); }
+                if (peg$silentFails === 0) { peg$fail(peg$c274); }
               }
               if (s1 === peg$FAILED) {
-                if (input.substr(peg$currPos, 5) === peg$c227) {
-                  s1 = peg$c227;
+                if (input.substr(peg$currPos, 5) === peg$c275) {
+                  s1 = peg$c275;
                   peg$currPos += 5;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c228); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c276); }
                 }
                 if (s1 === peg$FAILED) {
-                  if (input.substr(peg$currPos, 5) === peg$c229) {
-                    s1 = peg$c229;
+                  if (input.substr(peg$currPos, 5) === peg$c277) {
+                    s1 = peg$c277;
                     peg$currPos += 5;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c230); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c278); }
                   }
                   if (s1 === peg$FAILED) {
-                    if (input.substr(peg$currPos, 5) === peg$c231) {
-                      s1 = peg$c231;
+                    if (input.substr(peg$currPos, 5) === peg$c279) {
+                      s1 = peg$c279;
                       peg$currPos += 5;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c232); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c280); }
                     }
                     if (s1 === peg$FAILED) {
-                      if (input.substr(peg$currPos, 8) === peg$c233) {
-                        s1 = peg$c233;
+                      if (input.substr(peg$currPos, 8) === peg$c281) {
+                        s1 = peg$c281;
                         peg$currPos += 8;
                       } else {
                         s1 = peg$FAILED;
-                        if (peg$silentFails === 0) { peg$fail(peg$c234); }
+                        if (peg$silentFails === 0) { peg$fail(peg$c282); }
                       }
                       if (s1 === peg$FAILED) {
-                        if (input.substr(peg$currPos, 4) === peg$c235) {
-                          s1 = peg$c235;
+                        if (input.substr(peg$currPos, 4) === peg$c283) {
+                          s1 = peg$c283;
                           peg$currPos += 4;
                         } else {
                           s1 = peg$FAILED;
-                          if (peg$silentFails === 0) { peg$fail(peg$c236); }
+                          if (peg$silentFails === 0) { peg$fail(peg$c284); }
                         }
                         if (s1 === peg$FAILED) {
-                          if (input.substr(peg$currPos, 7) === peg$c237) {
-                            s1 = peg$c237;
+                          if (input.substr(peg$currPos, 7) === peg$c285) {
+                            s1 = peg$c285;
                             peg$currPos += 7;
                           } else {
                             s1 = peg$FAILED;
-                            if (peg$silentFails === 0) { peg$fail(peg$c238); }
+                            if (peg$silentFails === 0) { peg$fail(peg$c286); }
                           }
                           if (s1 === peg$FAILED) {
-                            if (input.substr(peg$currPos, 4) === peg$c239) {
-                              s1 = peg$c239;
+                            if (input.substr(peg$currPos, 4) === peg$c287) {
+                              s1 = peg$c287;
                               peg$currPos += 4;
                             } else {
                               s1 = peg$FAILED;
-                              if (peg$silentFails === 0) { peg$fail(peg$c240); }
+                              if (peg$silentFails === 0) { peg$fail(peg$c288); }
                             }
                             if (s1 === peg$FAILED) {
-                              if (input.substr(peg$currPos, 5) === peg$c215) {
-                                s1 = peg$c215;
+                              if (input.substr(peg$currPos, 5) === peg$c263) {
+                                s1 = peg$c263;
                                 peg$currPos += 5;
                               } else {
                                 s1 = peg$FAILED;
-                                if (peg$silentFails === 0) { peg$fail(peg$c216); }
+                                if (peg$silentFails === 0) { peg$fail(peg$c264); }
                               }
                               if (s1 === peg$FAILED) {
-                                if (input.substr(peg$currPos, 6) === peg$c241) {
-                                  s1 = peg$c241;
+                                if (input.substr(peg$currPos, 6) === peg$c289) {
+                                  s1 = peg$c289;
                                   peg$currPos += 6;
                                 } else {
                                   s1 = peg$FAILED;
-                                  if (peg$silentFails === 0) { peg$fail(peg$c242); }
+                                  if (peg$silentFails === 0) { peg$fail(peg$c290); }
                                 }
                                 if (s1 === peg$FAILED) {
-                                  if (input.substr(peg$currPos, 7) === peg$c243) {
-                                    s1 = peg$c243;
+                                  if (input.substr(peg$currPos, 7) === peg$c291) {
+                                    s1 = peg$c291;
                                     peg$currPos += 7;
                                   } else {
                                     s1 = peg$FAILED;
-                                    if (peg$silentFails === 0) { peg$fail(peg$c244); }
+                                    if (peg$silentFails === 0) { peg$fail(peg$c292); }
                                   }
                                   if (s1 === peg$FAILED) {
-                                    if (input.substr(peg$currPos, 2) === peg$c245) {
-                                      s1 = peg$c245;
+                                    if (input.substr(peg$currPos, 2) === peg$c293) {
+                                      s1 = peg$c293;
                                       peg$currPos += 2;
                                     } else {
                                       s1 = peg$FAILED;
-                                      if (peg$silentFails === 0) { peg$fail(peg$c246); }
+                                      if (peg$silentFails === 0) { peg$fail(peg$c294); }
                                     }
                                     if (s1 === peg$FAILED) {
-                                      if (input.substr(peg$currPos, 3) === peg$c247) {
-                                        s1 = peg$c247;
+                                      if (input.substr(peg$currPos, 3) === peg$c295) {
+                                        s1 = peg$c295;
                                         peg$currPos += 3;
                                       } else {
                                         s1 = peg$FAILED;
-                                        if (peg$silentFails === 0) { peg$fail(peg$c248); }
+                                        if (peg$silentFails === 0) { peg$fail(peg$c296); }
                                       }
                                       if (s1 === peg$FAILED) {
-                                        if (input.substr(peg$currPos, 4) === peg$c249) {
-                                          s1 = peg$c249;
+                                        if (input.substr(peg$currPos, 4) === peg$c297) {
+                                          s1 = peg$c297;
                                           peg$currPos += 4;
                                         } else {
                                           s1 = peg$FAILED;
-                                          if (peg$silentFails === 0) { peg$fail(peg$c250); }
+                                          if (peg$silentFails === 0) { peg$fail(peg$c298); }
                                         }
                                         if (s1 === peg$FAILED) {
-                                          if (input.substr(peg$currPos, 5) === peg$c251) {
-                                            s1 = peg$c251;
+                                          if (input.substr(peg$currPos, 5) === peg$c299) {
+                                            s1 = peg$c299;
                                             peg$currPos += 5;
                                           } else {
                                             s1 = peg$FAILED;
-                                            if (peg$silentFails === 0) { peg$fail(peg$c252); }
+                                            if (peg$silentFails === 0) { peg$fail(peg$c300); }
                                           }
                                           if (s1 === peg$FAILED) {
                                             if (input.substr(peg$currPos, 4) === peg$c50) {
@@ -5717,7 +6707,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c253(s1, s4);
+              s1 = peg$c301(s1, s4);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -5777,12 +6767,12 @@ function peg$parse(input, options) {
   function peg$parseFunctionNameStart() {
     var s0;
 
-    if (peg$c254.test(input.charAt(peg$currPos))) {
+    if (peg$c302.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c255); }
+      if (peg$silentFails === 0) { peg$fail(peg$c303); }
     }
 
     return s0;
@@ -5793,12 +6783,12 @@ function peg$parse(input, options) {
 
     s0 = peg$parseFunctionNameStart();
     if (s0 === peg$FAILED) {
-      if (peg$c256.test(input.charAt(peg$currPos))) {
+      if (peg$c304.test(input.charAt(peg$currPos))) {
         s0 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c257); }
+        if (peg$silentFails === 0) { peg$fail(peg$c305); }
       }
     }
 
@@ -5828,7 +6818,7 @@ function peg$parse(input, options) {
             s7 = peg$parseConditionalExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c258(s1, s7);
+              s4 = peg$c232(s1, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5864,7 +6854,7 @@ function peg$parse(input, options) {
               s7 = peg$parseConditionalExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c258(s1, s7);
+                s4 = peg$c232(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5885,7 +6875,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c259(s1, s2);
+        s1 = peg$c306(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5900,7 +6890,7 @@ function peg$parse(input, options) {
       s1 = peg$parse__();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c260();
+        s1 = peg$c307();
       }
       s0 = s1;
     }
@@ -5922,7 +6912,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c261(s1, s2);
+        s1 = peg$c308(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5943,11 +6933,11 @@ function peg$parse(input, options) {
     s1 = peg$parse__();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 91) {
-        s2 = peg$c262;
+        s2 = peg$c230;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c263); }
+        if (peg$silentFails === 0) { peg$fail(peg$c231); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parse__();
@@ -5957,15 +6947,15 @@ function peg$parse(input, options) {
             s5 = peg$parse__();
             if (s5 !== peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 93) {
-                s6 = peg$c264;
+                s6 = peg$c233;
                 peg$currPos++;
               } else {
                 s6 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c265); }
+                if (peg$silentFails === 0) { peg$fail(peg$c234); }
               }
               if (s6 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c266(s4);
+                s1 = peg$c309(s4);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6018,11 +7008,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 46) {
-      s1 = peg$c267;
+      s1 = peg$c219;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c268); }
+      if (peg$silentFails === 0) { peg$fail(peg$c220); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse__();
@@ -6030,7 +7020,7 @@ function peg$parse(input, options) {
         s3 = peg$parseField();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c269(s3);
+          s1 = peg$c310(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6067,7 +7057,7 @@ function peg$parse(input, options) {
                 peg$currPos += 3;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c270); }
+                if (peg$silentFails === 0) { peg$fail(peg$c311); }
               }
               if (s3 !== peg$FAILED) {
                 s4 = peg$parse_();
@@ -6112,44 +7102,44 @@ function peg$parse(input, options) {
   function peg$parsesec_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c271) {
-      s0 = peg$c271;
+    if (input.substr(peg$currPos, 7) === peg$c312) {
+      s0 = peg$c312;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c272); }
+      if (peg$silentFails === 0) { peg$fail(peg$c313); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c273) {
-        s0 = peg$c273;
+      if (input.substr(peg$currPos, 6) === peg$c314) {
+        s0 = peg$c314;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c274); }
+        if (peg$silentFails === 0) { peg$fail(peg$c315); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c275) {
-          s0 = peg$c275;
+        if (input.substr(peg$currPos, 4) === peg$c316) {
+          s0 = peg$c316;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c276); }
+          if (peg$silentFails === 0) { peg$fail(peg$c317); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 3) === peg$c277) {
-            s0 = peg$c277;
+          if (input.substr(peg$currPos, 3) === peg$c318) {
+            s0 = peg$c318;
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c278); }
+            if (peg$silentFails === 0) { peg$fail(peg$c319); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 115) {
-              s0 = peg$c279;
+              s0 = peg$c320;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c280); }
+              if (peg$silentFails === 0) { peg$fail(peg$c321); }
             }
           }
         }
@@ -6162,28 +7152,28 @@ function peg$parse(input, options) {
   function peg$parsemin_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c281) {
-      s0 = peg$c281;
+    if (input.substr(peg$currPos, 7) === peg$c322) {
+      s0 = peg$c322;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c282); }
+      if (peg$silentFails === 0) { peg$fail(peg$c323); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c283) {
-        s0 = peg$c283;
+      if (input.substr(peg$currPos, 6) === peg$c324) {
+        s0 = peg$c324;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c284); }
+        if (peg$silentFails === 0) { peg$fail(peg$c325); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c285) {
-          s0 = peg$c285;
+        if (input.substr(peg$currPos, 4) === peg$c326) {
+          s0 = peg$c326;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c286); }
+          if (peg$silentFails === 0) { peg$fail(peg$c327); }
         }
         if (s0 === peg$FAILED) {
           if (input.substr(peg$currPos, 3) === peg$c105) {
@@ -6191,15 +7181,15 @@ function peg$parse(input, options) {
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c287); }
+            if (peg$silentFails === 0) { peg$fail(peg$c328); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 109) {
-              s0 = peg$c288;
+              s0 = peg$c329;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c289); }
+              if (peg$silentFails === 0) { peg$fail(peg$c330); }
             }
           }
         }
@@ -6212,44 +7202,44 @@ function peg$parse(input, options) {
   function peg$parsehour_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c290) {
-      s0 = peg$c290;
+    if (input.substr(peg$currPos, 5) === peg$c331) {
+      s0 = peg$c331;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c291); }
+      if (peg$silentFails === 0) { peg$fail(peg$c332); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c292) {
-        s0 = peg$c292;
+      if (input.substr(peg$currPos, 3) === peg$c333) {
+        s0 = peg$c333;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c293); }
+        if (peg$silentFails === 0) { peg$fail(peg$c334); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c294) {
-          s0 = peg$c294;
+        if (input.substr(peg$currPos, 2) === peg$c335) {
+          s0 = peg$c335;
           peg$currPos += 2;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c295); }
+          if (peg$silentFails === 0) { peg$fail(peg$c336); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 104) {
-            s0 = peg$c296;
+            s0 = peg$c337;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c297); }
+            if (peg$silentFails === 0) { peg$fail(peg$c338); }
           }
           if (s0 === peg$FAILED) {
-            if (input.substr(peg$currPos, 4) === peg$c298) {
-              s0 = peg$c298;
+            if (input.substr(peg$currPos, 4) === peg$c339) {
+              s0 = peg$c339;
               peg$currPos += 4;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c299); }
+              if (peg$silentFails === 0) { peg$fail(peg$c340); }
             }
           }
         }
@@ -6262,28 +7252,28 @@ function peg$parse(input, options) {
   function peg$parseday_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 4) === peg$c300) {
-      s0 = peg$c300;
+    if (input.substr(peg$currPos, 4) === peg$c341) {
+      s0 = peg$c341;
       peg$currPos += 4;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c301); }
+      if (peg$silentFails === 0) { peg$fail(peg$c342); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c302) {
-        s0 = peg$c302;
+      if (input.substr(peg$currPos, 3) === peg$c343) {
+        s0 = peg$c343;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c303); }
+        if (peg$silentFails === 0) { peg$fail(peg$c344); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 100) {
-          s0 = peg$c304;
+          s0 = peg$c345;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c305); }
+          if (peg$silentFails === 0) { peg$fail(peg$c346); }
         }
       }
     }
@@ -6294,44 +7284,44 @@ function peg$parse(input, options) {
   function peg$parseweek_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c306) {
-      s0 = peg$c306;
+    if (input.substr(peg$currPos, 5) === peg$c347) {
+      s0 = peg$c347;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c307); }
+      if (peg$silentFails === 0) { peg$fail(peg$c348); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 4) === peg$c308) {
-        s0 = peg$c308;
+      if (input.substr(peg$currPos, 4) === peg$c349) {
+        s0 = peg$c349;
         peg$currPos += 4;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c309); }
+        if (peg$silentFails === 0) { peg$fail(peg$c350); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 3) === peg$c310) {
-          s0 = peg$c310;
+        if (input.substr(peg$currPos, 3) === peg$c351) {
+          s0 = peg$c351;
           peg$currPos += 3;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c311); }
+          if (peg$silentFails === 0) { peg$fail(peg$c352); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c312) {
-            s0 = peg$c312;
+          if (input.substr(peg$currPos, 2) === peg$c353) {
+            s0 = peg$c353;
             peg$currPos += 2;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c313); }
+            if (peg$silentFails === 0) { peg$fail(peg$c354); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 119) {
-              s0 = peg$c314;
+              s0 = peg$c355;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c315); }
+              if (peg$silentFails === 0) { peg$fail(peg$c356); }
             }
           }
         }
@@ -6345,16 +7335,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c273) {
-      s1 = peg$c273;
+    if (input.substr(peg$currPos, 6) === peg$c314) {
+      s1 = peg$c314;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c274); }
+      if (peg$silentFails === 0) { peg$fail(peg$c315); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c316();
+      s1 = peg$c357();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6369,7 +7359,7 @@ function peg$parse(input, options) {
           s3 = peg$parsesec_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c317(s1);
+            s1 = peg$c358(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6392,16 +7382,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c283) {
-      s1 = peg$c283;
+    if (input.substr(peg$currPos, 6) === peg$c324) {
+      s1 = peg$c324;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c284); }
+      if (peg$silentFails === 0) { peg$fail(peg$c325); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c318();
+      s1 = peg$c359();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6416,7 +7406,7 @@ function peg$parse(input, options) {
           s3 = peg$parsemin_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c319(s1);
+            s1 = peg$c360(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6439,16 +7429,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c298) {
-      s1 = peg$c298;
+    if (input.substr(peg$currPos, 4) === peg$c339) {
+      s1 = peg$c339;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c299); }
+      if (peg$silentFails === 0) { peg$fail(peg$c340); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c320();
+      s1 = peg$c361();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6463,7 +7453,7 @@ function peg$parse(input, options) {
           s3 = peg$parsehour_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c321(s1);
+            s1 = peg$c362(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6486,16 +7476,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3) === peg$c302) {
-      s1 = peg$c302;
+    if (input.substr(peg$currPos, 3) === peg$c343) {
+      s1 = peg$c343;
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c303); }
+      if (peg$silentFails === 0) { peg$fail(peg$c344); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c322();
+      s1 = peg$c363();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6510,7 +7500,7 @@ function peg$parse(input, options) {
           s3 = peg$parseday_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c323(s1);
+            s1 = peg$c364(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6533,16 +7523,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c308) {
-      s1 = peg$c308;
+    if (input.substr(peg$currPos, 4) === peg$c349) {
+      s1 = peg$c349;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c309); }
+      if (peg$silentFails === 0) { peg$fail(peg$c350); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c324();
+      s1 = peg$c365();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6557,7 +7547,7 @@ function peg$parse(input, options) {
           s3 = peg$parseweek_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c325(s1);
+            s1 = peg$c366(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6584,31 +7574,31 @@ function peg$parse(input, options) {
     s2 = peg$parseunsignedInteger();
     if (s2 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 46) {
-        s3 = peg$c267;
+        s3 = peg$c219;
         peg$currPos++;
       } else {
         s3 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c268); }
+        if (peg$silentFails === 0) { peg$fail(peg$c220); }
       }
       if (s3 !== peg$FAILED) {
         s4 = peg$parseunsignedInteger();
         if (s4 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 46) {
-            s5 = peg$c267;
+            s5 = peg$c219;
             peg$currPos++;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c268); }
+            if (peg$silentFails === 0) { peg$fail(peg$c220); }
           }
           if (s5 !== peg$FAILED) {
             s6 = peg$parseunsignedInteger();
             if (s6 !== peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 46) {
-                s7 = peg$c267;
+                s7 = peg$c219;
                 peg$currPos++;
               } else {
                 s7 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c268); }
+                if (peg$silentFails === 0) { peg$fail(peg$c220); }
               }
               if (s7 !== peg$FAILED) {
                 s8 = peg$parseunsignedInteger();
@@ -6645,7 +7635,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c326();
+      s1 = peg$c367();
     }
     s0 = s1;
 
@@ -6670,7 +7660,7 @@ function peg$parse(input, options) {
       s2 = peg$parseip6tail();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c327(s1, s2);
+        s1 = peg$c368(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6691,12 +7681,12 @@ function peg$parse(input, options) {
           s3 = peg$parseh_append();
         }
         if (s2 !== peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c328) {
-            s3 = peg$c328;
+          if (input.substr(peg$currPos, 2) === peg$c369) {
+            s3 = peg$c369;
             peg$currPos += 2;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c329); }
+            if (peg$silentFails === 0) { peg$fail(peg$c370); }
           }
           if (s3 !== peg$FAILED) {
             s4 = [];
@@ -6709,7 +7699,7 @@ function peg$parse(input, options) {
               s5 = peg$parseip6tail();
               if (s5 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c330(s1, s2, s4, s5);
+                s1 = peg$c371(s1, s2, s4, s5);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6733,12 +7723,12 @@ function peg$parse(input, options) {
       }
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 2) === peg$c328) {
-          s1 = peg$c328;
+        if (input.substr(peg$currPos, 2) === peg$c369) {
+          s1 = peg$c369;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c329); }
+          if (peg$silentFails === 0) { peg$fail(peg$c370); }
         }
         if (s1 !== peg$FAILED) {
           s2 = [];
@@ -6751,7 +7741,7 @@ function peg$parse(input, options) {
             s3 = peg$parseip6tail();
             if (s3 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c331(s2, s3);
+              s1 = peg$c372(s2, s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -6776,16 +7766,16 @@ function peg$parse(input, options) {
               s3 = peg$parseh_append();
             }
             if (s2 !== peg$FAILED) {
-              if (input.substr(peg$currPos, 2) === peg$c328) {
-                s3 = peg$c328;
+              if (input.substr(peg$currPos, 2) === peg$c369) {
+                s3 = peg$c369;
                 peg$currPos += 2;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c329); }
+                if (peg$silentFails === 0) { peg$fail(peg$c370); }
               }
               if (s3 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c332(s1, s2);
+                s1 = peg$c373(s1, s2);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6801,16 +7791,16 @@ function peg$parse(input, options) {
           }
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            if (input.substr(peg$currPos, 2) === peg$c328) {
-              s1 = peg$c328;
+            if (input.substr(peg$currPos, 2) === peg$c369) {
+              s1 = peg$c369;
               peg$currPos += 2;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c329); }
+              if (peg$silentFails === 0) { peg$fail(peg$c370); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c333();
+              s1 = peg$c374();
             }
             s0 = s1;
           }
@@ -6837,17 +7827,17 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 58) {
-      s1 = peg$c188;
+      s1 = peg$c228;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c189); }
+      if (peg$silentFails === 0) { peg$fail(peg$c229); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseh16();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c334(s2);
+        s1 = peg$c375(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6868,15 +7858,15 @@ function peg$parse(input, options) {
     s1 = peg$parseh16();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 58) {
-        s2 = peg$c188;
+        s2 = peg$c228;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c189); }
+        if (peg$silentFails === 0) { peg$fail(peg$c229); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c335(s1);
+        s1 = peg$c376(s1);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6897,17 +7887,17 @@ function peg$parse(input, options) {
     s1 = peg$parseaddr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c211;
+        s2 = peg$c259;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c212); }
+        if (peg$silentFails === 0) { peg$fail(peg$c260); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c336(s1, s3);
+          s1 = peg$c377(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6932,17 +7922,17 @@ function peg$parse(input, options) {
     s1 = peg$parseip6addr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c211;
+        s2 = peg$c259;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c212); }
+        if (peg$silentFails === 0) { peg$fail(peg$c260); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c337(s1, s3);
+          s1 = peg$c378(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6967,7 +7957,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesuint();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c338(s1);
+      s1 = peg$c379(s1);
     }
     s0 = s1;
 
@@ -7013,12 +8003,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (peg$c339.test(input.charAt(peg$currPos))) {
+    if (peg$c380.test(input.charAt(peg$currPos))) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c340); }
+      if (peg$silentFails === 0) { peg$fail(peg$c381); }
     }
     if (s1 === peg$FAILED) {
       s1 = null;
@@ -7068,11 +8058,11 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 46) {
-          s3 = peg$c267;
+          s3 = peg$c219;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c268); }
+          if (peg$silentFails === 0) { peg$fail(peg$c220); }
         }
         if (s3 !== peg$FAILED) {
           s4 = [];
@@ -7092,7 +8082,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c342();
+              s1 = peg$c383();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7128,11 +8118,11 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 46) {
-          s2 = peg$c267;
+          s2 = peg$c219;
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c268); }
+          if (peg$silentFails === 0) { peg$fail(peg$c220); }
         }
         if (s2 !== peg$FAILED) {
           s3 = [];
@@ -7152,7 +8142,7 @@ function peg$parse(input, options) {
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c342();
+              s1 = peg$c383();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7179,20 +8169,20 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     if (input.charCodeAt(peg$currPos) === 48) {
-      s0 = peg$c343;
+      s0 = peg$c384;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c344); }
+      if (peg$silentFails === 0) { peg$fail(peg$c385); }
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (peg$c345.test(input.charAt(peg$currPos))) {
+      if (peg$c386.test(input.charAt(peg$currPos))) {
         s1 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c346); }
+        if (peg$silentFails === 0) { peg$fail(peg$c387); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
@@ -7247,12 +8237,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c347) {
+    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c388) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c348); }
+      if (peg$silentFails === 0) { peg$fail(peg$c389); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesinteger();
@@ -7287,7 +8277,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c349();
+      s1 = peg$c390();
     }
     s0 = s1;
 
@@ -7297,12 +8287,12 @@ function peg$parse(input, options) {
   function peg$parsehexdigit() {
     var s0;
 
-    if (peg$c350.test(input.charAt(peg$currPos))) {
+    if (peg$c391.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c351); }
+      if (peg$silentFails === 0) { peg$fail(peg$c392); }
     }
 
     return s0;
@@ -7324,7 +8314,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c352(s1);
+      s1 = peg$c393(s1);
     }
     s0 = s1;
 
@@ -7336,11 +8326,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 92) {
-      s1 = peg$c353;
+      s1 = peg$c394;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c354); }
+      if (peg$silentFails === 0) { peg$fail(peg$c395); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseescapeSequence();
@@ -7363,12 +8353,12 @@ function peg$parse(input, options) {
       s0 = peg$currPos;
       s1 = peg$currPos;
       peg$silentFails++;
-      if (peg$c355.test(input.charAt(peg$currPos))) {
+      if (peg$c396.test(input.charAt(peg$currPos))) {
         s2 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c356); }
+        if (peg$silentFails === 0) { peg$fail(peg$c397); }
       }
       if (s2 === peg$FAILED) {
         s2 = peg$parsews();
@@ -7386,7 +8376,7 @@ function peg$parse(input, options) {
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c357); }
+          if (peg$silentFails === 0) { peg$fail(peg$c207); }
         }
         if (s2 !== peg$FAILED) {
           peg$savedPos = s0;
@@ -7410,11 +8400,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s1 = peg$c358;
+      s1 = peg$c205;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c359); }
+      if (peg$silentFails === 0) { peg$fail(peg$c206); }
     }
     if (s1 !== peg$FAILED) {
       s2 = [];
@@ -7425,15 +8415,15 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 34) {
-          s3 = peg$c358;
+          s3 = peg$c205;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c359); }
+          if (peg$silentFails === 0) { peg$fail(peg$c206); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c360(s2);
+          s1 = peg$c398(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7450,11 +8440,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 39) {
-        s1 = peg$c361;
+        s1 = peg$c399;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c362); }
+        if (peg$silentFails === 0) { peg$fail(peg$c400); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
@@ -7465,15 +8455,15 @@ function peg$parse(input, options) {
         }
         if (s2 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 39) {
-            s3 = peg$c361;
+            s3 = peg$c399;
             peg$currPos++;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c362); }
+            if (peg$silentFails === 0) { peg$fail(peg$c400); }
           }
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c360(s2);
+            s1 = peg$c398(s2);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -7499,11 +8489,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s2 = peg$c358;
+      s2 = peg$c205;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c359); }
+      if (peg$silentFails === 0) { peg$fail(peg$c206); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7521,7 +8511,7 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c357); }
+        if (peg$silentFails === 0) { peg$fail(peg$c207); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -7538,11 +8528,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c353;
+        s1 = peg$c394;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c354); }
+        if (peg$silentFails === 0) { peg$fail(peg$c395); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7570,11 +8560,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 39) {
-      s2 = peg$c361;
+      s2 = peg$c399;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c362); }
+      if (peg$silentFails === 0) { peg$fail(peg$c400); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7592,7 +8582,7 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c357); }
+        if (peg$silentFails === 0) { peg$fail(peg$c207); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -7609,11 +8599,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c353;
+        s1 = peg$c394;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c354); }
+        if (peg$silentFails === 0) { peg$fail(peg$c395); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7639,11 +8629,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 120) {
-      s1 = peg$c363;
+      s1 = peg$c401;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c364); }
+      if (peg$silentFails === 0) { peg$fail(peg$c402); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsehexdigit();
@@ -7651,7 +8641,7 @@ function peg$parse(input, options) {
         s3 = peg$parsehexdigit();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c365();
+          s1 = peg$c403();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7679,110 +8669,110 @@ function peg$parse(input, options) {
     var s0, s1;
 
     if (input.charCodeAt(peg$currPos) === 39) {
-      s0 = peg$c361;
+      s0 = peg$c399;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c362); }
+      if (peg$silentFails === 0) { peg$fail(peg$c400); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 34) {
-        s0 = peg$c358;
+        s0 = peg$c205;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c359); }
+        if (peg$silentFails === 0) { peg$fail(peg$c206); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 92) {
-          s0 = peg$c353;
+          s0 = peg$c394;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c354); }
+          if (peg$silentFails === 0) { peg$fail(peg$c395); }
         }
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
           if (input.charCodeAt(peg$currPos) === 98) {
-            s1 = peg$c366;
+            s1 = peg$c404;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c367); }
+            if (peg$silentFails === 0) { peg$fail(peg$c405); }
           }
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c368();
+            s1 = peg$c406();
           }
           s0 = s1;
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
             if (input.charCodeAt(peg$currPos) === 102) {
-              s1 = peg$c369;
+              s1 = peg$c407;
               peg$currPos++;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c370); }
+              if (peg$silentFails === 0) { peg$fail(peg$c408); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c371();
+              s1 = peg$c409();
             }
             s0 = s1;
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
               if (input.charCodeAt(peg$currPos) === 110) {
-                s1 = peg$c372;
+                s1 = peg$c410;
                 peg$currPos++;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c373); }
+                if (peg$silentFails === 0) { peg$fail(peg$c411); }
               }
               if (s1 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c374();
+                s1 = peg$c412();
               }
               s0 = s1;
               if (s0 === peg$FAILED) {
                 s0 = peg$currPos;
                 if (input.charCodeAt(peg$currPos) === 114) {
-                  s1 = peg$c375;
+                  s1 = peg$c413;
                   peg$currPos++;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c376); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c414); }
                 }
                 if (s1 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c377();
+                  s1 = peg$c415();
                 }
                 s0 = s1;
                 if (s0 === peg$FAILED) {
                   s0 = peg$currPos;
                   if (input.charCodeAt(peg$currPos) === 116) {
-                    s1 = peg$c378;
+                    s1 = peg$c416;
                     peg$currPos++;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c379); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c417); }
                   }
                   if (s1 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c380();
+                    s1 = peg$c418();
                   }
                   s0 = s1;
                   if (s0 === peg$FAILED) {
                     s0 = peg$currPos;
                     if (input.charCodeAt(peg$currPos) === 118) {
-                      s1 = peg$c381;
+                      s1 = peg$c419;
                       peg$currPos++;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c382); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c420); }
                     }
                     if (s1 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c383();
+                      s1 = peg$c421();
                     }
                     s0 = s1;
                   }
@@ -7810,7 +8800,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c384();
+      s1 = peg$c422();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -7824,7 +8814,7 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c385();
+        s1 = peg$c423();
       }
       s0 = s1;
     }
@@ -7837,11 +8827,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 117) {
-      s1 = peg$c386;
+      s1 = peg$c424;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c387); }
+      if (peg$silentFails === 0) { peg$fail(peg$c425); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$currPos;
@@ -7873,7 +8863,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c388(s2);
+        s1 = peg$c426(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -7886,19 +8876,19 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 117) {
-        s1 = peg$c386;
+        s1 = peg$c424;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c387); }
+        if (peg$silentFails === 0) { peg$fail(peg$c425); }
       }
       if (s1 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 123) {
-          s2 = peg$c389;
+          s2 = peg$c222;
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c390); }
+          if (peg$silentFails === 0) { peg$fail(peg$c223); }
         }
         if (s2 !== peg$FAILED) {
           s3 = peg$currPos;
@@ -7957,15 +8947,15 @@ function peg$parse(input, options) {
           }
           if (s3 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 125) {
-              s4 = peg$c391;
+              s4 = peg$c225;
               peg$currPos++;
             } else {
               s4 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c392); }
+              if (peg$silentFails === 0) { peg$fail(peg$c226); }
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c388(s3);
+              s1 = peg$c426(s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7993,21 +8983,21 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 47) {
-      s1 = peg$c211;
+      s1 = peg$c259;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c212); }
+      if (peg$silentFails === 0) { peg$fail(peg$c260); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsereBody();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 47) {
-          s3 = peg$c211;
+          s3 = peg$c259;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c212); }
+          if (peg$silentFails === 0) { peg$fail(peg$c260); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
@@ -8034,39 +9024,39 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     s1 = [];
-    if (peg$c393.test(input.charAt(peg$currPos))) {
+    if (peg$c427.test(input.charAt(peg$currPos))) {
       s2 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c394); }
+      if (peg$silentFails === 0) { peg$fail(peg$c428); }
     }
     if (s2 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c395) {
-        s2 = peg$c395;
+      if (input.substr(peg$currPos, 2) === peg$c429) {
+        s2 = peg$c429;
         peg$currPos += 2;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c396); }
+        if (peg$silentFails === 0) { peg$fail(peg$c430); }
       }
     }
     if (s2 !== peg$FAILED) {
       while (s2 !== peg$FAILED) {
         s1.push(s2);
-        if (peg$c393.test(input.charAt(peg$currPos))) {
+        if (peg$c427.test(input.charAt(peg$currPos))) {
           s2 = input.charAt(peg$currPos);
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c394); }
+          if (peg$silentFails === 0) { peg$fail(peg$c428); }
         }
         if (s2 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c395) {
-            s2 = peg$c395;
+          if (input.substr(peg$currPos, 2) === peg$c429) {
+            s2 = peg$c429;
             peg$currPos += 2;
           } else {
             s2 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c396); }
+            if (peg$silentFails === 0) { peg$fail(peg$c430); }
           }
         }
       }
@@ -8085,12 +9075,12 @@ function peg$parse(input, options) {
   function peg$parseescapedChar() {
     var s0;
 
-    if (peg$c397.test(input.charAt(peg$currPos))) {
+    if (peg$c431.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c398); }
+      if (peg$silentFails === 0) { peg$fail(peg$c432); }
     }
 
     return s0;
@@ -8100,51 +9090,51 @@ function peg$parse(input, options) {
     var s0;
 
     if (input.charCodeAt(peg$currPos) === 9) {
-      s0 = peg$c399;
+      s0 = peg$c433;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c400); }
+      if (peg$silentFails === 0) { peg$fail(peg$c434); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 11) {
-        s0 = peg$c401;
+        s0 = peg$c435;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c402); }
+        if (peg$silentFails === 0) { peg$fail(peg$c436); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 12) {
-          s0 = peg$c403;
+          s0 = peg$c437;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c404); }
+          if (peg$silentFails === 0) { peg$fail(peg$c438); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 32) {
-            s0 = peg$c405;
+            s0 = peg$c439;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c406); }
+            if (peg$silentFails === 0) { peg$fail(peg$c440); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 160) {
-              s0 = peg$c407;
+              s0 = peg$c441;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c408); }
+              if (peg$silentFails === 0) { peg$fail(peg$c442); }
             }
             if (s0 === peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 65279) {
-                s0 = peg$c409;
+                s0 = peg$c443;
                 peg$currPos++;
               } else {
                 s0 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c410); }
+                if (peg$silentFails === 0) { peg$fail(peg$c444); }
               }
             }
           }
@@ -8172,7 +9162,7 @@ function peg$parse(input, options) {
     peg$silentFails--;
     if (s0 === peg$FAILED) {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c411); }
+      if (peg$silentFails === 0) { peg$fail(peg$c445); }
     }
 
     return s0;
@@ -8201,7 +9191,7 @@ function peg$parse(input, options) {
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c357); }
+      if (peg$silentFails === 0) { peg$fail(peg$c207); }
     }
     peg$silentFails--;
     if (s1 === peg$FAILED) {
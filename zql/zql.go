@@ -79,33 +79,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 18, col: 5, offset: 358},
+						pos: position{line: 18, col: 5, offset: 357},
 						run: (*parser).callonquery5,
 						expr: &seqExpr{
-							pos: position{line: 18, col: 5, offset: 358},
+							pos: position{line: 18, col: 5, offset: 357},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 18, col: 5, offset: 358},
+									pos:   position{line: 18, col: 5, offset: 357},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 18, col: 7, offset: 360},
+										pos:  position{line: 18, col: 7, offset: 359},
 										name: "search",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 18, col: 14, offset: 367},
+									pos: position{line: 18, col: 14, offset: 366},
 									expr: &ruleRefExpr{
-										pos:  position{line: 18, col: 14, offset: 367},
+										pos:  position{line: 18, col: 14, offset: 366},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 18, col: 17, offset: 370},
+									pos:   position{line: 18, col: 17, offset: 369},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 18, col: 22, offset: 375},
+										pos: position{line: 18, col: 22, offset: 374},
 										expr: &ruleRefExpr{
-											pos:  position{line: 18, col: 22, offset: 375},
+											pos:  position{line: 18, col: 22, offset: 374},
 											name: "chainedProc",
 										},
 									},
@@ -114,13 +114,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 25, col: 5, offset: 623},
+						pos: position{line: 25, col: 5, offset: 621},
 						run: (*parser).callonquery14,
 						expr: &labeledExpr{
-							pos:   position{line: 25, col: 5, offset: 623},
+							pos:   position{line: 25, col: 5, offset: 621},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 25, col: 7, offset: 625},
+								pos:  position{line: 25, col: 7, offset: 623},
 								name: "search",
 							},
 						},
@@ -130,28 +130,28 @@ var g = &grammar{
 		},
 		{
 			name: "procChain",
-			pos:  position{line: 29, col: 1, offset: 733},
+			pos:  position{line: 29, col: 1, offset: 731},
 			expr: &actionExpr{
-				pos: position{line: 30, col: 5, offset: 747},
+				pos: position{line: 30, col: 5, offset: 745},
 				run: (*parser).callonprocChain1,
 				expr: &seqExpr{
-					pos: position{line: 30, col: 5, offset: 747},
+					pos: position{line: 30, col: 5, offset: 745},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 30, col: 5, offset: 747},
+							pos:   position{line: 30, col: 5, offset: 745},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 30, col: 11, offset: 753},
+								pos:  position{line: 30, col: 11, offset: 751},
 								name: "proc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 30, col: 16, offset: 758},
+							pos:   position{line: 30, col: 16, offset: 756},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 30, col: 21, offset: 763},
+								pos: position{line: 30, col: 21, offset: 761},
 								expr: &ruleRefExpr{
-									pos:  position{line: 30, col: 21, offset: 763},
+									pos:  position{line: 30, col: 21, offset: 761},
 									name: "chainedProc",
 								},
 							},
@@ -162,37 +162,37 @@ var g = &grammar{
 		},
 		{
 			name: "chainedProc",
-			pos:  position{line: 38, col: 1, offset: 949},
+			pos:  position{line: 38, col: 1, offset: 946},
 			expr: &actionExpr{
-				pos: position{line: 38, col: 15, offset: 963},
+				pos: position{line: 38, col: 15, offset: 960},
 				run: (*parser).callonchainedProc1,
 				expr: &seqExpr{
-					pos: position{line: 38, col: 15, offset: 963},
+					pos: position{line: 38, col: 15, offset: 960},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 38, col: 15, offset: 963},
+							pos: position{line: 38, col: 15, offset: 960},
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 15, offset: 963},
+								pos:  position{line: 38, col: 15, offset: 960},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 38, col: 18, offset: 966},
+							pos:        position{line: 38, col: 18, offset: 963},
 							val:        "|",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 38, col: 22, offset: 970},
+							pos: position{line: 38, col: 22, offset: 967},
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 22, offset: 970},
+								pos:  position{line: 38, col: 22, offset: 967},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 38, col: 25, offset: 973},
+							pos:   position{line: 38, col: 25, offset: 970},
 							label: "p",
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 27, offset: 975},
+								pos:  position{line: 38, col: 27, offset: 972},
 								name: "proc",
 							},
 						},
@@ -202,15 +202,15 @@ var g = &grammar{
 		},
 		{
 			name: "search",
-			pos:  position{line: 40, col: 1, offset: 999},
+			pos:  position{line: 40, col: 1, offset: 996},
 			expr: &actionExpr{
-				pos: position{line: 41, col: 5, offset: 1010},
+				pos: position{line: 41, col: 5, offset: 1007},
 				run: (*parser).callonsearch1,
 				expr: &labeledExpr{
-					pos:   position{line: 41, col: 5, offset: 1010},
+					pos:   position{line: 41, col: 5, offset: 1007},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 41, col: 10, offset: 1015},
+						pos:  position{line: 41, col: 10, offset: 1012},
 						name: "searchExpr",
 					},
 				},
@@ -218,28 +218,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchExpr",
-			pos:  position{line: 45, col: 1, offset: 1112},
+			pos:  position{line: 45, col: 1, offset: 1109},
 			expr: &actionExpr{
-				pos: position{line: 46, col: 5, offset: 1127},
+				pos: position{line: 46, col: 5, offset: 1124},
 				run: (*parser).callonsearchExpr1,
 				expr: &seqExpr{
-					pos: position{line: 46, col: 5, offset: 1127},
+					pos: position{line: 46, col: 5, offset: 1124},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 46, col: 5, offset: 1127},
+							pos:   position{line: 46, col: 5, offset: 1124},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 46, col: 11, offset: 1133},
+								pos:  position{line: 46, col: 11, offset: 1130},
 								name: "searchTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 46, col: 22, offset: 1144},
+							pos:   position{line: 46, col: 22, offset: 1141},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 46, col: 27, offset: 1149},
+								pos: position{line: 46, col: 27, offset: 1146},
 								expr: &ruleRefExpr{
-									pos:  position{line: 46, col: 27, offset: 1149},
+									pos:  position{line: 46, col: 27, offset: 1146},
 									name: "oredSearchTerm",
 								},
 							},
@@ -250,30 +250,30 @@ var g = &grammar{
 		},
 		{
 			name: "oredSearchTerm",
-			pos:  position{line: 50, col: 1, offset: 1228},
+			pos:  position{line: 50, col: 1, offset: 1225},
 			expr: &actionExpr{
-				pos: position{line: 50, col: 18, offset: 1245},
+				pos: position{line: 50, col: 18, offset: 1242},
 				run: (*parser).callonoredSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 50, col: 18, offset: 1245},
+					pos: position{line: 50, col: 18, offset: 1242},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 18, offset: 1245},
+							pos:  position{line: 50, col: 18, offset: 1242},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 20, offset: 1247},
+							pos:  position{line: 50, col: 20, offset: 1244},
 							name: "orToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 28, offset: 1255},
+							pos:  position{line: 50, col: 28, offset: 1252},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 50, col: 30, offset: 1257},
+							pos:   position{line: 50, col: 30, offset: 1254},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 50, col: 32, offset: 1259},
+								pos:  position{line: 50, col: 32, offset: 1256},
 								name: "searchTerm",
 							},
 						},
@@ -283,28 +283,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchTerm",
-			pos:  position{line: 52, col: 1, offset: 1289},
+			pos:  position{line: 52, col: 1, offset: 1286},
 			expr: &actionExpr{
-				pos: position{line: 53, col: 5, offset: 1304},
+				pos: position{line: 53, col: 5, offset: 1301},
 				run: (*parser).callonsearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 53, col: 5, offset: 1304},
+					pos: position{line: 53, col: 5, offset: 1301},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 53, col: 5, offset: 1304},
+							pos:   position{line: 53, col: 5, offset: 1301},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 53, col: 11, offset: 1310},
+								pos:  position{line: 53, col: 11, offset: 1307},
 								name: "searchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 53, col: 24, offset: 1323},
+							pos:   position{line: 53, col: 24, offset: 1320},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 53, col: 29, offset: 1328},
+								pos: position{line: 53, col: 29, offset: 1325},
 								expr: &ruleRefExpr{
-									pos:  position{line: 53, col: 29, offset: 1328},
+									pos:  position{line: 53, col: 29, offset: 1325},
 									name: "andedSearchTerm",
 								},
 							},
@@ -315,38 +315,38 @@ var g = &grammar{
 		},
 		{
 			name: "andedSearchTerm",
-			pos:  position{line: 57, col: 1, offset: 1409},
+			pos:  position{line: 57, col: 1, offset: 1406},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 19, offset: 1427},
+				pos: position{line: 57, col: 19, offset: 1424},
 				run: (*parser).callonandedSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 19, offset: 1427},
+					pos: position{line: 57, col: 19, offset: 1424},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 19, offset: 1427},
+							pos:  position{line: 57, col: 19, offset: 1424},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 57, col: 21, offset: 1429},
+							pos: position{line: 57, col: 21, offset: 1426},
 							expr: &seqExpr{
-								pos: position{line: 57, col: 22, offset: 1430},
+								pos: position{line: 57, col: 22, offset: 1427},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 57, col: 22, offset: 1430},
+										pos:  position{line: 57, col: 22, offset: 1427},
 										name: "andToken",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 57, col: 31, offset: 1439},
+										pos:  position{line: 57, col: 31, offset: 1436},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 35, offset: 1443},
+							pos:   position{line: 57, col: 35, offset: 1440},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 37, offset: 1445},
+								pos:  position{line: 57, col: 37, offset: 1442},
 								name: "searchFactor",
 							},
 						},
@@ -356,44 +356,44 @@ var g = &grammar{
 		},
 		{
 			name: "searchFactor",
-			pos:  position{line: 59, col: 1, offset: 1477},
+			pos:  position{line: 59, col: 1, offset: 1474},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 5, offset: 1494},
+				pos: position{line: 60, col: 5, offset: 1491},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 60, col: 5, offset: 1494},
+						pos: position{line: 60, col: 5, offset: 1491},
 						run: (*parser).callonsearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 60, col: 5, offset: 1494},
+							pos: position{line: 60, col: 5, offset: 1491},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 60, col: 6, offset: 1495},
+									pos: position{line: 60, col: 6, offset: 1492},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 60, col: 6, offset: 1495},
+											pos: position{line: 60, col: 6, offset: 1492},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 60, col: 6, offset: 1495},
+													pos:  position{line: 60, col: 6, offset: 1492},
 													name: "notToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 60, col: 15, offset: 1504},
+													pos:  position{line: 60, col: 15, offset: 1501},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 60, col: 19, offset: 1508},
+											pos: position{line: 60, col: 19, offset: 1505},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 60, col: 19, offset: 1508},
+													pos:        position{line: 60, col: 19, offset: 1505},
 													val:        "!",
 													ignoreCase: false,
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 60, col: 23, offset: 1512},
+													pos: position{line: 60, col: 23, offset: 1509},
 													expr: &ruleRefExpr{
-														pos:  position{line: 60, col: 23, offset: 1512},
+														pos:  position{line: 60, col: 23, offset: 1509},
 														name: "_",
 													},
 												},
@@ -402,10 +402,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 60, col: 27, offset: 1516},
+									pos:   position{line: 60, col: 27, offset: 1513},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 60, col: 29, offset: 1518},
+										pos:  position{line: 60, col: 29, offset: 1515},
 										name: "searchExpr",
 									},
 								},
@@ -413,24 +413,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 63, col: 5, offset: 1613},
+						pos: position{line: 63, col: 5, offset: 1610},
 						run: (*parser).callonsearchFactor14,
 						expr: &seqExpr{
-							pos: position{line: 63, col: 5, offset: 1613},
+							pos: position{line: 63, col: 5, offset: 1610},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 63, col: 5, offset: 1613},
+									pos: position{line: 63, col: 5, offset: 1610},
 									expr: &litMatcher{
-										pos:        position{line: 63, col: 7, offset: 1615},
+										pos:        position{line: 63, col: 7, offset: 1612},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 63, col: 12, offset: 1620},
+									pos:   position{line: 63, col: 12, offset: 1617},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 63, col: 14, offset: 1622},
+										pos:  position{line: 63, col: 14, offset: 1619},
 										name: "searchPred",
 									},
 								},
@@ -438,40 +438,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 64, col: 5, offset: 1655},
+						pos: position{line: 64, col: 5, offset: 1652},
 						run: (*parser).callonsearchFactor20,
 						expr: &seqExpr{
-							pos: position{line: 64, col: 5, offset: 1655},
+							pos: position{line: 64, col: 5, offset: 1652},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 64, col: 5, offset: 1655},
+									pos:        position{line: 64, col: 5, offset: 1652},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 64, col: 9, offset: 1659},
+									pos: position{line: 64, col: 9, offset: 1656},
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 9, offset: 1659},
+										pos:  position{line: 64, col: 9, offset: 1656},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 64, col: 12, offset: 1662},
+									pos:   position{line: 64, col: 12, offset: 1659},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 17, offset: 1667},
+										pos:  position{line: 64, col: 17, offset: 1664},
 										name: "searchExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 64, col: 28, offset: 1678},
+									pos: position{line: 64, col: 28, offset: 1675},
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 28, offset: 1678},
+										pos:  position{line: 64, col: 28, offset: 1675},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 64, col: 31, offset: 1681},
+									pos:        position{line: 64, col: 31, offset: 1678},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -483,48 +483,48 @@ var g = &grammar{
 		},
 		{
 			name: "searchPred",
-			pos:  position{line: 66, col: 1, offset: 1707},
+			pos:  position{line: 66, col: 1, offset: 1704},
 			expr: &choiceExpr{
-				pos: position{line: 67, col: 5, offset: 1722},
+				pos: position{line: 67, col: 5, offset: 1719},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 67, col: 5, offset: 1722},
+						pos: position{line: 67, col: 5, offset: 1719},
 						run: (*parser).callonsearchPred2,
 						expr: &seqExpr{
-							pos: position{line: 67, col: 5, offset: 1722},
+							pos: position{line: 67, col: 5, offset: 1719},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 67, col: 5, offset: 1722},
+									pos:        position{line: 67, col: 5, offset: 1719},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 67, col: 9, offset: 1726},
+									pos: position{line: 67, col: 9, offset: 1723},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 9, offset: 1726},
+										pos:  position{line: 67, col: 9, offset: 1723},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 12, offset: 1729},
+									pos:   position{line: 67, col: 12, offset: 1726},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 17, offset: 1734},
+										pos:  position{line: 67, col: 17, offset: 1731},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 67, col: 31, offset: 1748},
+									pos: position{line: 67, col: 31, offset: 1745},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 31, offset: 1748},
+										pos:  position{line: 67, col: 31, offset: 1745},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 34, offset: 1751},
+									pos:   position{line: 67, col: 34, offset: 1748},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 36, offset: 1753},
+										pos:  position{line: 67, col: 36, offset: 1750},
 										name: "searchValue",
 									},
 								},
@@ -532,43 +532,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 70, col: 5, offset: 1890},
+						pos: position{line: 70, col: 5, offset: 1887},
 						run: (*parser).callonsearchPred13,
 						expr: &seqExpr{
-							pos: position{line: 70, col: 5, offset: 1890},
+							pos: position{line: 70, col: 5, offset: 1887},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 70, col: 5, offset: 1890},
+									pos:        position{line: 70, col: 5, offset: 1887},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 70, col: 10, offset: 1895},
+									pos: position{line: 70, col: 10, offset: 1892},
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 10, offset: 1895},
+										pos:  position{line: 70, col: 10, offset: 1892},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 70, col: 13, offset: 1898},
+									pos:   position{line: 70, col: 13, offset: 1895},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 18, offset: 1903},
+										pos:  position{line: 70, col: 18, offset: 1900},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 70, col: 32, offset: 1917},
+									pos: position{line: 70, col: 32, offset: 1914},
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 32, offset: 1917},
+										pos:  position{line: 70, col: 32, offset: 1914},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 70, col: 35, offset: 1920},
+									pos:   position{line: 70, col: 35, offset: 1917},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 37, offset: 1922},
+										pos:  position{line: 70, col: 37, offset: 1919},
 										name: "searchValue",
 									},
 								},
@@ -576,46 +576,46 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 2058},
+						pos: position{line: 73, col: 5, offset: 2055},
 						run: (*parser).callonsearchPred24,
 						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 2058},
+							pos: position{line: 73, col: 5, offset: 2055},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 73, col: 5, offset: 2058},
+									pos:   position{line: 73, col: 5, offset: 2055},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 7, offset: 2060},
+										pos:  position{line: 73, col: 7, offset: 2057},
 										name: "fieldExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 73, col: 17, offset: 2070},
+									pos: position{line: 73, col: 17, offset: 2067},
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 17, offset: 2070},
+										pos:  position{line: 73, col: 17, offset: 2067},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 20, offset: 2073},
+									pos:   position{line: 73, col: 20, offset: 2070},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 25, offset: 2078},
+										pos:  position{line: 73, col: 25, offset: 2075},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 73, col: 39, offset: 2092},
+									pos: position{line: 73, col: 39, offset: 2089},
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 39, offset: 2092},
+										pos:  position{line: 73, col: 39, offset: 2089},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 42, offset: 2095},
+									pos:   position{line: 73, col: 42, offset: 2092},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 44, offset: 2097},
+										pos:  position{line: 73, col: 44, offset: 2094},
 										name: "searchValue",
 									},
 								},
@@ -623,46 +623,46 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 76, col: 5, offset: 2228},
+						pos: position{line: 76, col: 5, offset: 2225},
 						run: (*parser).callonsearchPred36,
 						expr: &seqExpr{
-							pos: position{line: 76, col: 5, offset: 2228},
+							pos: position{line: 76, col: 5, offset: 2225},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 76, col: 5, offset: 2228},
+									pos:   position{line: 76, col: 5, offset: 2225},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 10, offset: 2233},
+										pos:  position{line: 76, col: 10, offset: 2230},
 										name: "FunctionExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 23, offset: 2246},
+									pos: position{line: 76, col: 23, offset: 2243},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 23, offset: 2246},
+										pos:  position{line: 76, col: 23, offset: 2243},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 76, col: 26, offset: 2249},
+									pos:   position{line: 76, col: 26, offset: 2246},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 31, offset: 2254},
+										pos:  position{line: 76, col: 31, offset: 2251},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 45, offset: 2268},
+									pos: position{line: 76, col: 45, offset: 2265},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 45, offset: 2268},
+										pos:  position{line: 76, col: 45, offset: 2265},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 76, col: 48, offset: 2271},
+									pos:   position{line: 76, col: 48, offset: 2268},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 50, offset: 2273},
+										pos:  position{line: 76, col: 50, offset: 2270},
 										name: "searchValue",
 									},
 								},
@@ -670,39 +670,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 79, col: 5, offset: 2405},
+						pos: position{line: 79, col: 5, offset: 2402},
 						run: (*parser).callonsearchPred48,
 						expr: &seqExpr{
-							pos: position{line: 79, col: 5, offset: 2405},
+							pos: position{line: 79, col: 5, offset: 2402},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 79, col: 5, offset: 2405},
+									pos:   position{line: 79, col: 5, offset: 2402},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 7, offset: 2407},
+										pos:  position{line: 79, col: 7, offset: 2404},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 19, offset: 2419},
+									pos: position{line: 79, col: 19, offset: 2416},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 19, offset: 2419},
+										pos:  position{line: 79, col: 19, offset: 2416},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 79, col: 22, offset: 2422},
+									pos:  position{line: 79, col: 22, offset: 2419},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 30, offset: 2430},
+									pos: position{line: 79, col: 30, offset: 2427},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 30, offset: 2430},
+										pos:  position{line: 79, col: 30, offset: 2427},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 79, col: 33, offset: 2433},
+									pos:        position{line: 79, col: 33, offset: 2430},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -710,42 +710,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 82, col: 5, offset: 2562},
+						pos: position{line: 82, col: 5, offset: 2559},
 						run: (*parser).callonsearchPred58,
 						expr: &seqExpr{
-							pos: position{line: 82, col: 5, offset: 2562},
+							pos: position{line: 82, col: 5, offset: 2559},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 82, col: 5, offset: 2562},
+									pos:   position{line: 82, col: 5, offset: 2559},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 82, col: 7, offset: 2564},
+										pos:  position{line: 82, col: 7, offset: 2561},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 82, col: 19, offset: 2576},
+									pos: position{line: 82, col: 19, offset: 2573},
 									expr: &ruleRefExpr{
-										pos:  position{line: 82, col: 19, offset: 2576},
+										pos:  position{line: 82, col: 19, offset: 2573},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 82, col: 22, offset: 2579},
+									pos:  position{line: 82, col: 22, offset: 2576},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 82, col: 30, offset: 2587},
+									pos: position{line: 82, col: 30, offset: 2584},
 									expr: &ruleRefExpr{
-										pos:  position{line: 82, col: 30, offset: 2587},
+										pos:  position{line: 82, col: 30, offset: 2584},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 82, col: 33, offset: 2590},
+									pos:   position{line: 82, col: 33, offset: 2587},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 82, col: 35, offset: 2592},
+										pos:  position{line: 82, col: 35, offset: 2589},
 										name: "fieldExpr",
 									},
 								},
@@ -753,44 +753,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 85, col: 5, offset: 2721},
+						pos: position{line: 85, col: 5, offset: 2718},
 						run: (*parser).callonsearchPred69,
 						expr: &labeledExpr{
-							pos:   position{line: 85, col: 5, offset: 2721},
+							pos:   position{line: 85, col: 5, offset: 2718},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 85, col: 7, offset: 2723},
+								pos:  position{line: 85, col: 7, offset: 2720},
 								name: "searchLiteral",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 88, col: 5, offset: 2842},
+						pos: position{line: 88, col: 5, offset: 2839},
 						run: (*parser).callonsearchPred72,
 						expr: &seqExpr{
-							pos: position{line: 88, col: 5, offset: 2842},
+							pos: position{line: 88, col: 5, offset: 2839},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 88, col: 5, offset: 2842},
+									pos: position{line: 88, col: 5, offset: 2839},
 									expr: &seqExpr{
-										pos: position{line: 88, col: 7, offset: 2844},
+										pos: position{line: 88, col: 7, offset: 2841},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 88, col: 8, offset: 2845},
+												pos:  position{line: 88, col: 8, offset: 2842},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 88, col: 24, offset: 2861},
+												pos:  position{line: 88, col: 24, offset: 2858},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 88, col: 28, offset: 2865},
+									pos:   position{line: 88, col: 28, offset: 2862},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 88, col: 30, offset: 2867},
+										pos:  position{line: 88, col: 30, offset: 2864},
 										name: "searchWord",
 									},
 								},
@@ -802,48 +802,48 @@ var g = &grammar{
 		},
 		{
 			name: "searchLiteral",
-			pos:  position{line: 101, col: 1, offset: 3319},
+			pos:  position{line: 101, col: 1, offset: 3316},
 			expr: &choiceExpr{
-				pos: position{line: 102, col: 5, offset: 3337},
+				pos: position{line: 102, col: 5, offset: 3334},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 102, col: 5, offset: 3337},
+						pos:  position{line: 102, col: 5, offset: 3334},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 103, col: 5, offset: 3355},
+						pos:  position{line: 103, col: 5, offset: 3352},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 104, col: 5, offset: 3373},
+						pos:  position{line: 104, col: 5, offset: 3370},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 105, col: 5, offset: 3391},
+						pos:  position{line: 105, col: 5, offset: 3388},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 106, col: 5, offset: 3410},
+						pos:  position{line: 106, col: 5, offset: 3407},
 						name: "FloatLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 3577},
+						pos: position{line: 110, col: 5, offset: 3427},
 						run: (*parser).callonsearchLiteral7,
 						expr: &seqExpr{
-							pos: position{line: 110, col: 5, offset: 3577},
+							pos: position{line: 110, col: 5, offset: 3427},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 110, col: 5, offset: 3577},
+									pos:   position{line: 110, col: 5, offset: 3427},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 110, col: 7, offset: 3579},
+										pos:  position{line: 110, col: 7, offset: 3429},
 										name: "IntegerLiteral",
 									},
 								},
 								&notExpr{
-									pos: position{line: 110, col: 22, offset: 3594},
+									pos: position{line: 110, col: 22, offset: 3444},
 									expr: &ruleRefExpr{
-										pos:  position{line: 110, col: 23, offset: 3595},
+										pos:  position{line: 110, col: 23, offset: 3445},
 										name: "searchWord",
 									},
 								},
@@ -851,32 +851,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 112, col: 5, offset: 3629},
+						pos: position{line: 112, col: 5, offset: 3479},
 						run: (*parser).callonsearchLiteral13,
 						expr: &seqExpr{
-							pos: position{line: 112, col: 5, offset: 3629},
+							pos: position{line: 112, col: 5, offset: 3479},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 112, col: 5, offset: 3629},
+									pos: position{line: 112, col: 5, offset: 3479},
 									expr: &seqExpr{
-										pos: position{line: 112, col: 7, offset: 3631},
+										pos: position{line: 112, col: 7, offset: 3481},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 112, col: 7, offset: 3631},
+												pos:  position{line: 112, col: 7, offset: 3481},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 112, col: 22, offset: 3646},
+												pos:  position{line: 112, col: 22, offset: 3496},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 112, col: 25, offset: 3649},
+									pos:   position{line: 112, col: 25, offset: 3499},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 112, col: 27, offset: 3651},
+										pos:  position{line: 112, col: 27, offset: 3501},
 										name: "BooleanLiteral",
 									},
 								},
@@ -884,32 +884,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 113, col: 5, offset: 3688},
+						pos: position{line: 113, col: 5, offset: 3538},
 						run: (*parser).callonsearchLiteral21,
 						expr: &seqExpr{
-							pos: position{line: 113, col: 5, offset: 3688},
+							pos: position{line: 113, col: 5, offset: 3538},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 113, col: 5, offset: 3688},
+									pos: position{line: 113, col: 5, offset: 3538},
 									expr: &seqExpr{
-										pos: position{line: 113, col: 7, offset: 3690},
+										pos: position{line: 113, col: 7, offset: 3540},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 113, col: 7, offset: 3690},
+												pos:  position{line: 113, col: 7, offset: 3540},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 113, col: 22, offset: 3705},
+												pos:  position{line: 113, col: 22, offset: 3555},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 113, col: 25, offset: 3708},
+									pos:   position{line: 113, col: 25, offset: 3558},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 113, col: 27, offset: 3710},
+										pos:  position{line: 113, col: 27, offset: 3560},
 										name: "NullLiteral",
 									},
 								},
@@ -921,41 +921,41 @@ var g = &grammar{
 		},
 		{
 			name: "searchValue",
-			pos:  position{line: 116, col: 1, offset: 3742},
+			pos:  position{line: 116, col: 1, offset: 3592},
 			expr: &choiceExpr{
-				pos: position{line: 117, col: 5, offset: 3758},
+				pos: position{line: 117, col: 5, offset: 3608},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 117, col: 5, offset: 3758},
+						pos:  position{line: 117, col: 5, offset: 3608},
 						name: "searchLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 118, col: 5, offset: 3776},
+						pos: position{line: 118, col: 5, offset: 3626},
 						run: (*parser).callonsearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 118, col: 5, offset: 3776},
+							pos: position{line: 118, col: 5, offset: 3626},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 118, col: 5, offset: 3776},
+									pos: position{line: 118, col: 5, offset: 3626},
 									expr: &seqExpr{
-										pos: position{line: 118, col: 7, offset: 3778},
+										pos: position{line: 118, col: 7, offset: 3628},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 118, col: 8, offset: 3779},
+												pos:  position{line: 118, col: 8, offset: 3629},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 118, col: 24, offset: 3795},
+												pos:  position{line: 118, col: 24, offset: 3645},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 118, col: 27, offset: 3798},
+									pos:   position{line: 118, col: 27, offset: 3648},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 118, col: 29, offset: 3800},
+										pos:  position{line: 118, col: 29, offset: 3650},
 										name: "searchWord",
 									},
 								},
@@ -967,15 +967,15 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 122, col: 1, offset: 3908},
+			pos:  position{line: 122, col: 1, offset: 3758},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 5, offset: 3926},
+				pos: position{line: 123, col: 5, offset: 3776},
 				run: (*parser).callonStringLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 123, col: 5, offset: 3926},
+					pos:   position{line: 123, col: 5, offset: 3776},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 123, col: 7, offset: 3928},
+						pos:  position{line: 123, col: 7, offset: 3778},
 						name: "quotedString",
 					},
 				},
@@ -983,15 +983,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpLiteral",
-			pos:  position{line: 127, col: 1, offset: 4038},
+			pos:  position{line: 127, col: 1, offset: 3888},
 			expr: &actionExpr{
-				pos: position{line: 128, col: 5, offset: 4056},
+				pos: position{line: 128, col: 5, offset: 3906},
 				run: (*parser).callonRegexpLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 128, col: 5, offset: 4056},
+					pos:   position{line: 128, col: 5, offset: 3906},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 128, col: 7, offset: 4058},
+						pos:  position{line: 128, col: 7, offset: 3908},
 						name: "reString",
 					},
 				},
@@ -999,28 +999,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 132, col: 1, offset: 4164},
+			pos:  position{line: 132, col: 1, offset: 4014},
 			expr: &choiceExpr{
-				pos: position{line: 133, col: 5, offset: 4182},
+				pos: position{line: 133, col: 5, offset: 4032},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 133, col: 5, offset: 4182},
+						pos: position{line: 133, col: 5, offset: 4032},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 133, col: 5, offset: 4182},
+							pos: position{line: 133, col: 5, offset: 4032},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 133, col: 5, offset: 4182},
+									pos:   position{line: 133, col: 5, offset: 4032},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 133, col: 7, offset: 4184},
+										pos:  position{line: 133, col: 7, offset: 4034},
 										name: "ip6subnet",
 									},
 								},
 								&notExpr{
-									pos: position{line: 133, col: 17, offset: 4194},
+									pos: position{line: 133, col: 17, offset: 4044},
 									expr: &ruleRefExpr{
-										pos:  position{line: 133, col: 18, offset: 4195},
+										pos:  position{line: 133, col: 18, offset: 4045},
 										name: "fieldNameRest",
 									},
 								},
@@ -1028,13 +1028,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 4306},
+						pos: position{line: 136, col: 5, offset: 4156},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 136, col: 5, offset: 4306},
+							pos:   position{line: 136, col: 5, offset: 4156},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 136, col: 7, offset: 4308},
+								pos:  position{line: 136, col: 7, offset: 4158},
 								name: "subnet",
 							},
 						},
@@ -1044,28 +1044,28 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 140, col: 1, offset: 4409},
+			pos:  position{line: 140, col: 1, offset: 4259},
 			expr: &choiceExpr{
-				pos: position{line: 141, col: 5, offset: 4428},
+				pos: position{line: 141, col: 5, offset: 4278},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 141, col: 5, offset: 4428},
+						pos: position{line: 141, col: 5, offset: 4278},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 141, col: 5, offset: 4428},
+							pos: position{line: 141, col: 5, offset: 4278},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 141, col: 5, offset: 4428},
+									pos:   position{line: 141, col: 5, offset: 4278},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 141, col: 7, offset: 4430},
+										pos:  position{line: 141, col: 7, offset: 4280},
 										name: "ip6addr",
 									},
 								},
 								&notExpr{
-									pos: position{line: 141, col: 15, offset: 4438},
+									pos: position{line: 141, col: 15, offset: 4288},
 									expr: &ruleRefExpr{
-										pos:  position{line: 141, col: 16, offset: 4439},
+										pos:  position{line: 141, col: 16, offset: 4289},
 										name: "fieldNameRest",
 									},
 								},
@@ -1073,13 +1073,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 144, col: 5, offset: 4549},
+						pos: position{line: 144, col: 5, offset: 4399},
 						run: (*parser).callonAddressLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 144, col: 5, offset: 4549},
+							pos:   position{line: 144, col: 5, offset: 4399},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 144, col: 7, offset: 4551},
+								pos:  position{line: 144, col: 7, offset: 4401},
 								name: "addr",
 							},
 						},
@@ -1089,15 +1089,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 148, col: 1, offset: 4649},
+			pos:  position{line: 148, col: 1, offset: 4499},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 5, offset: 4666},
+				pos: position{line: 149, col: 5, offset: 4516},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 149, col: 5, offset: 4666},
+					pos:   position{line: 149, col: 5, offset: 4516},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 149, col: 7, offset: 4668},
+						pos:  position{line: 149, col: 7, offset: 4518},
 						name: "sdouble",
 					},
 				},
@@ -1105,15 +1105,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 153, col: 1, offset: 4774},
+			pos:  position{line: 153, col: 1, offset: 4624},
 			expr: &actionExpr{
-				pos: position{line: 154, col: 5, offset: 4793},
+				pos: position{line: 154, col: 5, offset: 4643},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 154, col: 5, offset: 4793},
+					pos:   position{line: 154, col: 5, offset: 4643},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 154, col: 7, offset: 4795},
+						pos:  position{line: 154, col: 7, offset: 4645},
 						name: "sinteger",
 					},
 				},
@@ -1121,24 +1121,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 158, col: 1, offset: 4900},
+			pos:  position{line: 158, col: 1, offset: 4750},
 			expr: &choiceExpr{
-				pos: position{line: 159, col: 5, offset: 4919},
+				pos: position{line: 159, col: 5, offset: 4769},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 159, col: 5, offset: 4919},
+						pos: position{line: 159, col: 5, offset: 4769},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 159, col: 5, offset: 4919},
+							pos:        position{line: 159, col: 5, offset: 4769},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 160, col: 5, offset: 5029},
+						pos: position{line: 160, col: 5, offset: 4869},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 160, col: 5, offset: 5029},
+							pos:        position{line: 160, col: 5, offset: 4869},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -1148,12 +1148,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 162, col: 1, offset: 5137},
+			pos:  position{line: 162, col: 1, offset: 4968},
 			expr: &actionExpr{
-				pos: position{line: 163, col: 5, offset: 5153},
+				pos: position{line: 163, col: 5, offset: 4984},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 163, col: 5, offset: 5153},
+					pos:        position{line: 163, col: 5, offset: 4984},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -1161,20 +1161,20 @@ var g = &grammar{
 		},
 		{
 			name: "searchKeywords",
-			pos:  position{line: 165, col: 1, offset: 5243},
+			pos:  position{line: 165, col: 1, offset: 5064},
 			expr: &choiceExpr{
-				pos: position{line: 166, col: 5, offset: 5262},
+				pos: position{line: 166, col: 5, offset: 5083},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 166, col: 5, offset: 5262},
+						pos:  position{line: 166, col: 5, offset: 5083},
 						name: "andToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 167, col: 5, offset: 5275},
+						pos:  position{line: 167, col: 5, offset: 5096},
 						name: "orToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 168, col: 5, offset: 5287},
+						pos:  position{line: 168, col: 5, offset: 5108},
 						name: "inToken",
 					},
 				},
@@ -1182,28 +1182,28 @@ var g = &grammar{
 		},
 		{
 			name: "procList",
-			pos:  position{line: 170, col: 1, offset: 5296},
+			pos:  position{line: 170, col: 1, offset: 5117},
 			expr: &actionExpr{
-				pos: position{line: 171, col: 5, offset: 5309},
+				pos: position{line: 171, col: 5, offset: 5130},
 				run: (*parser).callonprocList1,
 				expr: &seqExpr{
-					pos: position{line: 171, col: 5, offset: 5309},
+					pos: position{line: 171, col: 5, offset: 5130},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 171, col: 5, offset: 5309},
+							pos:   position{line: 171, col: 5, offset: 5130},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 171, col: 11, offset: 5315},
+								pos:  position{line: 171, col: 11, offset: 5136},
 								name: "procChain",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 171, col: 21, offset: 5325},
+							pos:   position{line: 171, col: 21, offset: 5146},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 171, col: 26, offset: 5330},
+								pos: position{line: 171, col: 26, offset: 5151},
 								expr: &ruleRefExpr{
-									pos:  position{line: 171, col: 26, offset: 5330},
+									pos:  position{line: 171, col: 26, offset: 5151},
 									name: "parallelChain",
 								},
 							},
@@ -1214,37 +1214,37 @@ var g = &grammar{
 		},
 		{
 			name: "parallelChain",
-			pos:  position{line: 180, col: 1, offset: 5630},
+			pos:  position{line: 180, col: 1, offset: 5450},
 			expr: &actionExpr{
-				pos: position{line: 181, col: 5, offset: 5648},
+				pos: position{line: 181, col: 5, offset: 5468},
 				run: (*parser).callonparallelChain1,
 				expr: &seqExpr{
-					pos: position{line: 181, col: 5, offset: 5648},
+					pos: position{line: 181, col: 5, offset: 5468},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 181, col: 5, offset: 5648},
+							pos: position{line: 181, col: 5, offset: 5468},
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 5, offset: 5648},
+								pos:  position{line: 181, col: 5, offset: 5468},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 181, col: 8, offset: 5651},
+							pos:        position{line: 181, col: 8, offset: 5471},
 							val:        ";",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 181, col: 12, offset: 5655},
+							pos: position{line: 181, col: 12, offset: 5475},
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 12, offset: 5655},
+								pos:  position{line: 181, col: 12, offset: 5475},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 181, col: 15, offset: 5658},
+							pos:   position{line: 181, col: 15, offset: 5478},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 18, offset: 5661},
+								pos:  position{line: 181, col: 18, offset: 5481},
 								name: "procChain",
 							},
 						},
@@ -1254,53 +1254,53 @@ var g = &grammar{
 		},
 		{
 			name: "proc",
-			pos:  position{line: 183, col: 1, offset: 5748},
+			pos:  position{line: 183, col: 1, offset: 5568},
 			expr: &choiceExpr{
-				pos: position{line: 184, col: 5, offset: 5757},
+				pos: position{line: 184, col: 5, offset: 5577},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 184, col: 5, offset: 5757},
+						pos:  position{line: 184, col: 5, offset: 5577},
 						name: "simpleProc",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 185, col: 5, offset: 5772},
+						pos:  position{line: 185, col: 5, offset: 5592},
 						name: "groupByProc",
 					},
 					&actionExpr{
-						pos: position{line: 186, col: 5, offset: 5788},
+						pos: position{line: 186, col: 5, offset: 5608},
 						run: (*parser).callonproc4,
 						expr: &seqExpr{
-							pos: position{line: 186, col: 5, offset: 5788},
+							pos: position{line: 186, col: 5, offset: 5608},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 186, col: 5, offset: 5788},
+									pos:        position{line: 186, col: 5, offset: 5608},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 186, col: 9, offset: 5792},
+									pos: position{line: 186, col: 9, offset: 5612},
 									expr: &ruleRefExpr{
-										pos:  position{line: 186, col: 9, offset: 5792},
+										pos:  position{line: 186, col: 9, offset: 5612},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 186, col: 12, offset: 5795},
+									pos:   position{line: 186, col: 12, offset: 5615},
 									label: "proc",
 									expr: &ruleRefExpr{
-										pos:  position{line: 186, col: 17, offset: 5800},
+										pos:  position{line: 186, col: 17, offset: 5620},
 										name: "procList",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 186, col: 26, offset: 5809},
+									pos: position{line: 186, col: 26, offset: 5629},
 									expr: &ruleRefExpr{
-										pos:  position{line: 186, col: 26, offset: 5809},
+										pos:  position{line: 186, col: 26, offset: 5629},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 186, col: 29, offset: 5812},
+									pos:        position{line: 186, col: 29, offset: 5632},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1312,59 +1312,59 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKeys",
-			pos:  position{line: 190, col: 1, offset: 5848},
+			pos:  position{line: 190, col: 1, offset: 5668},
 			expr: &actionExpr{
-				pos: position{line: 191, col: 5, offset: 5864},
+				pos: position{line: 191, col: 5, offset: 5684},
 				run: (*parser).callongroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 191, col: 5, offset: 5864},
+					pos: position{line: 191, col: 5, offset: 5684},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 191, col: 5, offset: 5864},
+							pos:        position{line: 191, col: 5, offset: 5684},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 11, offset: 5870},
+							pos:  position{line: 191, col: 11, offset: 5690},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 13, offset: 5872},
+							pos:   position{line: 191, col: 13, offset: 5692},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 19, offset: 5878},
+								pos:  position{line: 191, col: 19, offset: 5698},
 								name: "groupByKey",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 30, offset: 5889},
+							pos:   position{line: 191, col: 30, offset: 5709},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 191, col: 35, offset: 5894},
+								pos: position{line: 191, col: 35, offset: 5714},
 								expr: &actionExpr{
-									pos: position{line: 191, col: 36, offset: 5895},
+									pos: position{line: 191, col: 36, offset: 5715},
 									run: (*parser).callongroupByKeys9,
 									expr: &seqExpr{
-										pos: position{line: 191, col: 36, offset: 5895},
+										pos: position{line: 191, col: 36, offset: 5715},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 191, col: 36, offset: 5895},
+												pos:  position{line: 191, col: 36, offset: 5715},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 191, col: 39, offset: 5898},
+												pos:        position{line: 191, col: 39, offset: 5718},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 191, col: 43, offset: 5902},
+												pos:  position{line: 191, col: 43, offset: 5722},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 191, col: 46, offset: 5905},
+												pos:   position{line: 191, col: 46, offset: 5725},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 191, col: 49, offset: 5908},
+													pos:  position{line: 191, col: 49, offset: 5728},
 													name: "groupByKey",
 												},
 											},
@@ -1379,22 +1379,22 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKey",
-			pos:  position{line: 196, col: 1, offset: 6025},
+			pos:  position{line: 196, col: 1, offset: 5844},
 			expr: &choiceExpr{
-				pos: position{line: 197, col: 5, offset: 6040},
+				pos: position{line: 197, col: 5, offset: 5859},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 197, col: 5, offset: 6040},
+						pos:  position{line: 197, col: 5, offset: 5859},
 						name: "ExpressionAssignment",
 					},
 					&actionExpr{
-						pos: position{line: 198, col: 5, offset: 6065},
+						pos: position{line: 198, col: 5, offset: 5884},
 						run: (*parser).callongroupByKey3,
 						expr: &labeledExpr{
-							pos:   position{line: 198, col: 5, offset: 6065},
+							pos:   position{line: 198, col: 5, offset: 5884},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 198, col: 11, offset: 6071},
+								pos:  position{line: 198, col: 11, offset: 5890},
 								name: "fieldExpr",
 							},
 						},
@@ -1404,27 +1404,27 @@ var g = &grammar{
 		},
 		{
 			name: "everyDur",
-			pos:  position{line: 201, col: 1, offset: 6199},
+			pos:  position{line: 201, col: 1, offset: 6018},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 5, offset: 6212},
+				pos: position{line: 202, col: 5, offset: 6031},
 				run: (*parser).calloneveryDur1,
 				expr: &seqExpr{
-					pos: position{line: 202, col: 5, offset: 6212},
+					pos: position{line: 202, col: 5, offset: 6031},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 202, col: 5, offset: 6212},
+							pos:        position{line: 202, col: 5, offset: 6031},
 							val:        "every",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 202, col: 14, offset: 6221},
+							pos:  position{line: 202, col: 14, offset: 6040},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 202, col: 16, offset: 6223},
+							pos:   position{line: 202, col: 16, offset: 6042},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 202, col: 20, offset: 6227},
+								pos:  position{line: 202, col: 20, offset: 6046},
 								name: "duration",
 							},
 						},
@@ -1434,16 +1434,16 @@ var g = &grammar{
 		},
 		{
 			name: "equalityToken",
-			pos:  position{line: 204, col: 1, offset: 6257},
+			pos:  position{line: 204, col: 1, offset: 6076},
 			expr: &choiceExpr{
-				pos: position{line: 205, col: 5, offset: 6275},
+				pos: position{line: 205, col: 5, offset: 6094},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 205, col: 5, offset: 6275},
+						pos:  position{line: 205, col: 5, offset: 6094},
 						name: "EqualityOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 205, col: 24, offset: 6294},
+						pos:  position{line: 205, col: 24, offset: 6113},
 						name: "RelativeOperator",
 					},
 				},
@@ -1451,12 +1451,12 @@ var g = &grammar{
 		},
 		{
 			name: "andToken",
-			pos:  position{line: 207, col: 1, offset: 6312},
+			pos:  position{line: 207, col: 1, offset: 6131},
 			expr: &actionExpr{
-				pos: position{line: 207, col: 12, offset: 6323},
+				pos: position{line: 207, col: 12, offset: 6142},
 				run: (*parser).callonandToken1,
 				expr: &litMatcher{
-					pos:        position{line: 207, col: 12, offset: 6323},
+					pos:        position{line: 207, col: 12, offset: 6142},
 					val:        "and",
 					ignoreCase: true,
 				},
@@ -1464,12 +1464,12 @@ var g = &grammar{
 		},
 		{
 			name: "orToken",
-			pos:  position{line: 208, col: 1, offset: 6361},
+			pos:  position{line: 208, col: 1, offset: 6180},
 			expr: &actionExpr{
-				pos: position{line: 208, col: 11, offset: 6371},
+				pos: position{line: 208, col: 11, offset: 6190},
 				run: (*parser).callonorToken1,
 				expr: &litMatcher{
-					pos:        position{line: 208, col: 11, offset: 6371},
+					pos:        position{line: 208, col: 11, offset: 6190},
 					val:        "or",
 					ignoreCase: true,
 				},
@@ -1477,12 +1477,12 @@ var g = &grammar{
 		},
 		{
 			name: "inToken",
-			pos:  position{line: 209, col: 1, offset: 6408},
+			pos:  position{line: 209, col: 1, offset: 6227},
 			expr: &actionExpr{
-				pos: position{line: 209, col: 11, offset: 6418},
+				pos: position{line: 209, col: 11, offset: 6237},
 				run: (*parser).calloninToken1,
 				expr: &litMatcher{
-					pos:        position{line: 209, col: 11, offset: 6418},
+					pos:        position{line: 209, col: 11, offset: 6237},
 					val:        "in",
 					ignoreCase: true,
 				},
@@ -1490,12 +1490,12 @@ var g = &grammar{
 		},
 		{
 			name: "notToken",
-			pos:  position{line: 210, col: 1, offset: 6455},
+			pos:  position{line: 210, col: 1, offset: 6274},
 			expr: &actionExpr{
-				pos: position{line: 210, col: 12, offset: 6466},
+				pos: position{line: 210, col: 12, offset: 6285},
 				run: (*parser).callonnotToken1,
 				expr: &litMatcher{
-					pos:        position{line: 210, col: 12, offset: 6466},
+					pos:        position{line: 210, col: 12, offset: 6285},
 					val:        "not",
 					ignoreCase: true,
 				},
@@ -1503,21 +1503,21 @@ var g = &grammar{
 		},
 		{
 			name: "fieldName",
-			pos:  position{line: 212, col: 1, offset: 6505},
+			pos:  position{line: 212, col: 1, offset: 6324},
 			expr: &actionExpr{
-				pos: position{line: 212, col: 13, offset: 6517},
+				pos: position{line: 212, col: 13, offset: 6336},
 				run: (*parser).callonfieldName1,
 				expr: &seqExpr{
-					pos: position{line: 212, col: 13, offset: 6517},
+					pos: position{line: 212, col: 13, offset: 6336},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 212, col: 13, offset: 6517},
+							pos:  position{line: 212, col: 13, offset: 6336},
 							name: "fieldNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 212, col: 28, offset: 6532},
+							pos: position{line: 212, col: 28, offset: 6351},
 							expr: &ruleRefExpr{
-								pos:  position{line: 212, col: 28, offset: 6532},
+								pos:  position{line: 212, col: 28, offset: 6351},
 								name: "fieldNameRest",
 							},
 						},
@@ -1527,9 +1527,9 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameStart",
-			pos:  position{line: 214, col: 1, offset: 6579},
+			pos:  position{line: 214, col: 1, offset: 6398},
 			expr: &charClassMatcher{
-				pos:        position{line: 214, col: 18, offset: 6596},
+				pos:        position{line: 214, col: 18, offset: 6415},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1539,16 +1539,16 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameRest",
-			pos:  position{line: 215, col: 1, offset: 6607},
+			pos:  position{line: 215, col: 1, offset: 6426},
 			expr: &choiceExpr{
-				pos: position{line: 215, col: 17, offset: 6623},
+				pos: position{line: 215, col: 17, offset: 6442},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 215, col: 17, offset: 6623},
+						pos:  position{line: 215, col: 17, offset: 6442},
 						name: "fieldNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 215, col: 34, offset: 6640},
+						pos:        position{line: 215, col: 34, offset: 6459},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1559,15 +1559,15 @@ var g = &grammar{
 		},
 		{
 			name: "Field",
-			pos:  position{line: 217, col: 1, offset: 6647},
+			pos:  position{line: 217, col: 1, offset: 6466},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 5, offset: 6657},
+				pos: position{line: 218, col: 5, offset: 6476},
 				run: (*parser).callonField1,
 				expr: &labeledExpr{
-					pos:   position{line: 218, col: 5, offset: 6657},
+					pos:   position{line: 218, col: 5, offset: 6476},
 					label: "name",
 					expr: &ruleRefExpr{
-						pos:  position{line: 218, col: 10, offset: 6662},
+						pos:  position{line: 218, col: 10, offset: 6481},
 						name: "fieldName",
 					},
 				},
@@ -1575,28 +1575,28 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExpr",
-			pos:  position{line: 220, col: 1, offset: 6743},
+			pos:  position{line: 220, col: 1, offset: 6561},
 			expr: &actionExpr{
-				pos: position{line: 220, col: 13, offset: 6755},
+				pos: position{line: 220, col: 13, offset: 6573},
 				run: (*parser).callonfieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 220, col: 13, offset: 6755},
+					pos: position{line: 220, col: 13, offset: 6573},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 220, col: 13, offset: 6755},
+							pos:   position{line: 220, col: 13, offset: 6573},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 220, col: 18, offset: 6760},
+								pos:  position{line: 220, col: 18, offset: 6578},
 								name: "Field",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 220, col: 24, offset: 6766},
+							pos:   position{line: 220, col: 24, offset: 6584},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 220, col: 31, offset: 6773},
+								pos: position{line: 220, col: 31, offset: 6591},
 								expr: &ruleRefExpr{
-									pos:  position{line: 220, col: 32, offset: 6774},
+									pos:  position{line: 220, col: 32, offset: 6592},
 									name: "Deref",
 								},
 							},
@@ -1607,28 +1607,28 @@ var g = &grammar{
 		},
 		{
 			name: "DotExpr",
-			pos:  position{line: 224, col: 1, offset: 6839},
+			pos:  position{line: 224, col: 1, offset: 6657},
 			expr: &actionExpr{
-				pos: position{line: 224, col: 11, offset: 6849},
+				pos: position{line: 224, col: 11, offset: 6667},
 				run: (*parser).callonDotExpr1,
 				expr: &seqExpr{
-					pos: position{line: 224, col: 11, offset: 6849},
+					pos: position{line: 224, col: 11, offset: 6667},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 224, col: 11, offset: 6849},
+							pos:   position{line: 224, col: 11, offset: 6667},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 224, col: 16, offset: 6854},
+								pos:  position{line: 224, col: 16, offset: 6672},
 								name: "Field",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 224, col: 22, offset: 6860},
+							pos:   position{line: 224, col: 22, offset: 6678},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 224, col: 29, offset: 6867},
+								pos: position{line: 224, col: 29, offset: 6685},
 								expr: &ruleRefExpr{
-									pos:  position{line: 224, col: 30, offset: 6868},
+									pos:  position{line: 224, col: 30, offset: 6686},
 									name: "DotField",
 								},
 							},
@@ -1639,52 +1639,52 @@ var g = &grammar{
 		},
 		{
 			name: "DotExprText",
-			pos:  position{line: 228, col: 1, offset: 6936},
+			pos:  position{line: 228, col: 1, offset: 6754},
 			expr: &actionExpr{
-				pos: position{line: 228, col: 15, offset: 6950},
+				pos: position{line: 228, col: 15, offset: 6768},
 				run: (*parser).callonDotExprText1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 228, col: 15, offset: 6950},
+					pos:  position{line: 228, col: 15, offset: 6768},
 					name: "DotExpr",
 				},
 			},
 		},
 		{
 			name: "FunctionExpr",
-			pos:  position{line: 234, col: 1, offset: 7250},
+			pos:  position{line: 234, col: 1, offset: 6812},
 			expr: &actionExpr{
-				pos: position{line: 235, col: 7, offset: 7269},
+				pos: position{line: 235, col: 7, offset: 6831},
 				run: (*parser).callonFunctionExpr1,
 				expr: &seqExpr{
-					pos: position{line: 235, col: 7, offset: 7269},
+					pos: position{line: 235, col: 7, offset: 6831},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 235, col: 7, offset: 7269},
+							pos:   position{line: 235, col: 7, offset: 6831},
 							label: "fn",
 							expr: &ruleRefExpr{
-								pos:  position{line: 235, col: 10, offset: 7272},
+								pos:  position{line: 235, col: 10, offset: 6834},
 								name: "FunctionName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 235, col: 23, offset: 7285},
+							pos:  position{line: 235, col: 23, offset: 6847},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 235, col: 26, offset: 7288},
+							pos:        position{line: 235, col: 26, offset: 6850},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 235, col: 30, offset: 7292},
+							pos:   position{line: 235, col: 30, offset: 6854},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 235, col: 35, offset: 7297},
+								pos:  position{line: 235, col: 35, offset: 6859},
 								name: "ArgumentList",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 235, col: 48, offset: 7310},
+							pos:        position{line: 235, col: 48, offset: 6872},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -1694,50 +1694,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExprList",
-			pos:  position{line: 239, col: 1, offset: 7422},
+			pos:  position{line: 239, col: 1, offset: 6984},
 			expr: &actionExpr{
-				pos: position{line: 240, col: 5, offset: 7440},
+				pos: position{line: 240, col: 5, offset: 7002},
 				run: (*parser).callonfieldExprList1,
 				expr: &seqExpr{
-					pos: position{line: 240, col: 5, offset: 7440},
+					pos: position{line: 240, col: 5, offset: 7002},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 240, col: 5, offset: 7440},
+							pos:   position{line: 240, col: 5, offset: 7002},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 240, col: 11, offset: 7446},
+								pos:  position{line: 240, col: 11, offset: 7008},
 								name: "fieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 240, col: 21, offset: 7456},
+							pos:   position{line: 240, col: 21, offset: 7018},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 240, col: 26, offset: 7461},
+								pos: position{line: 240, col: 26, offset: 7023},
 								expr: &seqExpr{
-									pos: position{line: 240, col: 27, offset: 7462},
+									pos: position{line: 240, col: 27, offset: 7024},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 240, col: 27, offset: 7462},
+											pos: position{line: 240, col: 27, offset: 7024},
 											expr: &ruleRefExpr{
-												pos:  position{line: 240, col: 27, offset: 7462},
+												pos:  position{line: 240, col: 27, offset: 7024},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 240, col: 30, offset: 7465},
+											pos:        position{line: 240, col: 30, offset: 7027},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 240, col: 34, offset: 7469},
+											pos: position{line: 240, col: 34, offset: 7031},
 											expr: &ruleRefExpr{
-												pos:  position{line: 240, col: 34, offset: 7469},
+												pos:  position{line: 240, col: 34, offset: 7031},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 240, col: 37, offset: 7472},
+											pos:  position{line: 240, col: 37, offset: 7034},
 											name: "fieldExpr",
 										},
 									},
@@ -1750,12 +1750,12 @@ var g = &grammar{
 		},
 		{
 			name: "countOp",
-			pos:  position{line: 250, col: 1, offset: 7669},
+			pos:  position{line: 250, col: 1, offset: 7229},
 			expr: &actionExpr{
-				pos: position{line: 251, col: 5, offset: 7681},
+				pos: position{line: 251, col: 5, offset: 7241},
 				run: (*parser).calloncountOp1,
 				expr: &litMatcher{
-					pos:        position{line: 251, col: 5, offset: 7681},
+					pos:        position{line: 251, col: 5, offset: 7241},
 					val:        "count",
 					ignoreCase: true,
 				},
@@ -1763,105 +1763,105 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducerOp",
-			pos:  position{line: 253, col: 1, offset: 7715},
+			pos:  position{line: 253, col: 1, offset: 7275},
 			expr: &choiceExpr{
-				pos: position{line: 254, col: 5, offset: 7734},
+				pos: position{line: 254, col: 5, offset: 7294},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 254, col: 5, offset: 7734},
+						pos: position{line: 254, col: 5, offset: 7294},
 						run: (*parser).callonfieldReducerOp2,
 						expr: &litMatcher{
-							pos:        position{line: 254, col: 5, offset: 7734},
+							pos:        position{line: 254, col: 5, offset: 7294},
 							val:        "sum",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 255, col: 5, offset: 7768},
+						pos: position{line: 255, col: 5, offset: 7327},
 						run: (*parser).callonfieldReducerOp4,
 						expr: &litMatcher{
-							pos:        position{line: 255, col: 5, offset: 7768},
+							pos:        position{line: 255, col: 5, offset: 7327},
 							val:        "avg",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 256, col: 5, offset: 7802},
+						pos: position{line: 256, col: 5, offset: 7360},
 						run: (*parser).callonfieldReducerOp6,
 						expr: &litMatcher{
-							pos:        position{line: 256, col: 5, offset: 7802},
+							pos:        position{line: 256, col: 5, offset: 7360},
 							val:        "stdev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 257, col: 5, offset: 7839},
+						pos: position{line: 257, col: 5, offset: 7397},
 						run: (*parser).callonfieldReducerOp8,
 						expr: &litMatcher{
-							pos:        position{line: 257, col: 5, offset: 7839},
+							pos:        position{line: 257, col: 5, offset: 7397},
 							val:        "sd",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 258, col: 5, offset: 7875},
+						pos: position{line: 258, col: 5, offset: 7431},
 						run: (*parser).callonfieldReducerOp10,
 						expr: &litMatcher{
-							pos:        position{line: 258, col: 5, offset: 7875},
+							pos:        position{line: 258, col: 5, offset: 7431},
 							val:        "var",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 259, col: 5, offset: 7909},
+						pos: position{line: 259, col: 5, offset: 7464},
 						run: (*parser).callonfieldReducerOp12,
 						expr: &litMatcher{
-							pos:        position{line: 259, col: 5, offset: 7909},
+							pos:        position{line: 259, col: 5, offset: 7464},
 							val:        "entropy",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 260, col: 5, offset: 7950},
+						pos: position{line: 260, col: 5, offset: 7505},
 						run: (*parser).callonfieldReducerOp14,
 						expr: &litMatcher{
-							pos:        position{line: 260, col: 5, offset: 7950},
+							pos:        position{line: 260, col: 5, offset: 7505},
 							val:        "min",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 7984},
+						pos: position{line: 261, col: 5, offset: 7538},
 						run: (*parser).callonfieldReducerOp16,
 						expr: &litMatcher{
-							pos:        position{line: 261, col: 5, offset: 7984},
+							pos:        position{line: 261, col: 5, offset: 7538},
 							val:        "max",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 262, col: 5, offset: 8018},
+						pos: position{line: 262, col: 5, offset: 7571},
 						run: (*parser).callonfieldReducerOp18,
 						expr: &litMatcher{
-							pos:        position{line: 262, col: 5, offset: 8018},
+							pos:        position{line: 262, col: 5, offset: 7571},
 							val:        "first",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 263, col: 5, offset: 8056},
+						pos: position{line: 263, col: 5, offset: 7608},
 						run: (*parser).callonfieldReducerOp20,
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 5, offset: 8056},
+							pos:        position{line: 263, col: 5, offset: 7608},
 							val:        "last",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 8092},
+						pos: position{line: 264, col: 5, offset: 7643},
 						run: (*parser).callonfieldReducerOp22,
 						expr: &litMatcher{
-							pos:        position{line: 264, col: 5, offset: 8092},
+							pos:        position{line: 264, col: 5, offset: 7643},
 							val:        "countdistinct",
 							ignoreCase: true,
 						},
@@ -1871,32 +1871,32 @@ var g = &grammar{
 		},
 		{
 			name: "paddedFieldExpr",
-			pos:  position{line: 266, col: 1, offset: 8142},
+			pos:  position{line: 266, col: 1, offset: 7693},
 			expr: &actionExpr{
-				pos: position{line: 266, col: 19, offset: 8160},
+				pos: position{line: 266, col: 19, offset: 7711},
 				run: (*parser).callonpaddedFieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 266, col: 19, offset: 8160},
+					pos: position{line: 266, col: 19, offset: 7711},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 266, col: 19, offset: 8160},
+							pos: position{line: 266, col: 19, offset: 7711},
 							expr: &ruleRefExpr{
-								pos:  position{line: 266, col: 19, offset: 8160},
+								pos:  position{line: 266, col: 19, offset: 7711},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 266, col: 22, offset: 8163},
+							pos:   position{line: 266, col: 22, offset: 7714},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 266, col: 28, offset: 8169},
+								pos:  position{line: 266, col: 28, offset: 7720},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 266, col: 38, offset: 8179},
+							pos: position{line: 266, col: 38, offset: 7730},
 							expr: &ruleRefExpr{
-								pos:  position{line: 266, col: 38, offset: 8179},
+								pos:  position{line: 266, col: 38, offset: 7730},
 								name: "_",
 							},
 						},
@@ -1906,53 +1906,53 @@ var g = &grammar{
 		},
 		{
 			name: "countReducer",
-			pos:  position{line: 268, col: 1, offset: 8205},
+			pos:  position{line: 268, col: 1, offset: 7756},
 			expr: &actionExpr{
-				pos: position{line: 269, col: 5, offset: 8222},
+				pos: position{line: 269, col: 5, offset: 7773},
 				run: (*parser).calloncountReducer1,
 				expr: &seqExpr{
-					pos: position{line: 269, col: 5, offset: 8222},
+					pos: position{line: 269, col: 5, offset: 7773},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 269, col: 5, offset: 8222},
+							pos:   position{line: 269, col: 5, offset: 7773},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 269, col: 8, offset: 8225},
+								pos:  position{line: 269, col: 8, offset: 7776},
 								name: "countOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 269, col: 16, offset: 8233},
+							pos: position{line: 269, col: 16, offset: 7784},
 							expr: &ruleRefExpr{
-								pos:  position{line: 269, col: 16, offset: 8233},
+								pos:  position{line: 269, col: 16, offset: 7784},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 269, col: 19, offset: 8236},
+							pos:        position{line: 269, col: 19, offset: 7787},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 269, col: 23, offset: 8240},
+							pos:   position{line: 269, col: 23, offset: 7791},
 							label: "field",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 269, col: 29, offset: 8246},
+								pos: position{line: 269, col: 29, offset: 7797},
 								expr: &ruleRefExpr{
-									pos:  position{line: 269, col: 29, offset: 8246},
+									pos:  position{line: 269, col: 29, offset: 7797},
 									name: "paddedFieldExpr",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 269, col: 47, offset: 8264},
+							pos: position{line: 269, col: 46, offset: 7814},
 							expr: &ruleRefExpr{
-								pos:  position{line: 269, col: 47, offset: 8264},
+								pos:  position{line: 269, col: 46, offset: 7814},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 269, col: 50, offset: 8267},
+							pos:        position{line: 269, col: 49, offset: 7817},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -1962,57 +1962,57 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducer",
-			pos:  position{line: 277, col: 1, offset: 8410},
+			pos:  position{line: 277, col: 1, offset: 7960},
 			expr: &actionExpr{
-				pos: position{line: 278, col: 5, offset: 8427},
+				pos: position{line: 278, col: 5, offset: 7977},
 				run: (*parser).callonfieldReducer1,
 				expr: &seqExpr{
-					pos: position{line: 278, col: 5, offset: 8427},
+					pos: position{line: 278, col: 5, offset: 7977},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 278, col: 5, offset: 8427},
+							pos:   position{line: 278, col: 5, offset: 7977},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 8, offset: 8430},
+								pos:  position{line: 278, col: 8, offset: 7980},
 								name: "fieldReducerOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 278, col: 23, offset: 8445},
+							pos: position{line: 278, col: 23, offset: 7995},
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 23, offset: 8445},
+								pos:  position{line: 278, col: 23, offset: 7995},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 278, col: 26, offset: 8448},
+							pos:        position{line: 278, col: 26, offset: 7998},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 278, col: 30, offset: 8452},
+							pos: position{line: 278, col: 30, offset: 8002},
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 30, offset: 8452},
+								pos:  position{line: 278, col: 30, offset: 8002},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 278, col: 33, offset: 8455},
+							pos:   position{line: 278, col: 33, offset: 8005},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 39, offset: 8461},
+								pos:  position{line: 278, col: 39, offset: 8011},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 278, col: 50, offset: 8472},
+							pos: position{line: 278, col: 49, offset: 8021},
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 50, offset: 8472},
+								pos:  position{line: 278, col: 49, offset: 8021},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 278, col: 53, offset: 8475},
+							pos:        position{line: 278, col: 52, offset: 8024},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2022,27 +2022,27 @@ var g = &grammar{
 		},
 		{
 			name: "groupByProc",
-			pos:  position{line: 286, col: 1, offset: 8626},
+			pos:  position{line: 286, col: 1, offset: 8175},
 			expr: &actionExpr{
-				pos: position{line: 287, col: 5, offset: 8642},
+				pos: position{line: 287, col: 5, offset: 8191},
 				run: (*parser).callongroupByProc1,
 				expr: &seqExpr{
-					pos: position{line: 287, col: 5, offset: 8642},
+					pos: position{line: 287, col: 5, offset: 8191},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 287, col: 5, offset: 8642},
+							pos:   position{line: 287, col: 5, offset: 8191},
 							label: "every",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 287, col: 11, offset: 8648},
+								pos: position{line: 287, col: 11, offset: 8197},
 								expr: &seqExpr{
-									pos: position{line: 287, col: 12, offset: 8649},
+									pos: position{line: 287, col: 12, offset: 8198},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 287, col: 12, offset: 8649},
+											pos:  position{line: 287, col: 12, offset: 8198},
 											name: "everyDur",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 287, col: 21, offset: 8658},
+											pos:  position{line: 287, col: 21, offset: 8207},
 											name: "_",
 										},
 									},
@@ -2050,27 +2050,27 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 287, col: 25, offset: 8662},
+							pos:   position{line: 287, col: 25, offset: 8211},
 							label: "reducers",
 							expr: &ruleRefExpr{
-								pos:  position{line: 287, col: 34, offset: 8671},
+								pos:  position{line: 287, col: 34, offset: 8220},
 								name: "reducerList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 287, col: 46, offset: 8683},
+							pos:   position{line: 287, col: 46, offset: 8232},
 							label: "keys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 287, col: 51, offset: 8688},
+								pos: position{line: 287, col: 51, offset: 8237},
 								expr: &seqExpr{
-									pos: position{line: 287, col: 52, offset: 8689},
+									pos: position{line: 287, col: 52, offset: 8238},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 287, col: 52, offset: 8689},
+											pos:  position{line: 287, col: 52, offset: 8238},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 287, col: 54, offset: 8691},
+											pos:  position{line: 287, col: 54, offset: 8240},
 											name: "groupByKeys",
 										},
 									},
@@ -2078,12 +2078,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 287, col: 68, offset: 8705},
+							pos:   position{line: 287, col: 68, offset: 8254},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 287, col: 74, offset: 8711},
+								pos: position{line: 287, col: 74, offset: 8260},
 								expr: &ruleRefExpr{
-									pos:  position{line: 287, col: 74, offset: 8711},
+									pos:  position{line: 287, col: 74, offset: 8260},
 									name: "procLimitArg",
 								},
 							},
@@ -2094,48 +2094,48 @@ var g = &grammar{
 		},
 		{
 			name: "reducerExpr",
-			pos:  position{line: 304, col: 1, offset: 9176},
+			pos:  position{line: 304, col: 1, offset: 8725},
 			expr: &choiceExpr{
-				pos: position{line: 305, col: 5, offset: 9192},
+				pos: position{line: 305, col: 5, offset: 8741},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 305, col: 5, offset: 9192},
+						pos: position{line: 305, col: 5, offset: 8741},
 						run: (*parser).callonreducerExpr2,
 						expr: &seqExpr{
-							pos: position{line: 305, col: 5, offset: 9192},
+							pos: position{line: 305, col: 5, offset: 8741},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 305, col: 5, offset: 9192},
+									pos:   position{line: 305, col: 5, offset: 8741},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 305, col: 11, offset: 9198},
+										pos:  position{line: 305, col: 11, offset: 8747},
 										name: "fieldName",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 305, col: 21, offset: 9208},
+									pos: position{line: 305, col: 21, offset: 8757},
 									expr: &ruleRefExpr{
-										pos:  position{line: 305, col: 21, offset: 9208},
+										pos:  position{line: 305, col: 21, offset: 8757},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 305, col: 24, offset: 9211},
+									pos:        position{line: 305, col: 24, offset: 8760},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 305, col: 28, offset: 9215},
+									pos: position{line: 305, col: 28, offset: 8764},
 									expr: &ruleRefExpr{
-										pos:  position{line: 305, col: 28, offset: 9215},
+										pos:  position{line: 305, col: 28, offset: 8764},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 305, col: 31, offset: 9218},
+									pos:   position{line: 305, col: 31, offset: 8767},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 305, col: 33, offset: 9220},
+										pos:  position{line: 305, col: 33, offset: 8769},
 										name: "reducer",
 									},
 								},
@@ -2143,7 +2143,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 310, col: 5, offset: 9316},
+						pos:  position{line: 310, col: 5, offset: 8865},
 						name: "reducer",
 					},
 				},
@@ -2151,16 +2151,16 @@ var g = &grammar{
 		},
 		{
 			name: "reducer",
-			pos:  position{line: 312, col: 1, offset: 9325},
+			pos:  position{line: 312, col: 1, offset: 8874},
 			expr: &choiceExpr{
-				pos: position{line: 313, col: 5, offset: 9337},
+				pos: position{line: 313, col: 5, offset: 8886},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 313, col: 5, offset: 9337},
+						pos:  position{line: 313, col: 5, offset: 8886},
 						name: "countReducer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 314, col: 5, offset: 9354},
+						pos:  position{line: 314, col: 5, offset: 8903},
 						name: "fieldReducer",
 					},
 				},
@@ -2168,50 +2168,50 @@ var g = &grammar{
 		},
 		{
 			name: "reducerList",
-			pos:  position{line: 316, col: 1, offset: 9368},
+			pos:  position{line: 316, col: 1, offset: 8917},
 			expr: &actionExpr{
-				pos: position{line: 317, col: 5, offset: 9384},
+				pos: position{line: 317, col: 5, offset: 8933},
 				run: (*parser).callonreducerList1,
 				expr: &seqExpr{
-					pos: position{line: 317, col: 5, offset: 9384},
+					pos: position{line: 317, col: 5, offset: 8933},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 317, col: 5, offset: 9384},
+							pos:   position{line: 317, col: 5, offset: 8933},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 11, offset: 9390},
+								pos:  position{line: 317, col: 11, offset: 8939},
 								name: "reducerExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 317, col: 23, offset: 9402},
+							pos:   position{line: 317, col: 23, offset: 8951},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 317, col: 28, offset: 9407},
+								pos: position{line: 317, col: 28, offset: 8956},
 								expr: &seqExpr{
-									pos: position{line: 317, col: 29, offset: 9408},
+									pos: position{line: 317, col: 29, offset: 8957},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 317, col: 29, offset: 9408},
+											pos: position{line: 317, col: 29, offset: 8957},
 											expr: &ruleRefExpr{
-												pos:  position{line: 317, col: 29, offset: 9408},
+												pos:  position{line: 317, col: 29, offset: 8957},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 317, col: 32, offset: 9411},
+											pos:        position{line: 317, col: 32, offset: 8960},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 317, col: 36, offset: 9415},
+											pos: position{line: 317, col: 36, offset: 8964},
 											expr: &ruleRefExpr{
-												pos:  position{line: 317, col: 36, offset: 9415},
+												pos:  position{line: 317, col: 36, offset: 8964},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 317, col: 39, offset: 9418},
+											pos:  position{line: 317, col: 39, offset: 8967},
 											name: "reducerExpr",
 										},
 									},
@@ -2224,95 +2224,103 @@ var g = &grammar{
 		},
 		{
 			name: "simpleProc",
-			pos:  position{line: 325, col: 1, offset: 9617},
+			pos:  position{line: 325, col: 1, offset: 9164},
 			expr: &choiceExpr{
-				pos: position{line: 326, col: 5, offset: 9632},
+				pos: position{line: 326, col: 5, offset: 9179},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 326, col: 5, offset: 9632},
+						pos:  position{line: 326, col: 5, offset: 9179},
 						name: "sort",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 327, col: 5, offset: 9641},
+						pos:  position{line: 327, col: 5, offset: 9188},
 						name: "top",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 328, col: 5, offset: 9649},
+						pos:  position{line: 328, col: 5, offset: 9196},
 						name: "cut",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 329, col: 5, offset: 9657},
+						pos:  position{line: 329, col: 5, offset: 9204},
 						name: "head",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 330, col: 5, offset: 9666},
+						pos:  position{line: 330, col: 5, offset: 9213},
 						name: "tail",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 5, offset: 9675},
+						pos:  position{line: 331, col: 5, offset: 9222},
 						name: "filter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 5, offset: 9686},
+						pos:  position{line: 332, col: 5, offset: 9233},
 						name: "uniq",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 5, offset: 9695},
+						pos:  position{line: 333, col: 5, offset: 9242},
 						name: "put",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 5, offset: 9703},
+						pos:  position{line: 334, col: 5, offset: 9250},
 						name: "rename",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 5, offset: 9714},
+						pos:  position{line: 335, col: 5, offset: 9261},
 						name: "fuse",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 336, col: 5, offset: 9270},
+						name: "lookup",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 337, col: 5, offset: 9281},
+						name: "window",
+					},
 				},
 			},
 		},
 		{
 			name: "sort",
-			pos:  position{line: 337, col: 1, offset: 9720},
+			pos:  position{line: 339, col: 1, offset: 9289},
 			expr: &actionExpr{
-				pos: position{line: 338, col: 5, offset: 9729},
+				pos: position{line: 340, col: 5, offset: 9298},
 				run: (*parser).callonsort1,
 				expr: &seqExpr{
-					pos: position{line: 338, col: 5, offset: 9729},
+					pos: position{line: 340, col: 5, offset: 9298},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 338, col: 5, offset: 9729},
+							pos:        position{line: 340, col: 5, offset: 9298},
 							val:        "sort",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 338, col: 13, offset: 9737},
+							pos:   position{line: 340, col: 13, offset: 9306},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 338, col: 18, offset: 9742},
+								pos:  position{line: 340, col: 18, offset: 9311},
 								name: "sortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 338, col: 27, offset: 9751},
+							pos:   position{line: 340, col: 27, offset: 9320},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 338, col: 32, offset: 9756},
+								pos: position{line: 340, col: 32, offset: 9325},
 								expr: &actionExpr{
-									pos: position{line: 338, col: 33, offset: 9757},
+									pos: position{line: 340, col: 33, offset: 9326},
 									run: (*parser).callonsort8,
 									expr: &seqExpr{
-										pos: position{line: 338, col: 33, offset: 9757},
+										pos: position{line: 340, col: 33, offset: 9326},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 338, col: 33, offset: 9757},
+												pos:  position{line: 340, col: 33, offset: 9326},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 338, col: 35, offset: 9759},
+												pos:   position{line: 340, col: 35, offset: 9328},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 338, col: 37, offset: 9761},
+													pos:  position{line: 340, col: 37, offset: 9330},
 													name: "fieldExprList",
 												},
 											},
@@ -2327,30 +2335,30 @@ var g = &grammar{
 		},
 		{
 			name: "sortArgs",
-			pos:  position{line: 352, col: 1, offset: 10164},
+			pos:  position{line: 354, col: 1, offset: 9731},
 			expr: &actionExpr{
-				pos: position{line: 352, col: 12, offset: 10175},
+				pos: position{line: 354, col: 12, offset: 9742},
 				run: (*parser).callonsortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 352, col: 12, offset: 10175},
+					pos:   position{line: 354, col: 12, offset: 9742},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 352, col: 17, offset: 10180},
+						pos: position{line: 354, col: 17, offset: 9747},
 						expr: &actionExpr{
-							pos: position{line: 352, col: 18, offset: 10181},
+							pos: position{line: 354, col: 18, offset: 9748},
 							run: (*parser).callonsortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 352, col: 18, offset: 10181},
+								pos: position{line: 354, col: 18, offset: 9748},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 352, col: 18, offset: 10181},
+										pos:  position{line: 354, col: 18, offset: 9748},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 352, col: 20, offset: 10183},
+										pos:   position{line: 354, col: 20, offset: 9750},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 352, col: 22, offset: 10185},
+											pos:  position{line: 354, col: 22, offset: 9752},
 											name: "sortArg",
 										},
 									},
@@ -2363,50 +2371,50 @@ var g = &grammar{
 		},
 		{
 			name: "sortArg",
-			pos:  position{line: 356, col: 1, offset: 10245},
+			pos:  position{line: 358, col: 1, offset: 9812},
 			expr: &choiceExpr{
-				pos: position{line: 357, col: 5, offset: 10257},
+				pos: position{line: 359, col: 5, offset: 9824},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 357, col: 5, offset: 10257},
+						pos: position{line: 359, col: 5, offset: 9824},
 						run: (*parser).callonsortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 357, col: 5, offset: 10257},
+							pos:        position{line: 359, col: 5, offset: 9824},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 358, col: 5, offset: 10332},
+						pos: position{line: 360, col: 5, offset: 9899},
 						run: (*parser).callonsortArg4,
 						expr: &seqExpr{
-							pos: position{line: 358, col: 5, offset: 10332},
+							pos: position{line: 360, col: 5, offset: 9899},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 358, col: 5, offset: 10332},
+									pos:        position{line: 360, col: 5, offset: 9899},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 358, col: 14, offset: 10341},
+									pos:  position{line: 360, col: 14, offset: 9908},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 358, col: 16, offset: 10343},
+									pos:   position{line: 360, col: 16, offset: 9910},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 358, col: 23, offset: 10350},
+										pos: position{line: 360, col: 23, offset: 9917},
 										run: (*parser).callonsortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 358, col: 24, offset: 10351},
+											pos: position{line: 360, col: 24, offset: 9918},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 358, col: 24, offset: 10351},
+													pos:        position{line: 360, col: 24, offset: 9918},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 358, col: 34, offset: 10361},
+													pos:        position{line: 360, col: 34, offset: 9928},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2422,38 +2430,38 @@ var g = &grammar{
 		},
 		{
 			name: "top",
-			pos:  position{line: 360, col: 1, offset: 10475},
+			pos:  position{line: 362, col: 1, offset: 10042},
 			expr: &actionExpr{
-				pos: position{line: 361, col: 5, offset: 10483},
+				pos: position{line: 363, col: 5, offset: 10050},
 				run: (*parser).callontop1,
 				expr: &seqExpr{
-					pos: position{line: 361, col: 5, offset: 10483},
+					pos: position{line: 363, col: 5, offset: 10050},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 361, col: 5, offset: 10483},
+							pos:        position{line: 363, col: 5, offset: 10050},
 							val:        "top",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 361, col: 12, offset: 10490},
+							pos:   position{line: 363, col: 12, offset: 10057},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 361, col: 18, offset: 10496},
+								pos: position{line: 363, col: 18, offset: 10063},
 								expr: &actionExpr{
-									pos: position{line: 361, col: 19, offset: 10497},
+									pos: position{line: 363, col: 19, offset: 10064},
 									run: (*parser).callontop6,
 									expr: &seqExpr{
-										pos: position{line: 361, col: 19, offset: 10497},
+										pos: position{line: 363, col: 19, offset: 10064},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 361, col: 19, offset: 10497},
+												pos:  position{line: 363, col: 19, offset: 10064},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 361, col: 21, offset: 10499},
+												pos:   position{line: 363, col: 21, offset: 10066},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 361, col: 23, offset: 10501},
+													pos:  position{line: 363, col: 23, offset: 10068},
 													name: "unsignedInteger",
 												},
 											},
@@ -2463,19 +2471,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 361, col: 58, offset: 10536},
+							pos:   position{line: 363, col: 58, offset: 10103},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 361, col: 64, offset: 10542},
+								pos: position{line: 363, col: 64, offset: 10109},
 								expr: &seqExpr{
-									pos: position{line: 361, col: 65, offset: 10543},
+									pos: position{line: 363, col: 65, offset: 10110},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 361, col: 65, offset: 10543},
+											pos:  position{line: 363, col: 65, offset: 10110},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 361, col: 67, offset: 10545},
+											pos:        position{line: 363, col: 67, offset: 10112},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2484,25 +2492,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 361, col: 78, offset: 10556},
+							pos:   position{line: 363, col: 78, offset: 10123},
 							label: "fields",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 361, col: 85, offset: 10563},
+								pos: position{line: 363, col: 85, offset: 10130},
 								expr: &actionExpr{
-									pos: position{line: 361, col: 86, offset: 10564},
+									pos: position{line: 363, col: 86, offset: 10131},
 									run: (*parser).callontop18,
 									expr: &seqExpr{
-										pos: position{line: 361, col: 86, offset: 10564},
+										pos: position{line: 363, col: 86, offset: 10131},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 361, col: 86, offset: 10564},
+												pos:  position{line: 363, col: 86, offset: 10131},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 361, col: 88, offset: 10566},
+												pos:   position{line: 363, col: 88, offset: 10133},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 361, col: 90, offset: 10568},
+													pos:  position{line: 363, col: 90, offset: 10135},
 													name: "fieldExprList",
 												},
 											},
@@ -2517,31 +2525,31 @@ var g = &grammar{
 		},
 		{
 			name: "procLimitArg",
-			pos:  position{line: 375, col: 1, offset: 10855},
+			pos:  position{line: 377, col: 1, offset: 10422},
 			expr: &actionExpr{
-				pos: position{line: 376, col: 5, offset: 10872},
+				pos: position{line: 378, col: 5, offset: 10439},
 				run: (*parser).callonprocLimitArg1,
 				expr: &seqExpr{
-					pos: position{line: 376, col: 5, offset: 10872},
+					pos: position{line: 378, col: 5, offset: 10439},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 5, offset: 10872},
+							pos:  position{line: 378, col: 5, offset: 10439},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 376, col: 7, offset: 10874},
+							pos:        position{line: 378, col: 7, offset: 10441},
 							val:        "-limit",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 16, offset: 10883},
+							pos:  position{line: 378, col: 16, offset: 10450},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 376, col: 18, offset: 10885},
+							pos:   position{line: 378, col: 18, offset: 10452},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 376, col: 24, offset: 10891},
+								pos:  position{line: 378, col: 24, offset: 10458},
 								name: "unsignedInteger",
 							},
 						},
@@ -2551,27 +2559,27 @@ var g = &grammar{
 		},
 		{
 			name: "cutArgs",
-			pos:  position{line: 378, col: 1, offset: 10930},
+			pos:  position{line: 380, col: 1, offset: 10497},
 			expr: &actionExpr{
-				pos: position{line: 379, col: 5, offset: 10942},
+				pos: position{line: 381, col: 5, offset: 10509},
 				run: (*parser).calloncutArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 379, col: 5, offset: 10942},
+					pos:   position{line: 381, col: 5, offset: 10509},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 379, col: 10, offset: 10947},
+						pos: position{line: 381, col: 10, offset: 10514},
 						expr: &actionExpr{
-							pos: position{line: 379, col: 11, offset: 10948},
+							pos: position{line: 381, col: 11, offset: 10515},
 							run: (*parser).calloncutArgs4,
 							expr: &seqExpr{
-								pos: position{line: 379, col: 11, offset: 10948},
+								pos: position{line: 381, col: 11, offset: 10515},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 379, col: 11, offset: 10948},
+										pos:  position{line: 381, col: 11, offset: 10515},
 										name: "_",
 									},
 									&litMatcher{
-										pos:        position{line: 379, col: 13, offset: 10950},
+										pos:        position{line: 381, col: 13, offset: 10517},
 										val:        "-c",
 										ignoreCase: false,
 									},
@@ -2584,22 +2592,22 @@ var g = &grammar{
 		},
 		{
 			name: "cutAssignment",
-			pos:  position{line: 383, col: 1, offset: 11058},
+			pos:  position{line: 385, col: 1, offset: 10625},
 			expr: &choiceExpr{
-				pos: position{line: 384, col: 5, offset: 11076},
+				pos: position{line: 386, col: 5, offset: 10643},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 384, col: 5, offset: 11076},
+						pos:  position{line: 386, col: 5, offset: 10643},
 						name: "FieldAssignment",
 					},
 					&actionExpr{
-						pos: position{line: 385, col: 5, offset: 11096},
+						pos: position{line: 387, col: 5, offset: 10663},
 						run: (*parser).calloncutAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 385, col: 5, offset: 11096},
+							pos:   position{line: 387, col: 5, offset: 10663},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 385, col: 11, offset: 11102},
+								pos:  position{line: 387, col: 11, offset: 10669},
 								name: "DotExpr",
 							},
 						},
@@ -2609,67 +2617,67 @@ var g = &grammar{
 		},
 		{
 			name: "cut",
-			pos:  position{line: 389, col: 1, offset: 11187},
+			pos:  position{line: 391, col: 1, offset: 10754},
 			expr: &actionExpr{
-				pos: position{line: 390, col: 5, offset: 11195},
+				pos: position{line: 392, col: 5, offset: 10762},
 				run: (*parser).calloncut1,
 				expr: &seqExpr{
-					pos: position{line: 390, col: 5, offset: 11195},
+					pos: position{line: 392, col: 5, offset: 10762},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 390, col: 5, offset: 11195},
+							pos:        position{line: 392, col: 5, offset: 10762},
 							val:        "cut",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 390, col: 12, offset: 11202},
+							pos:   position{line: 392, col: 12, offset: 10769},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 390, col: 17, offset: 11207},
+								pos:  position{line: 392, col: 17, offset: 10774},
 								name: "cutArgs",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 390, col: 25, offset: 11215},
+							pos:  position{line: 392, col: 25, offset: 10782},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 390, col: 27, offset: 11217},
+							pos:   position{line: 392, col: 27, offset: 10784},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 390, col: 33, offset: 11223},
+								pos:  position{line: 392, col: 33, offset: 10790},
 								name: "cutAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 390, col: 47, offset: 11237},
+							pos:   position{line: 392, col: 47, offset: 10804},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 390, col: 52, offset: 11242},
+								pos: position{line: 392, col: 52, offset: 10809},
 								expr: &actionExpr{
-									pos: position{line: 390, col: 53, offset: 11243},
+									pos: position{line: 392, col: 53, offset: 10810},
 									run: (*parser).calloncut11,
 									expr: &seqExpr{
-										pos: position{line: 390, col: 53, offset: 11243},
+										pos: position{line: 392, col: 53, offset: 10810},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 390, col: 53, offset: 11243},
+												pos:  position{line: 392, col: 53, offset: 10810},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 390, col: 56, offset: 11246},
+												pos:        position{line: 392, col: 56, offset: 10813},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 390, col: 60, offset: 11250},
+												pos:  position{line: 392, col: 60, offset: 10817},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 390, col: 63, offset: 11253},
+												pos:   position{line: 392, col: 63, offset: 10820},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 390, col: 66, offset: 11256},
+													pos:  position{line: 392, col: 66, offset: 10823},
 													name: "cutAssignment",
 												},
 											},
@@ -2684,30 +2692,30 @@ var g = &grammar{
 		},
 		{
 			name: "head",
-			pos:  position{line: 398, col: 1, offset: 11578},
+			pos:  position{line: 400, col: 1, offset: 11143},
 			expr: &choiceExpr{
-				pos: position{line: 399, col: 5, offset: 11587},
+				pos: position{line: 401, col: 5, offset: 11152},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 399, col: 5, offset: 11587},
+						pos: position{line: 401, col: 5, offset: 11152},
 						run: (*parser).callonhead2,
 						expr: &seqExpr{
-							pos: position{line: 399, col: 5, offset: 11587},
+							pos: position{line: 401, col: 5, offset: 11152},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 399, col: 5, offset: 11587},
+									pos:        position{line: 401, col: 5, offset: 11152},
 									val:        "head",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 399, col: 13, offset: 11595},
+									pos:  position{line: 401, col: 13, offset: 11160},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 399, col: 15, offset: 11597},
+									pos:   position{line: 401, col: 15, offset: 11162},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 399, col: 21, offset: 11603},
+										pos:  position{line: 401, col: 21, offset: 11168},
 										name: "unsignedInteger",
 									},
 								},
@@ -2715,10 +2723,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 400, col: 5, offset: 11696},
+						pos: position{line: 402, col: 5, offset: 11261},
 						run: (*parser).callonhead8,
 						expr: &litMatcher{
-							pos:        position{line: 400, col: 5, offset: 11696},
+							pos:        position{line: 402, col: 5, offset: 11261},
 							val:        "head",
 							ignoreCase: true,
 						},
@@ -2728,30 +2736,30 @@ var g = &grammar{
 		},
 		{
 			name: "tail",
-			pos:  position{line: 401, col: 1, offset: 11773},
+			pos:  position{line: 403, col: 1, offset: 11338},
 			expr: &choiceExpr{
-				pos: position{line: 402, col: 5, offset: 11782},
+				pos: position{line: 404, col: 5, offset: 11347},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 402, col: 5, offset: 11782},
+						pos: position{line: 404, col: 5, offset: 11347},
 						run: (*parser).callontail2,
 						expr: &seqExpr{
-							pos: position{line: 402, col: 5, offset: 11782},
+							pos: position{line: 404, col: 5, offset: 11347},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 402, col: 5, offset: 11782},
+									pos:        position{line: 404, col: 5, offset: 11347},
 									val:        "tail",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 402, col: 13, offset: 11790},
+									pos:  position{line: 404, col: 13, offset: 11355},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 402, col: 15, offset: 11792},
+									pos:   position{line: 404, col: 15, offset: 11357},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 402, col: 21, offset: 11798},
+										pos:  position{line: 404, col: 21, offset: 11363},
 										name: "unsignedInteger",
 									},
 								},
@@ -2759,10 +2767,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 403, col: 5, offset: 11891},
+						pos: position{line: 405, col: 5, offset: 11456},
 						run: (*parser).callontail8,
 						expr: &litMatcher{
-							pos:        position{line: 403, col: 5, offset: 11891},
+							pos:        position{line: 405, col: 5, offset: 11456},
 							val:        "tail",
 							ignoreCase: true,
 						},
@@ -2772,27 +2780,27 @@ var g = &grammar{
 		},
 		{
 			name: "filter",
-			pos:  position{line: 405, col: 1, offset: 11969},
+			pos:  position{line: 407, col: 1, offset: 11534},
 			expr: &actionExpr{
-				pos: position{line: 406, col: 5, offset: 11980},
+				pos: position{line: 408, col: 5, offset: 11545},
 				run: (*parser).callonfilter1,
 				expr: &seqExpr{
-					pos: position{line: 406, col: 5, offset: 11980},
+					pos: position{line: 408, col: 5, offset: 11545},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 406, col: 5, offset: 11980},
+							pos:        position{line: 408, col: 5, offset: 11545},
 							val:        "filter",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 406, col: 15, offset: 11990},
+							pos:  position{line: 408, col: 15, offset: 11555},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 406, col: 17, offset: 11992},
+							pos:   position{line: 408, col: 17, offset: 11557},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 406, col: 22, offset: 11997},
+								pos:  position{line: 408, col: 22, offset: 11562},
 								name: "searchExpr",
 							},
 						},
@@ -2802,27 +2810,27 @@ var g = &grammar{
 		},
 		{
 			name: "uniq",
-			pos:  position{line: 409, col: 1, offset: 12093},
+			pos:  position{line: 411, col: 1, offset: 11658},
 			expr: &choiceExpr{
-				pos: position{line: 410, col: 5, offset: 12102},
+				pos: position{line: 412, col: 5, offset: 11667},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 410, col: 5, offset: 12102},
+						pos: position{line: 412, col: 5, offset: 11667},
 						run: (*parser).callonuniq2,
 						expr: &seqExpr{
-							pos: position{line: 410, col: 5, offset: 12102},
+							pos: position{line: 412, col: 5, offset: 11667},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 410, col: 5, offset: 12102},
+									pos:        position{line: 412, col: 5, offset: 11667},
 									val:        "uniq",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 410, col: 13, offset: 12110},
+									pos:  position{line: 412, col: 13, offset: 11675},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 410, col: 15, offset: 12112},
+									pos:        position{line: 412, col: 15, offset: 11677},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2830,10 +2838,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 413, col: 5, offset: 12203},
+						pos: position{line: 415, col: 5, offset: 11768},
 						run: (*parser).callonuniq7,
 						expr: &litMatcher{
-							pos:        position{line: 413, col: 5, offset: 12203},
+							pos:        position{line: 415, col: 5, offset: 11768},
 							val:        "uniq",
 							ignoreCase: true,
 						},
@@ -2843,59 +2851,59 @@ var g = &grammar{
 		},
 		{
 			name: "put",
-			pos:  position{line: 417, col: 1, offset: 12295},
+			pos:  position{line: 419, col: 1, offset: 11860},
 			expr: &actionExpr{
-				pos: position{line: 418, col: 5, offset: 12303},
+				pos: position{line: 420, col: 5, offset: 11868},
 				run: (*parser).callonput1,
 				expr: &seqExpr{
-					pos: position{line: 418, col: 5, offset: 12303},
+					pos: position{line: 420, col: 5, offset: 11868},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 418, col: 5, offset: 12303},
+							pos:        position{line: 420, col: 5, offset: 11868},
 							val:        "put",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 418, col: 12, offset: 12310},
+							pos:  position{line: 420, col: 12, offset: 11875},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 418, col: 14, offset: 12312},
+							pos:   position{line: 420, col: 14, offset: 11877},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 418, col: 20, offset: 12318},
+								pos:  position{line: 420, col: 20, offset: 11883},
 								name: "ExpressionAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 418, col: 41, offset: 12339},
+							pos:   position{line: 420, col: 41, offset: 11904},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 418, col: 46, offset: 12344},
+								pos: position{line: 420, col: 46, offset: 11909},
 								expr: &actionExpr{
-									pos: position{line: 418, col: 47, offset: 12345},
+									pos: position{line: 420, col: 47, offset: 11910},
 									run: (*parser).callonput9,
 									expr: &seqExpr{
-										pos: position{line: 418, col: 47, offset: 12345},
+										pos: position{line: 420, col: 47, offset: 11910},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 418, col: 47, offset: 12345},
+												pos:  position{line: 420, col: 47, offset: 11910},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 418, col: 50, offset: 12348},
+												pos:        position{line: 420, col: 50, offset: 11913},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 418, col: 54, offset: 12352},
+												pos:  position{line: 420, col: 54, offset: 11917},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 418, col: 57, offset: 12355},
+												pos:   position{line: 420, col: 57, offset: 11920},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 418, col: 60, offset: 12358},
+													pos:  position{line: 420, col: 60, offset: 11923},
 													name: "ExpressionAssignment",
 												},
 											},
@@ -2910,59 +2918,59 @@ var g = &grammar{
 		},
 		{
 			name: "rename",
-			pos:  position{line: 422, col: 1, offset: 12536},
+			pos:  position{line: 424, col: 1, offset: 12100},
 			expr: &actionExpr{
-				pos: position{line: 423, col: 5, offset: 12547},
+				pos: position{line: 425, col: 5, offset: 12111},
 				run: (*parser).callonrename1,
 				expr: &seqExpr{
-					pos: position{line: 423, col: 5, offset: 12547},
+					pos: position{line: 425, col: 5, offset: 12111},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 423, col: 5, offset: 12547},
+							pos:        position{line: 425, col: 5, offset: 12111},
 							val:        "rename",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 423, col: 15, offset: 12557},
+							pos:  position{line: 425, col: 15, offset: 12121},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 17, offset: 12559},
+							pos:   position{line: 425, col: 17, offset: 12123},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 423, col: 23, offset: 12565},
+								pos:  position{line: 425, col: 23, offset: 12129},
 								name: "FieldAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 39, offset: 12581},
+							pos:   position{line: 425, col: 39, offset: 12145},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 423, col: 44, offset: 12586},
+								pos: position{line: 425, col: 44, offset: 12150},
 								expr: &actionExpr{
-									pos: position{line: 423, col: 45, offset: 12587},
+									pos: position{line: 425, col: 45, offset: 12151},
 									run: (*parser).callonrename9,
 									expr: &seqExpr{
-										pos: position{line: 423, col: 45, offset: 12587},
+										pos: position{line: 425, col: 45, offset: 12151},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 423, col: 45, offset: 12587},
+												pos:  position{line: 425, col: 45, offset: 12151},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 423, col: 48, offset: 12590},
+												pos:        position{line: 425, col: 48, offset: 12154},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 423, col: 52, offset: 12594},
+												pos:  position{line: 425, col: 52, offset: 12158},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 423, col: 55, offset: 12597},
+												pos:   position{line: 425, col: 55, offset: 12161},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 423, col: 58, offset: 12600},
+													pos:  position{line: 425, col: 58, offset: 12164},
 													name: "FieldAssignment",
 												},
 											},
@@ -2977,52 +2985,752 @@ var g = &grammar{
 		},
 		{
 			name: "fuse",
-			pos:  position{line: 427, col: 1, offset: 12775},
+			pos:  position{line: 429, col: 1, offset: 12338},
 			expr: &actionExpr{
-				pos: position{line: 428, col: 5, offset: 12784},
+				pos: position{line: 430, col: 5, offset: 12347},
 				run: (*parser).callonfuse1,
 				expr: &litMatcher{
-					pos:        position{line: 428, col: 5, offset: 12784},
+					pos:        position{line: 430, col: 5, offset: 12347},
 					val:        "fuse",
 					ignoreCase: true,
 				},
 			},
 		},
 		{
-			name: "ExpressionAssignment",
-			pos:  position{line: 432, col: 1, offset: 12858},
+			name: "lookup",
+			pos:  position{line: 434, col: 1, offset: 12421},
+			expr: &actionExpr{
+				pos: position{line: 435, col: 5, offset: 12432},
+				run: (*parser).callonlookup1,
+				expr: &seqExpr{
+					pos: position{line: 435, col: 5, offset: 12432},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 435, col: 5, offset: 12432},
+							val:        "lookup",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 435, col: 15, offset: 12442},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 435, col: 20, offset: 12447},
+								name: "lookupArgs",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 435, col: 31, offset: 12458},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 435, col: 33, offset: 12460},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 435, col: 37, offset: 12464},
+								name: "Expression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "lookupArgs",
+			pos:  position{line: 454, col: 1, offset: 13031},
+			expr: &actionExpr{
+				pos: position{line: 454, col: 14, offset: 13044},
+				run: (*parser).callonlookupArgs1,
+				expr: &labeledExpr{
+					pos:   position{line: 454, col: 14, offset: 13044},
+					label: "args",
+					expr: &zeroOrMoreExpr{
+						pos: position{line: 454, col: 19, offset: 13049},
+						expr: &actionExpr{
+							pos: position{line: 454, col: 20, offset: 13050},
+							run: (*parser).callonlookupArgs4,
+							expr: &seqExpr{
+								pos: position{line: 454, col: 20, offset: 13050},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 454, col: 20, offset: 13050},
+										name: "_",
+									},
+									&labeledExpr{
+										pos:   position{line: 454, col: 22, offset: 13052},
+										label: "a",
+										expr: &ruleRefExpr{
+											pos:  position{line: 454, col: 24, offset: 13054},
+											name: "lookupArg",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "lookupArg",
+			pos:  position{line: 458, col: 1, offset: 13117},
+			expr: &choiceExpr{
+				pos: position{line: 459, col: 5, offset: 13131},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 459, col: 5, offset: 13131},
+						run: (*parser).callonlookupArg2,
+						expr: &seqExpr{
+							pos: position{line: 459, col: 5, offset: 13131},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 459, col: 5, offset: 13131},
+									val:        "-file",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 459, col: 13, offset: 13139},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 459, col: 15, offset: 13141},
+									label: "path",
+									expr: &ruleRefExpr{
+										pos:  position{line: 459, col: 20, offset: 13146},
+										name: "lookupPath",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 460, col: 5, offset: 13231},
+						run: (*parser).callonlookupArg8,
+						expr: &seqExpr{
+							pos: position{line: 460, col: 5, offset: 13231},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 460, col: 5, offset: 13231},
+									val:        "-index",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 460, col: 14, offset: 13240},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 460, col: 16, offset: 13242},
+									label: "path",
+									expr: &ruleRefExpr{
+										pos:  position{line: 460, col: 21, offset: 13247},
+										name: "lookupPath",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 461, col: 5, offset: 13333},
+						run: (*parser).callonlookupArg14,
+						expr: &seqExpr{
+							pos: position{line: 461, col: 5, offset: 13333},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 461, col: 5, offset: 13333},
+									val:        "-key",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 461, col: 12, offset: 13340},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 461, col: 14, offset: 13342},
+									label: "field",
+									expr: &ruleRefExpr{
+										pos:  position{line: 461, col: 20, offset: 13348},
+										name: "fieldName",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 462, col: 5, offset: 13432},
+						run: (*parser).callonlookupArg20,
+						expr: &seqExpr{
+							pos: position{line: 462, col: 5, offset: 13432},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 462, col: 5, offset: 13432},
+									val:        "-miss",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 462, col: 13, offset: 13440},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 462, col: 15, offset: 13442},
+									label: "miss",
+									expr: &actionExpr{
+										pos: position{line: 462, col: 21, offset: 13448},
+										run: (*parser).callonlookupArg25,
+										expr: &choiceExpr{
+											pos: position{line: 462, col: 22, offset: 13449},
+											alternatives: []interface{}{
+												&litMatcher{
+													pos:        position{line: 462, col: 22, offset: 13449},
+													val:        "drop",
+													ignoreCase: false,
+												},
+												&litMatcher{
+													pos:        position{line: 462, col: 31, offset: 13458},
+													val:        "keep",
+													ignoreCase: false,
+												},
+												&litMatcher{
+													pos:        position{line: 462, col: 40, offset: 13467},
+													val:        "null",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "lookupPath",
+			pos:  position{line: 464, col: 1, offset: 13578},
+			expr: &choiceExpr{
+				pos: position{line: 465, col: 5, offset: 13593},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 465, col: 5, offset: 13593},
+						name: "quotedString",
+					},
+					&actionExpr{
+						pos: position{line: 466, col: 5, offset: 13610},
+						run: (*parser).callonlookupPath3,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 466, col: 5, offset: 13610},
+							expr: &seqExpr{
+								pos: position{line: 466, col: 6, offset: 13611},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 466, col: 6, offset: 13611},
+										expr: &choiceExpr{
+											pos: position{line: 466, col: 8, offset: 13613},
+											alternatives: []interface{}{
+												&charClassMatcher{
+													pos:        position{line: 466, col: 8, offset: 13613},
+													val:        "[ \\t\\r\\n|;()]",
+													chars:      []rune{' ', '\t', '\r', '\n', '|', ';', '(', ')'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&litMatcher{
+													pos:        position{line: 466, col: 24, offset: 13629},
+													val:        "\"",
+													ignoreCase: false,
+												},
+											},
+										},
+									},
+									&anyMatcher{
+										line: 466, col: 29, offset: 13634,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "window",
+			pos:  position{line: 468, col: 1, offset: 13670},
+			expr: &actionExpr{
+				pos: position{line: 469, col: 5, offset: 13681},
+				run: (*parser).callonwindow1,
+				expr: &seqExpr{
+					pos: position{line: 469, col: 5, offset: 13681},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 469, col: 5, offset: 13681},
+							val:        "window",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 469, col: 15, offset: 13691},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 469, col: 20, offset: 13696},
+								name: "windowArgs",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 469, col: 31, offset: 13707},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 469, col: 33, offset: 13709},
+							label: "reducers",
+							expr: &ruleRefExpr{
+								pos:  position{line: 469, col: 42, offset: 13718},
+								name: "reducerList",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 469, col: 54, offset: 13730},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 469, col: 59, offset: 13735},
+								expr: &actionExpr{
+									pos: position{line: 469, col: 60, offset: 13736},
+									run: (*parser).callonwindow11,
+									expr: &seqExpr{
+										pos: position{line: 469, col: 60, offset: 13736},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 469, col: 60, offset: 13736},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 469, col: 62, offset: 13738},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 469, col: 64, offset: 13740},
+													name: "groupByKeys",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "windowArgs",
+			pos:  position{line: 481, col: 1, offset: 14112},
+			expr: &actionExpr{
+				pos: position{line: 481, col: 14, offset: 14125},
+				run: (*parser).callonwindowArgs1,
+				expr: &labeledExpr{
+					pos:   position{line: 481, col: 14, offset: 14125},
+					label: "args",
+					expr: &zeroOrMoreExpr{
+						pos: position{line: 481, col: 19, offset: 14130},
+						expr: &actionExpr{
+							pos: position{line: 481, col: 20, offset: 14131},
+							run: (*parser).callonwindowArgs4,
+							expr: &seqExpr{
+								pos: position{line: 481, col: 20, offset: 14131},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 481, col: 20, offset: 14131},
+										name: "_",
+									},
+									&labeledExpr{
+										pos:   position{line: 481, col: 22, offset: 14133},
+										label: "a",
+										expr: &ruleRefExpr{
+											pos:  position{line: 481, col: 24, offset: 14135},
+											name: "windowArg",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "windowArg",
+			pos:  position{line: 485, col: 1, offset: 14198},
+			expr: &choiceExpr{
+				pos: position{line: 486, col: 5, offset: 14212},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 486, col: 5, offset: 14212},
+						run: (*parser).callonwindowArg2,
+						expr: &seqExpr{
+							pos: position{line: 486, col: 5, offset: 14212},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 486, col: 5, offset: 14212},
+									val:        "-rows",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 486, col: 13, offset: 14220},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 486, col: 15, offset: 14222},
+									label: "n",
+									expr: &ruleRefExpr{
+										pos:  position{line: 486, col: 17, offset: 14224},
+										name: "unsignedInteger",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 487, col: 5, offset: 14311},
+						run: (*parser).callonwindowArg8,
+						expr: &seqExpr{
+							pos: position{line: 487, col: 5, offset: 14311},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 487, col: 5, offset: 14311},
+									val:        "-range",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 487, col: 14, offset: 14320},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 487, col: 16, offset: 14322},
+									label: "dur",
+									expr: &ruleRefExpr{
+										pos:  position{line: 487, col: 20, offset: 14326},
+										name: "duration",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExpressionAssignment",
+			pos:  position{line: 489, col: 1, offset: 14406},
+			expr: &actionExpr{
+				pos: position{line: 490, col: 5, offset: 14431},
+				run: (*parser).callonExpressionAssignment1,
+				expr: &seqExpr{
+					pos: position{line: 490, col: 5, offset: 14431},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 490, col: 5, offset: 14431},
+							label: "f",
+							expr: &ruleRefExpr{
+								pos:  position{line: 490, col: 7, offset: 14433},
+								name: "fieldPath",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 490, col: 17, offset: 14443},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 490, col: 20, offset: 14446},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 490, col: 24, offset: 14450},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 490, col: 27, offset: 14453},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 490, col: 29, offset: 14455},
+								name: "Expression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fieldPath",
+			pos:  position{line: 494, col: 1, offset: 14546},
+			expr: &actionExpr{
+				pos: position{line: 494, col: 13, offset: 14558},
+				run: (*parser).callonfieldPath1,
+				expr: &seqExpr{
+					pos: position{line: 494, col: 13, offset: 14558},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 494, col: 13, offset: 14558},
+							name: "fieldName",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 494, col: 23, offset: 14568},
+							expr: &seqExpr{
+								pos: position{line: 494, col: 24, offset: 14569},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 494, col: 24, offset: 14569},
+										val:        ".",
+										ignoreCase: false,
+									},
+									&ruleRefExpr{
+										pos:  position{line: 494, col: 28, offset: 14573},
+										name: "fieldName",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FieldAssignment",
+			pos:  position{line: 496, col: 1, offset: 14617},
+			expr: &actionExpr{
+				pos: position{line: 497, col: 5, offset: 14637},
+				run: (*parser).callonFieldAssignment1,
+				expr: &seqExpr{
+					pos: position{line: 497, col: 5, offset: 14637},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 497, col: 5, offset: 14637},
+							label: "l",
+							expr: &ruleRefExpr{
+								pos:  position{line: 497, col: 7, offset: 14639},
+								name: "DotExprText",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 497, col: 19, offset: 14651},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 497, col: 22, offset: 14654},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 497, col: 26, offset: 14658},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 497, col: 29, offset: 14661},
+							label: "r",
+							expr: &ruleRefExpr{
+								pos:  position{line: 497, col: 31, offset: 14663},
+								name: "DotExpr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PrimaryExpression",
+			pos:  position{line: 501, col: 1, offset: 14747},
+			expr: &choiceExpr{
+				pos: position{line: 502, col: 5, offset: 14769},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 502, col: 5, offset: 14769},
+						name: "StringLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 503, col: 5, offset: 14787},
+						name: "RegexpLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 504, col: 5, offset: 14805},
+						name: "SubnetLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 505, col: 5, offset: 14823},
+						name: "AddressLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 506, col: 5, offset: 14842},
+						name: "FloatLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 507, col: 5, offset: 14859},
+						name: "IntegerLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 508, col: 5, offset: 14878},
+						name: "BooleanLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 509, col: 5, offset: 14897},
+						name: "NullLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 510, col: 5, offset: 14913},
+						name: "Field",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 511, col: 5, offset: 14923},
+						name: "RecordExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 512, col: 5, offset: 14944},
+						name: "ArrayExpression",
+					},
+					&actionExpr{
+						pos: position{line: 513, col: 5, offset: 14964},
+						run: (*parser).callonPrimaryExpression13,
+						expr: &seqExpr{
+							pos: position{line: 513, col: 5, offset: 14964},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 513, col: 5, offset: 14964},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 513, col: 9, offset: 14968},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 513, col: 12, offset: 14971},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 513, col: 17, offset: 14976},
+										name: "Expression",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 513, col: 28, offset: 14987},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 513, col: 31, offset: 14990},
+									val:        ")",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RecordExpression",
+			pos:  position{line: 515, col: 1, offset: 15016},
+			expr: &actionExpr{
+				pos: position{line: 516, col: 5, offset: 15037},
+				run: (*parser).callonRecordExpression1,
+				expr: &seqExpr{
+					pos: position{line: 516, col: 5, offset: 15037},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 516, col: 5, offset: 15037},
+							val:        "{",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 516, col: 9, offset: 15041},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 516, col: 12, offset: 15044},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 516, col: 18, offset: 15050},
+								name: "RecordField",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 516, col: 30, offset: 15062},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 516, col: 35, offset: 15067},
+								expr: &actionExpr{
+									pos: position{line: 516, col: 36, offset: 15068},
+									run: (*parser).callonRecordExpression9,
+									expr: &seqExpr{
+										pos: position{line: 516, col: 36, offset: 15068},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 516, col: 36, offset: 15068},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 516, col: 39, offset: 15071},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 516, col: 43, offset: 15075},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 516, col: 46, offset: 15078},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 516, col: 48, offset: 15080},
+													name: "RecordField",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 516, col: 80, offset: 15112},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 516, col: 83, offset: 15115},
+							val:        "}",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RecordField",
+			pos:  position{line: 520, col: 1, offset: 15256},
 			expr: &actionExpr{
-				pos: position{line: 433, col: 5, offset: 12883},
-				run: (*parser).callonExpressionAssignment1,
+				pos: position{line: 521, col: 5, offset: 15272},
+				run: (*parser).callonRecordField1,
 				expr: &seqExpr{
-					pos: position{line: 433, col: 5, offset: 12883},
+					pos: position{line: 521, col: 5, offset: 15272},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 433, col: 5, offset: 12883},
+							pos:   position{line: 521, col: 5, offset: 15272},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 433, col: 7, offset: 12885},
+								pos:  position{line: 521, col: 7, offset: 15274},
 								name: "fieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 433, col: 17, offset: 12895},
+							pos:  position{line: 521, col: 17, offset: 15284},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 433, col: 20, offset: 12898},
-							val:        "=",
+							pos:        position{line: 521, col: 20, offset: 15287},
+							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 433, col: 24, offset: 12902},
+							pos:  position{line: 521, col: 24, offset: 15291},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 433, col: 27, offset: 12905},
+							pos:   position{line: 521, col: 27, offset: 15294},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 433, col: 29, offset: 12907},
+								pos:  position{line: 521, col: 29, offset: 15296},
 								name: "Expression",
 							},
 						},
@@ -3031,194 +3739,148 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FieldAssignment",
-			pos:  position{line: 437, col: 1, offset: 12998},
+			name: "ArrayExpression",
+			pos:  position{line: 525, col: 1, offset: 15387},
 			expr: &actionExpr{
-				pos: position{line: 438, col: 5, offset: 13018},
-				run: (*parser).callonFieldAssignment1,
+				pos: position{line: 526, col: 5, offset: 15407},
+				run: (*parser).callonArrayExpression1,
 				expr: &seqExpr{
-					pos: position{line: 438, col: 5, offset: 13018},
+					pos: position{line: 526, col: 5, offset: 15407},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 438, col: 5, offset: 13018},
-							label: "l",
-							expr: &ruleRefExpr{
-								pos:  position{line: 438, col: 7, offset: 13020},
-								name: "DotExprText",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 438, col: 19, offset: 13032},
-							name: "__",
-						},
 						&litMatcher{
-							pos:        position{line: 438, col: 22, offset: 13035},
-							val:        "=",
+							pos:        position{line: 526, col: 5, offset: 15407},
+							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 438, col: 26, offset: 13039},
+							pos:  position{line: 526, col: 9, offset: 15411},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 438, col: 29, offset: 13042},
-							label: "r",
+							pos:   position{line: 526, col: 12, offset: 15414},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 438, col: 31, offset: 13044},
-								name: "DotExpr",
+								pos:  position{line: 526, col: 18, offset: 15420},
+								name: "Expression",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "PrimaryExpression",
-			pos:  position{line: 442, col: 1, offset: 13128},
-			expr: &choiceExpr{
-				pos: position{line: 443, col: 5, offset: 13150},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 443, col: 5, offset: 13150},
-						name: "StringLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 444, col: 5, offset: 13168},
-						name: "RegexpLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 445, col: 5, offset: 13186},
-						name: "SubnetLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 446, col: 5, offset: 13204},
-						name: "AddressLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 447, col: 5, offset: 13223},
-						name: "FloatLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 448, col: 5, offset: 13240},
-						name: "IntegerLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 449, col: 5, offset: 13259},
-						name: "BooleanLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 450, col: 5, offset: 13278},
-						name: "NullLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 451, col: 5, offset: 13294},
-						name: "Field",
-					},
-					&actionExpr{
-						pos: position{line: 452, col: 5, offset: 13304},
-						run: (*parser).callonPrimaryExpression11,
-						expr: &seqExpr{
-							pos: position{line: 452, col: 5, offset: 13304},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 452, col: 5, offset: 13304},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 452, col: 9, offset: 13308},
-									name: "__",
-								},
-								&labeledExpr{
-									pos:   position{line: 452, col: 12, offset: 13311},
-									label: "expr",
-									expr: &ruleRefExpr{
-										pos:  position{line: 452, col: 17, offset: 13316},
-										name: "Expression",
+						&labeledExpr{
+							pos:   position{line: 526, col: 29, offset: 15431},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 526, col: 34, offset: 15436},
+								expr: &actionExpr{
+									pos: position{line: 526, col: 35, offset: 15437},
+									run: (*parser).callonArrayExpression9,
+									expr: &seqExpr{
+										pos: position{line: 526, col: 35, offset: 15437},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 35, offset: 15437},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 526, col: 38, offset: 15440},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 526, col: 42, offset: 15444},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 526, col: 45, offset: 15447},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 526, col: 47, offset: 15449},
+													name: "Expression",
+												},
+											},
+										},
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 452, col: 28, offset: 13327},
-									name: "__",
-								},
-								&litMatcher{
-									pos:        position{line: 452, col: 31, offset: 13330},
-									val:        ")",
-									ignoreCase: false,
-								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 526, col: 78, offset: 15480},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 526, col: 81, offset: 15483},
+							val:        "]",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 460, col: 1, offset: 13532},
+			pos:  position{line: 536, col: 1, offset: 15628},
 			expr: &ruleRefExpr{
-				pos:  position{line: 460, col: 14, offset: 13545},
+				pos:  position{line: 536, col: 14, offset: 15641},
 				name: "ConditionalExpression",
 			},
 		},
 		{
 			name: "ConditionalExpression",
-			pos:  position{line: 462, col: 1, offset: 13568},
+			pos:  position{line: 538, col: 1, offset: 15664},
 			expr: &choiceExpr{
-				pos: position{line: 463, col: 5, offset: 13594},
+				pos: position{line: 539, col: 5, offset: 15690},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 463, col: 5, offset: 13594},
+						pos: position{line: 539, col: 5, offset: 15690},
 						run: (*parser).callonConditionalExpression2,
 						expr: &seqExpr{
-							pos: position{line: 463, col: 5, offset: 13594},
+							pos: position{line: 539, col: 5, offset: 15690},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 463, col: 5, offset: 13594},
+									pos:   position{line: 539, col: 5, offset: 15690},
 									label: "condition",
 									expr: &ruleRefExpr{
-										pos:  position{line: 463, col: 15, offset: 13604},
+										pos:  position{line: 539, col: 15, offset: 15700},
 										name: "LogicalORExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 463, col: 35, offset: 13624},
+									pos:  position{line: 539, col: 35, offset: 15720},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 463, col: 38, offset: 13627},
+									pos:        position{line: 539, col: 38, offset: 15723},
 									val:        "?",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 463, col: 42, offset: 13631},
+									pos:  position{line: 539, col: 42, offset: 15727},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 463, col: 45, offset: 13634},
+									pos:   position{line: 539, col: 45, offset: 15730},
 									label: "thenClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 463, col: 56, offset: 13645},
+										pos:  position{line: 539, col: 56, offset: 15741},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 463, col: 67, offset: 13656},
+									pos:  position{line: 539, col: 67, offset: 15752},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 463, col: 70, offset: 13659},
+									pos:        position{line: 539, col: 70, offset: 15755},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 463, col: 74, offset: 13663},
+									pos:  position{line: 539, col: 74, offset: 15759},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 463, col: 77, offset: 13666},
+									pos:   position{line: 539, col: 77, offset: 15762},
 									label: "elseClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 463, col: 88, offset: 13677},
+										pos:  position{line: 539, col: 88, offset: 15773},
 										name: "Expression",
 									},
 								},
@@ -3226,7 +3888,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 466, col: 5, offset: 13826},
+						pos:  position{line: 542, col: 5, offset: 15922},
 						name: "LogicalORExpression",
 					},
 				},
@@ -3234,53 +3896,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalORExpression",
-			pos:  position{line: 468, col: 1, offset: 13847},
+			pos:  position{line: 544, col: 1, offset: 15943},
 			expr: &actionExpr{
-				pos: position{line: 469, col: 5, offset: 13871},
+				pos: position{line: 545, col: 5, offset: 15967},
 				run: (*parser).callonLogicalORExpression1,
 				expr: &seqExpr{
-					pos: position{line: 469, col: 5, offset: 13871},
+					pos: position{line: 545, col: 5, offset: 15967},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 469, col: 5, offset: 13871},
+							pos:   position{line: 545, col: 5, offset: 15967},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 469, col: 11, offset: 13877},
+								pos:  position{line: 545, col: 11, offset: 15973},
 								name: "LogicalANDExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 470, col: 5, offset: 13902},
+							pos:   position{line: 546, col: 5, offset: 15998},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 470, col: 10, offset: 13907},
+								pos: position{line: 546, col: 10, offset: 16003},
 								expr: &actionExpr{
-									pos: position{line: 470, col: 11, offset: 13908},
+									pos: position{line: 546, col: 11, offset: 16004},
 									run: (*parser).callonLogicalORExpression7,
 									expr: &seqExpr{
-										pos: position{line: 470, col: 11, offset: 13908},
+										pos: position{line: 546, col: 11, offset: 16004},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 470, col: 11, offset: 13908},
+												pos:  position{line: 546, col: 11, offset: 16004},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 470, col: 14, offset: 13911},
+												pos:   position{line: 546, col: 14, offset: 16007},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 470, col: 17, offset: 13914},
+													pos:  position{line: 546, col: 17, offset: 16010},
 													name: "orToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 470, col: 25, offset: 13922},
+												pos:  position{line: 546, col: 25, offset: 16018},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 470, col: 28, offset: 13925},
+												pos:   position{line: 546, col: 28, offset: 16021},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 470, col: 33, offset: 13930},
+													pos:  position{line: 546, col: 33, offset: 16026},
 													name: "LogicalANDExpression",
 												},
 											},
@@ -3295,53 +3957,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalANDExpression",
-			pos:  position{line: 474, col: 1, offset: 14054},
+			pos:  position{line: 550, col: 1, offset: 16150},
 			expr: &actionExpr{
-				pos: position{line: 475, col: 5, offset: 14079},
+				pos: position{line: 551, col: 5, offset: 16175},
 				run: (*parser).callonLogicalANDExpression1,
 				expr: &seqExpr{
-					pos: position{line: 475, col: 5, offset: 14079},
+					pos: position{line: 551, col: 5, offset: 16175},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 475, col: 5, offset: 14079},
+							pos:   position{line: 551, col: 5, offset: 16175},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 475, col: 11, offset: 14085},
+								pos:  position{line: 551, col: 11, offset: 16181},
 								name: "EqualityCompareExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 476, col: 5, offset: 14115},
+							pos:   position{line: 552, col: 5, offset: 16211},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 476, col: 10, offset: 14120},
+								pos: position{line: 552, col: 10, offset: 16216},
 								expr: &actionExpr{
-									pos: position{line: 476, col: 11, offset: 14121},
+									pos: position{line: 552, col: 11, offset: 16217},
 									run: (*parser).callonLogicalANDExpression7,
 									expr: &seqExpr{
-										pos: position{line: 476, col: 11, offset: 14121},
+										pos: position{line: 552, col: 11, offset: 16217},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 476, col: 11, offset: 14121},
+												pos:  position{line: 552, col: 11, offset: 16217},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 476, col: 14, offset: 14124},
+												pos:   position{line: 552, col: 14, offset: 16220},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 476, col: 17, offset: 14127},
+													pos:  position{line: 552, col: 17, offset: 16223},
 													name: "andToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 476, col: 26, offset: 14136},
+												pos:  position{line: 552, col: 26, offset: 16232},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 476, col: 29, offset: 14139},
+												pos:   position{line: 552, col: 29, offset: 16235},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 476, col: 34, offset: 14144},
+													pos:  position{line: 552, col: 34, offset: 16240},
 													name: "EqualityCompareExpression",
 												},
 											},
@@ -3356,53 +4018,53 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityCompareExpression",
-			pos:  position{line: 480, col: 1, offset: 14273},
+			pos:  position{line: 556, col: 1, offset: 16369},
 			expr: &actionExpr{
-				pos: position{line: 481, col: 5, offset: 14303},
+				pos: position{line: 557, col: 5, offset: 16399},
 				run: (*parser).callonEqualityCompareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 481, col: 5, offset: 14303},
+					pos: position{line: 557, col: 5, offset: 16399},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 481, col: 5, offset: 14303},
+							pos:   position{line: 557, col: 5, offset: 16399},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 11, offset: 14309},
+								pos:  position{line: 557, col: 11, offset: 16405},
 								name: "RelativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 5, offset: 14332},
+							pos:   position{line: 558, col: 5, offset: 16428},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 482, col: 10, offset: 14337},
+								pos: position{line: 558, col: 10, offset: 16433},
 								expr: &actionExpr{
-									pos: position{line: 482, col: 11, offset: 14338},
+									pos: position{line: 558, col: 11, offset: 16434},
 									run: (*parser).callonEqualityCompareExpression7,
 									expr: &seqExpr{
-										pos: position{line: 482, col: 11, offset: 14338},
+										pos: position{line: 558, col: 11, offset: 16434},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 482, col: 11, offset: 14338},
+												pos:  position{line: 558, col: 11, offset: 16434},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 482, col: 14, offset: 14341},
+												pos:   position{line: 558, col: 14, offset: 16437},
 												label: "comp",
 												expr: &ruleRefExpr{
-													pos:  position{line: 482, col: 19, offset: 14346},
+													pos:  position{line: 558, col: 19, offset: 16442},
 													name: "EqualityComparator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 482, col: 38, offset: 14365},
+												pos:  position{line: 558, col: 38, offset: 16461},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 482, col: 41, offset: 14368},
+												pos:   position{line: 558, col: 41, offset: 16464},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 482, col: 46, offset: 14373},
+													pos:  position{line: 558, col: 46, offset: 16469},
 													name: "RelativeExpression",
 												},
 											},
@@ -3417,30 +4079,30 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityOperator",
-			pos:  position{line: 486, col: 1, offset: 14497},
+			pos:  position{line: 562, col: 1, offset: 16593},
 			expr: &actionExpr{
-				pos: position{line: 486, col: 20, offset: 14516},
+				pos: position{line: 562, col: 20, offset: 16612},
 				run: (*parser).callonEqualityOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 486, col: 21, offset: 14517},
+					pos: position{line: 562, col: 21, offset: 16613},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 486, col: 21, offset: 14517},
+							pos:        position{line: 562, col: 21, offset: 16613},
 							val:        "=~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 486, col: 28, offset: 14524},
+							pos:        position{line: 562, col: 28, offset: 16620},
 							val:        "!~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 486, col: 35, offset: 14531},
+							pos:        position{line: 562, col: 35, offset: 16627},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 486, col: 41, offset: 14537},
+							pos:        position{line: 562, col: 41, offset: 16633},
 							val:        "!=",
 							ignoreCase: false,
 						},
@@ -3450,19 +4112,19 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityComparator",
-			pos:  position{line: 488, col: 1, offset: 14575},
+			pos:  position{line: 564, col: 1, offset: 16671},
 			expr: &choiceExpr{
-				pos: position{line: 489, col: 5, offset: 14598},
+				pos: position{line: 565, col: 5, offset: 16694},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 489, col: 5, offset: 14598},
+						pos:  position{line: 565, col: 5, offset: 16694},
 						name: "EqualityOperator",
 					},
 					&actionExpr{
-						pos: position{line: 490, col: 5, offset: 14619},
+						pos: position{line: 566, col: 5, offset: 16715},
 						run: (*parser).callonEqualityComparator3,
 						expr: &litMatcher{
-							pos:        position{line: 490, col: 5, offset: 14619},
+							pos:        position{line: 566, col: 5, offset: 16715},
 							val:        "in",
 							ignoreCase: false,
 						},
@@ -3472,53 +4134,53 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeExpression",
-			pos:  position{line: 492, col: 1, offset: 14656},
+			pos:  position{line: 568, col: 1, offset: 16752},
 			expr: &actionExpr{
-				pos: position{line: 493, col: 5, offset: 14679},
+				pos: position{line: 569, col: 5, offset: 16775},
 				run: (*parser).callonRelativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 493, col: 5, offset: 14679},
+					pos: position{line: 569, col: 5, offset: 16775},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 493, col: 5, offset: 14679},
+							pos:   position{line: 569, col: 5, offset: 16775},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 493, col: 11, offset: 14685},
+								pos:  position{line: 569, col: 11, offset: 16781},
 								name: "AdditiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 494, col: 5, offset: 14708},
+							pos:   position{line: 570, col: 5, offset: 16804},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 494, col: 10, offset: 14713},
+								pos: position{line: 570, col: 10, offset: 16809},
 								expr: &actionExpr{
-									pos: position{line: 494, col: 11, offset: 14714},
+									pos: position{line: 570, col: 11, offset: 16810},
 									run: (*parser).callonRelativeExpression7,
 									expr: &seqExpr{
-										pos: position{line: 494, col: 11, offset: 14714},
+										pos: position{line: 570, col: 11, offset: 16810},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 494, col: 11, offset: 14714},
+												pos:  position{line: 570, col: 11, offset: 16810},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 494, col: 14, offset: 14717},
+												pos:   position{line: 570, col: 14, offset: 16813},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 494, col: 17, offset: 14720},
+													pos:  position{line: 570, col: 17, offset: 16816},
 													name: "RelativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 494, col: 34, offset: 14737},
+												pos:  position{line: 570, col: 34, offset: 16833},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 494, col: 37, offset: 14740},
+												pos:   position{line: 570, col: 37, offset: 16836},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 494, col: 42, offset: 14745},
+													pos:  position{line: 570, col: 42, offset: 16841},
 													name: "AdditiveExpression",
 												},
 											},
@@ -3533,30 +4195,30 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeOperator",
-			pos:  position{line: 498, col: 1, offset: 14867},
+			pos:  position{line: 574, col: 1, offset: 16963},
 			expr: &actionExpr{
-				pos: position{line: 498, col: 20, offset: 14886},
+				pos: position{line: 574, col: 20, offset: 16982},
 				run: (*parser).callonRelativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 498, col: 21, offset: 14887},
+					pos: position{line: 574, col: 21, offset: 16983},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 498, col: 21, offset: 14887},
+							pos:        position{line: 574, col: 21, offset: 16983},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 498, col: 28, offset: 14894},
+							pos:        position{line: 574, col: 28, offset: 16990},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 498, col: 34, offset: 14900},
+							pos:        position{line: 574, col: 34, offset: 16996},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 498, col: 41, offset: 14907},
+							pos:        position{line: 574, col: 41, offset: 17003},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -3566,53 +4228,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpression",
-			pos:  position{line: 500, col: 1, offset: 14944},
+			pos:  position{line: 576, col: 1, offset: 17040},
 			expr: &actionExpr{
-				pos: position{line: 501, col: 5, offset: 14967},
+				pos: position{line: 577, col: 5, offset: 17063},
 				run: (*parser).callonAdditiveExpression1,
 				expr: &seqExpr{
-					pos: position{line: 501, col: 5, offset: 14967},
+					pos: position{line: 577, col: 5, offset: 17063},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 501, col: 5, offset: 14967},
+							pos:   position{line: 577, col: 5, offset: 17063},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 501, col: 11, offset: 14973},
+								pos:  position{line: 577, col: 11, offset: 17069},
 								name: "MultiplicativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 502, col: 5, offset: 15002},
+							pos:   position{line: 578, col: 5, offset: 17098},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 502, col: 10, offset: 15007},
+								pos: position{line: 578, col: 10, offset: 17103},
 								expr: &actionExpr{
-									pos: position{line: 502, col: 11, offset: 15008},
+									pos: position{line: 578, col: 11, offset: 17104},
 									run: (*parser).callonAdditiveExpression7,
 									expr: &seqExpr{
-										pos: position{line: 502, col: 11, offset: 15008},
+										pos: position{line: 578, col: 11, offset: 17104},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 502, col: 11, offset: 15008},
+												pos:  position{line: 578, col: 11, offset: 17104},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 502, col: 14, offset: 15011},
+												pos:   position{line: 578, col: 14, offset: 17107},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 502, col: 17, offset: 15014},
+													pos:  position{line: 578, col: 17, offset: 17110},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 502, col: 34, offset: 15031},
+												pos:  position{line: 578, col: 34, offset: 17127},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 502, col: 37, offset: 15034},
+												pos:   position{line: 578, col: 37, offset: 17130},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 502, col: 42, offset: 15039},
+													pos:  position{line: 578, col: 42, offset: 17135},
 													name: "MultiplicativeExpression",
 												},
 											},
@@ -3627,20 +4289,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 506, col: 1, offset: 15167},
+			pos:  position{line: 582, col: 1, offset: 17263},
 			expr: &actionExpr{
-				pos: position{line: 506, col: 20, offset: 15186},
+				pos: position{line: 582, col: 20, offset: 17282},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 506, col: 21, offset: 15187},
+					pos: position{line: 582, col: 21, offset: 17283},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 506, col: 21, offset: 15187},
+							pos:        position{line: 582, col: 21, offset: 17283},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 506, col: 27, offset: 15193},
+							pos:        position{line: 582, col: 27, offset: 17289},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -3650,53 +4312,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpression",
-			pos:  position{line: 508, col: 1, offset: 15230},
+			pos:  position{line: 584, col: 1, offset: 17326},
 			expr: &actionExpr{
-				pos: position{line: 509, col: 5, offset: 15259},
+				pos: position{line: 585, col: 5, offset: 17355},
 				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 509, col: 5, offset: 15259},
+					pos: position{line: 585, col: 5, offset: 17355},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 509, col: 5, offset: 15259},
+							pos:   position{line: 585, col: 5, offset: 17355},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 509, col: 11, offset: 15265},
+								pos:  position{line: 585, col: 11, offset: 17361},
 								name: "NotExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 510, col: 5, offset: 15283},
+							pos:   position{line: 586, col: 5, offset: 17379},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 510, col: 10, offset: 15288},
+								pos: position{line: 586, col: 10, offset: 17384},
 								expr: &actionExpr{
-									pos: position{line: 510, col: 11, offset: 15289},
+									pos: position{line: 586, col: 11, offset: 17385},
 									run: (*parser).callonMultiplicativeExpression7,
 									expr: &seqExpr{
-										pos: position{line: 510, col: 11, offset: 15289},
+										pos: position{line: 586, col: 11, offset: 17385},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 510, col: 11, offset: 15289},
+												pos:  position{line: 586, col: 11, offset: 17385},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 510, col: 14, offset: 15292},
+												pos:   position{line: 586, col: 14, offset: 17388},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 510, col: 17, offset: 15295},
+													pos:  position{line: 586, col: 17, offset: 17391},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 510, col: 40, offset: 15318},
+												pos:  position{line: 586, col: 40, offset: 17414},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 510, col: 43, offset: 15321},
+												pos:   position{line: 586, col: 43, offset: 17417},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 510, col: 48, offset: 15326},
+													pos:  position{line: 586, col: 48, offset: 17422},
 													name: "NotExpression",
 												},
 											},
@@ -3711,20 +4373,20 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 514, col: 1, offset: 15443},
+			pos:  position{line: 590, col: 1, offset: 17539},
 			expr: &actionExpr{
-				pos: position{line: 514, col: 26, offset: 15468},
+				pos: position{line: 590, col: 26, offset: 17564},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 514, col: 27, offset: 15469},
+					pos: position{line: 590, col: 27, offset: 17565},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 514, col: 27, offset: 15469},
+							pos:        position{line: 590, col: 27, offset: 17565},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 514, col: 33, offset: 15475},
+							pos:        position{line: 590, col: 33, offset: 17571},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -3734,30 +4396,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpression",
-			pos:  position{line: 516, col: 1, offset: 15512},
+			pos:  position{line: 592, col: 1, offset: 17608},
 			expr: &choiceExpr{
-				pos: position{line: 517, col: 5, offset: 15530},
+				pos: position{line: 593, col: 5, offset: 17626},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 517, col: 5, offset: 15530},
+						pos: position{line: 593, col: 5, offset: 17626},
 						run: (*parser).callonNotExpression2,
 						expr: &seqExpr{
-							pos: position{line: 517, col: 5, offset: 15530},
+							pos: position{line: 593, col: 5, offset: 17626},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 517, col: 5, offset: 15530},
+									pos:        position{line: 593, col: 5, offset: 17626},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 517, col: 9, offset: 15534},
+									pos:  position{line: 593, col: 9, offset: 17630},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 517, col: 12, offset: 15537},
+									pos:   position{line: 593, col: 12, offset: 17633},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 517, col: 14, offset: 15539},
+										pos:  position{line: 593, col: 14, offset: 17635},
 										name: "NotExpression",
 									},
 								},
@@ -3765,7 +4427,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 520, col: 5, offset: 15658},
+						pos:  position{line: 596, col: 5, offset: 17754},
 						name: "CastExpression",
 					},
 				},
@@ -3773,34 +4435,34 @@ var g = &grammar{
 		},
 		{
 			name: "CastExpression",
-			pos:  position{line: 522, col: 1, offset: 15674},
+			pos:  position{line: 598, col: 1, offset: 17770},
 			expr: &choiceExpr{
-				pos: position{line: 523, col: 5, offset: 15693},
+				pos: position{line: 599, col: 5, offset: 17789},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 523, col: 5, offset: 15693},
+						pos: position{line: 599, col: 5, offset: 17789},
 						run: (*parser).callonCastExpression2,
 						expr: &seqExpr{
-							pos: position{line: 523, col: 5, offset: 15693},
+							pos: position{line: 599, col: 5, offset: 17789},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 523, col: 5, offset: 15693},
+									pos:   position{line: 599, col: 5, offset: 17789},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 523, col: 7, offset: 15695},
+										pos:  position{line: 599, col: 7, offset: 17791},
 										name: "CallExpression",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 523, col: 22, offset: 15710},
+									pos:        position{line: 599, col: 22, offset: 17806},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 523, col: 26, offset: 15714},
+									pos:   position{line: 599, col: 26, offset: 17810},
 									label: "ct",
 									expr: &ruleRefExpr{
-										pos:  position{line: 523, col: 29, offset: 15717},
+										pos:  position{line: 599, col: 29, offset: 17813},
 										name: "PrimitiveType",
 									},
 								},
@@ -3808,7 +4470,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 526, col: 5, offset: 15823},
+						pos:  position{line: 602, col: 5, offset: 17919},
 						name: "CallExpression",
 					},
 				},
@@ -3816,115 +4478,115 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 528, col: 1, offset: 15839},
+			pos:  position{line: 604, col: 1, offset: 17935},
 			expr: &actionExpr{
-				pos: position{line: 529, col: 5, offset: 15857},
+				pos: position{line: 605, col: 5, offset: 17953},
 				run: (*parser).callonPrimitiveType1,
 				expr: &choiceExpr{
-					pos: position{line: 529, col: 9, offset: 15861},
+					pos: position{line: 605, col: 7, offset: 17955},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 529, col: 9, offset: 15861},
+							pos:        position{line: 605, col: 7, offset: 17955},
 							val:        "bytes",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 529, col: 19, offset: 15871},
+							pos:        position{line: 605, col: 17, offset: 17965},
 							val:        "uint8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 529, col: 29, offset: 15881},
+							pos:        position{line: 605, col: 27, offset: 17975},
 							val:        "uint16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 529, col: 40, offset: 15892},
+							pos:        position{line: 605, col: 38, offset: 17986},
 							val:        "uint32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 529, col: 51, offset: 15903},
+							pos:        position{line: 605, col: 49, offset: 17997},
 							val:        "uint64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 9, offset: 15920},
+							pos:        position{line: 606, col: 9, offset: 18014},
 							val:        "int8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 18, offset: 15929},
+							pos:        position{line: 606, col: 18, offset: 18023},
 							val:        "int16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 28, offset: 15939},
+							pos:        position{line: 606, col: 28, offset: 18033},
 							val:        "int32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 38, offset: 15949},
+							pos:        position{line: 606, col: 38, offset: 18043},
 							val:        "int64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 531, col: 9, offset: 15965},
+							pos:        position{line: 607, col: 9, offset: 18059},
 							val:        "duration",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 531, col: 22, offset: 15978},
+							pos:        position{line: 607, col: 22, offset: 18072},
 							val:        "time",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 532, col: 9, offset: 15993},
+							pos:        position{line: 608, col: 9, offset: 18087},
 							val:        "float64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 533, col: 9, offset: 16011},
+							pos:        position{line: 609, col: 9, offset: 18105},
 							val:        "bool",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 533, col: 18, offset: 16020},
+							pos:        position{line: 609, col: 18, offset: 18114},
 							val:        "bytes",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 533, col: 28, offset: 16030},
+							pos:        position{line: 609, col: 28, offset: 18124},
 							val:        "string",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 533, col: 39, offset: 16041},
+							pos:        position{line: 609, col: 39, offset: 18135},
 							val:        "bstring",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 9, offset: 16059},
+							pos:        position{line: 610, col: 9, offset: 18153},
 							val:        "ip",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 16, offset: 16066},
+							pos:        position{line: 610, col: 16, offset: 18160},
 							val:        "net",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 535, col: 9, offset: 16080},
+							pos:        position{line: 611, col: 9, offset: 18174},
 							val:        "type",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 535, col: 18, offset: 16089},
+							pos:        position{line: 611, col: 18, offset: 18183},
 							val:        "error",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 535, col: 28, offset: 16099},
+							pos:        position{line: 611, col: 28, offset: 18193},
 							val:        "null",
 							ignoreCase: false,
 						},
@@ -3934,43 +4596,43 @@ var g = &grammar{
 		},
 		{
 			name: "CallExpression",
-			pos:  position{line: 537, col: 1, offset: 16140},
+			pos:  position{line: 613, col: 1, offset: 18234},
 			expr: &choiceExpr{
-				pos: position{line: 538, col: 5, offset: 16159},
+				pos: position{line: 614, col: 5, offset: 18253},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 538, col: 5, offset: 16159},
+						pos: position{line: 614, col: 5, offset: 18253},
 						run: (*parser).callonCallExpression2,
 						expr: &seqExpr{
-							pos: position{line: 538, col: 5, offset: 16159},
+							pos: position{line: 614, col: 5, offset: 18253},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 538, col: 5, offset: 16159},
+									pos:   position{line: 614, col: 5, offset: 18253},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 538, col: 8, offset: 16162},
+										pos:  position{line: 614, col: 8, offset: 18256},
 										name: "FunctionName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 538, col: 21, offset: 16175},
+									pos:  position{line: 614, col: 21, offset: 18269},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 538, col: 24, offset: 16178},
+									pos:        position{line: 614, col: 24, offset: 18272},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 538, col: 28, offset: 16182},
+									pos:   position{line: 614, col: 28, offset: 18276},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 538, col: 33, offset: 16187},
+										pos:  position{line: 614, col: 33, offset: 18281},
 										name: "ArgumentList",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 538, col: 46, offset: 16200},
+									pos:        position{line: 614, col: 46, offset: 18294},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3978,7 +4640,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 541, col: 5, offset: 16311},
+						pos:  position{line: 617, col: 5, offset: 18405},
 						name: "DereferenceExpression",
 					},
 				},
@@ -3986,21 +4648,21 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionName",
-			pos:  position{line: 543, col: 1, offset: 16334},
+			pos:  position{line: 619, col: 1, offset: 18428},
 			expr: &actionExpr{
-				pos: position{line: 544, col: 5, offset: 16351},
+				pos: position{line: 620, col: 5, offset: 18445},
 				run: (*parser).callonFunctionName1,
 				expr: &seqExpr{
-					pos: position{line: 544, col: 5, offset: 16351},
+					pos: position{line: 620, col: 5, offset: 18445},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 544, col: 5, offset: 16351},
+							pos:  position{line: 620, col: 5, offset: 18445},
 							name: "FunctionNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 544, col: 23, offset: 16369},
+							pos: position{line: 620, col: 23, offset: 18463},
 							expr: &ruleRefExpr{
-								pos:  position{line: 544, col: 23, offset: 16369},
+								pos:  position{line: 620, col: 23, offset: 18463},
 								name: "FunctionNameRest",
 							},
 						},
@@ -4010,9 +4672,9 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameStart",
-			pos:  position{line: 546, col: 1, offset: 16419},
+			pos:  position{line: 622, col: 1, offset: 18513},
 			expr: &charClassMatcher{
-				pos:        position{line: 546, col: 21, offset: 16439},
+				pos:        position{line: 622, col: 21, offset: 18533},
 				val:        "[A-Za-z]",
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
 				ignoreCase: false,
@@ -4021,16 +4683,16 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameRest",
-			pos:  position{line: 547, col: 1, offset: 16448},
+			pos:  position{line: 623, col: 1, offset: 18542},
 			expr: &choiceExpr{
-				pos: position{line: 547, col: 20, offset: 16467},
+				pos: position{line: 623, col: 20, offset: 18561},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 547, col: 20, offset: 16467},
+						pos:  position{line: 623, col: 20, offset: 18561},
 						name: "FunctionNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 547, col: 40, offset: 16487},
+						pos:        position{line: 623, col: 40, offset: 18581},
 						val:        "[.0-9]",
 						chars:      []rune{'.'},
 						ranges:     []rune{'0', '9'},
@@ -4042,53 +4704,53 @@ var g = &grammar{
 		},
 		{
 			name: "ArgumentList",
-			pos:  position{line: 549, col: 1, offset: 16495},
+			pos:  position{line: 625, col: 1, offset: 18589},
 			expr: &choiceExpr{
-				pos: position{line: 550, col: 5, offset: 16512},
+				pos: position{line: 626, col: 5, offset: 18606},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 550, col: 5, offset: 16512},
+						pos: position{line: 626, col: 5, offset: 18606},
 						run: (*parser).callonArgumentList2,
 						expr: &seqExpr{
-							pos: position{line: 550, col: 5, offset: 16512},
+							pos: position{line: 626, col: 5, offset: 18606},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 550, col: 5, offset: 16512},
+									pos:   position{line: 626, col: 5, offset: 18606},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 550, col: 11, offset: 16518},
+										pos:  position{line: 626, col: 11, offset: 18612},
 										name: "Expression",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 550, col: 22, offset: 16529},
+									pos:   position{line: 626, col: 22, offset: 18623},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 550, col: 27, offset: 16534},
+										pos: position{line: 626, col: 27, offset: 18628},
 										expr: &actionExpr{
-											pos: position{line: 550, col: 28, offset: 16535},
+											pos: position{line: 626, col: 28, offset: 18629},
 											run: (*parser).callonArgumentList8,
 											expr: &seqExpr{
-												pos: position{line: 550, col: 28, offset: 16535},
+												pos: position{line: 626, col: 28, offset: 18629},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 550, col: 28, offset: 16535},
+														pos:  position{line: 626, col: 28, offset: 18629},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 550, col: 31, offset: 16538},
+														pos:        position{line: 626, col: 31, offset: 18632},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 550, col: 35, offset: 16542},
+														pos:  position{line: 626, col: 35, offset: 18636},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 550, col: 38, offset: 16545},
+														pos:   position{line: 626, col: 38, offset: 18639},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 550, col: 40, offset: 16547},
+															pos:  position{line: 626, col: 40, offset: 18641},
 															name: "Expression",
 														},
 													},
@@ -4101,10 +4763,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 553, col: 5, offset: 16663},
+						pos: position{line: 629, col: 5, offset: 18756},
 						run: (*parser).callonArgumentList15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 553, col: 5, offset: 16663},
+							pos:  position{line: 629, col: 5, offset: 18756},
 							name: "__",
 						},
 					},
@@ -4113,28 +4775,28 @@ var g = &grammar{
 		},
 		{
 			name: "DereferenceExpression",
-			pos:  position{line: 555, col: 1, offset: 16699},
+			pos:  position{line: 631, col: 1, offset: 18792},
 			expr: &actionExpr{
-				pos: position{line: 556, col: 5, offset: 16725},
+				pos: position{line: 632, col: 5, offset: 18818},
 				run: (*parser).callonDereferenceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 556, col: 5, offset: 16725},
+					pos: position{line: 632, col: 5, offset: 18818},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 556, col: 5, offset: 16725},
+							pos:   position{line: 632, col: 5, offset: 18818},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 10, offset: 16730},
+								pos:  position{line: 632, col: 10, offset: 18823},
 								name: "PrimaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 557, col: 5, offset: 16752},
+							pos:   position{line: 633, col: 5, offset: 18845},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 557, col: 12, offset: 16759},
+								pos: position{line: 633, col: 12, offset: 18852},
 								expr: &ruleRefExpr{
-									pos:  position{line: 557, col: 13, offset: 16760},
+									pos:  position{line: 633, col: 13, offset: 18853},
 									name: "Deref",
 								},
 							},
@@ -4145,43 +4807,43 @@ var g = &grammar{
 		},
 		{
 			name: "Deref",
-			pos:  position{line: 561, col: 1, offset: 16830},
+			pos:  position{line: 637, col: 1, offset: 18923},
 			expr: &choiceExpr{
-				pos: position{line: 561, col: 9, offset: 16838},
+				pos: position{line: 637, col: 9, offset: 18931},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 561, col: 9, offset: 16838},
+						pos: position{line: 637, col: 9, offset: 18931},
 						run: (*parser).callonDeref2,
 						expr: &seqExpr{
-							pos: position{line: 561, col: 9, offset: 16838},
+							pos: position{line: 637, col: 9, offset: 18931},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 561, col: 9, offset: 16838},
+									pos:  position{line: 637, col: 9, offset: 18931},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 561, col: 12, offset: 16841},
+									pos:        position{line: 637, col: 12, offset: 18934},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 561, col: 16, offset: 16845},
+									pos:  position{line: 637, col: 16, offset: 18938},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 561, col: 19, offset: 16848},
+									pos:   position{line: 637, col: 19, offset: 18941},
 									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 561, col: 25, offset: 16854},
+										pos:  position{line: 637, col: 25, offset: 18947},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 561, col: 36, offset: 16865},
+									pos:  position{line: 637, col: 36, offset: 18958},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 561, col: 39, offset: 16868},
+									pos:        position{line: 637, col: 39, offset: 18961},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -4189,20 +4851,20 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 564, col: 5, offset: 16924},
+						pos: position{line: 640, col: 5, offset: 19017},
 						run: (*parser).callonDeref11,
 						expr: &seqExpr{
-							pos: position{line: 564, col: 5, offset: 16924},
+							pos: position{line: 640, col: 5, offset: 19017},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 564, col: 5, offset: 16924},
+									pos:  position{line: 640, col: 5, offset: 19017},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 564, col: 8, offset: 16927},
+									pos:   position{line: 640, col: 8, offset: 19020},
 									label: "p",
 									expr: &ruleRefExpr{
-										pos:  position{line: 564, col: 10, offset: 16929},
+										pos:  position{line: 640, col: 10, offset: 19022},
 										name: "DotField",
 									},
 								},
@@ -4214,27 +4876,27 @@ var g = &grammar{
 		},
 		{
 			name: "DotField",
-			pos:  position{line: 566, col: 1, offset: 16957},
+			pos:  position{line: 642, col: 1, offset: 19050},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 13, offset: 16969},
+				pos: position{line: 642, col: 12, offset: 19061},
 				run: (*parser).callonDotField1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 13, offset: 16969},
+					pos: position{line: 642, col: 12, offset: 19061},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 566, col: 13, offset: 16969},
+							pos:        position{line: 642, col: 12, offset: 19061},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 17, offset: 16973},
+							pos:  position{line: 642, col: 16, offset: 19065},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 566, col: 20, offset: 16976},
+							pos:   position{line: 642, col: 19, offset: 19068},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 566, col: 26, offset: 16982},
+								pos:  position{line: 642, col: 25, offset: 19074},
 								name: "Field",
 							},
 						},
@@ -4244,54 +4906,54 @@ var g = &grammar{
 		},
 		{
 			name: "duration",
-			pos:  position{line: 570, col: 1, offset: 17037},
+			pos:  position{line: 646, col: 1, offset: 19129},
 			expr: &choiceExpr{
-				pos: position{line: 571, col: 5, offset: 17050},
+				pos: position{line: 647, col: 5, offset: 19142},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 571, col: 5, offset: 17050},
+						pos:  position{line: 647, col: 5, offset: 19142},
 						name: "seconds",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 572, col: 5, offset: 17062},
+						pos:  position{line: 648, col: 5, offset: 19154},
 						name: "minutes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 573, col: 5, offset: 17074},
+						pos:  position{line: 649, col: 5, offset: 19166},
 						name: "hours",
 					},
 					&seqExpr{
-						pos: position{line: 574, col: 5, offset: 17084},
+						pos: position{line: 650, col: 5, offset: 19176},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 574, col: 5, offset: 17084},
+								pos:  position{line: 650, col: 5, offset: 19176},
 								name: "hours",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 574, col: 11, offset: 17090},
+								pos:  position{line: 650, col: 11, offset: 19182},
 								name: "_",
 							},
 							&litMatcher{
-								pos:        position{line: 574, col: 13, offset: 17092},
+								pos:        position{line: 650, col: 13, offset: 19184},
 								val:        "and",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 574, col: 19, offset: 17098},
+								pos:  position{line: 650, col: 19, offset: 19190},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 574, col: 21, offset: 17100},
+								pos:  position{line: 650, col: 21, offset: 19192},
 								name: "minutes",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 575, col: 5, offset: 17112},
+						pos:  position{line: 651, col: 5, offset: 19204},
 						name: "days",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 576, col: 5, offset: 17121},
+						pos:  position{line: 652, col: 5, offset: 19213},
 						name: "weeks",
 					},
 				},
@@ -4299,32 +4961,32 @@ var g = &grammar{
 		},
 		{
 			name: "sec_abbrev",
-			pos:  position{line: 578, col: 1, offset: 17128},
+			pos:  position{line: 654, col: 1, offset: 19220},
 			expr: &choiceExpr{
-				pos: position{line: 579, col: 5, offset: 17143},
+				pos: position{line: 655, col: 5, offset: 19235},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 579, col: 5, offset: 17143},
+						pos:        position{line: 655, col: 5, offset: 19235},
 						val:        "seconds",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 580, col: 5, offset: 17157},
+						pos:        position{line: 656, col: 5, offset: 19249},
 						val:        "second",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 581, col: 5, offset: 17170},
+						pos:        position{line: 657, col: 5, offset: 19262},
 						val:        "secs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 582, col: 5, offset: 17181},
+						pos:        position{line: 658, col: 5, offset: 19273},
 						val:        "sec",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 583, col: 5, offset: 17191},
+						pos:        position{line: 659, col: 5, offset: 19283},
 						val:        "s",
 						ignoreCase: false,
 					},
@@ -4333,32 +4995,32 @@ var g = &grammar{
 		},
 		{
 			name: "min_abbrev",
-			pos:  position{line: 585, col: 1, offset: 17196},
+			pos:  position{line: 661, col: 1, offset: 19288},
 			expr: &choiceExpr{
-				pos: position{line: 586, col: 5, offset: 17211},
+				pos: position{line: 662, col: 5, offset: 19303},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 586, col: 5, offset: 17211},
+						pos:        position{line: 662, col: 5, offset: 19303},
 						val:        "minutes",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 587, col: 5, offset: 17225},
+						pos:        position{line: 663, col: 5, offset: 19317},
 						val:        "minute",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 588, col: 5, offset: 17238},
+						pos:        position{line: 664, col: 5, offset: 19330},
 						val:        "mins",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 589, col: 5, offset: 17249},
+						pos:        position{line: 665, col: 5, offset: 19341},
 						val:        "min",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 590, col: 5, offset: 17259},
+						pos:        position{line: 666, col: 5, offset: 19351},
 						val:        "m",
 						ignoreCase: false,
 					},
@@ -4367,32 +5029,32 @@ var g = &grammar{
 		},
 		{
 			name: "hour_abbrev",
-			pos:  position{line: 592, col: 1, offset: 17264},
+			pos:  position{line: 668, col: 1, offset: 19356},
 			expr: &choiceExpr{
-				pos: position{line: 593, col: 5, offset: 17280},
+				pos: position{line: 669, col: 5, offset: 19372},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 593, col: 5, offset: 17280},
+						pos:        position{line: 669, col: 5, offset: 19372},
 						val:        "hours",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 594, col: 5, offset: 17292},
+						pos:        position{line: 670, col: 5, offset: 19384},
 						val:        "hrs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 595, col: 5, offset: 17302},
+						pos:        position{line: 671, col: 5, offset: 19394},
 						val:        "hr",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 596, col: 5, offset: 17311},
+						pos:        position{line: 672, col: 5, offset: 19403},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 597, col: 5, offset: 17319},
+						pos:        position{line: 673, col: 5, offset: 19411},
 						val:        "hour",
 						ignoreCase: false,
 					},
@@ -4401,22 +5063,22 @@ var g = &grammar{
 		},
 		{
 			name: "day_abbrev",
-			pos:  position{line: 599, col: 1, offset: 17327},
+			pos:  position{line: 675, col: 1, offset: 19419},
 			expr: &choiceExpr{
-				pos: position{line: 599, col: 14, offset: 17340},
+				pos: position{line: 675, col: 14, offset: 19432},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 599, col: 14, offset: 17340},
+						pos:        position{line: 675, col: 14, offset: 19432},
 						val:        "days",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 599, col: 21, offset: 17347},
+						pos:        position{line: 675, col: 21, offset: 19439},
 						val:        "day",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 599, col: 27, offset: 17353},
+						pos:        position{line: 675, col: 27, offset: 19445},
 						val:        "d",
 						ignoreCase: false,
 					},
@@ -4425,32 +5087,32 @@ var g = &grammar{
 		},
 		{
 			name: "week_abbrev",
-			pos:  position{line: 600, col: 1, offset: 17357},
+			pos:  position{line: 676, col: 1, offset: 19449},
 			expr: &choiceExpr{
-				pos: position{line: 600, col: 15, offset: 17371},
+				pos: position{line: 676, col: 15, offset: 19463},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 600, col: 15, offset: 17371},
+						pos:        position{line: 676, col: 15, offset: 19463},
 						val:        "weeks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 600, col: 23, offset: 17379},
+						pos:        position{line: 676, col: 23, offset: 19471},
 						val:        "week",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 600, col: 30, offset: 17386},
+						pos:        position{line: 676, col: 30, offset: 19478},
 						val:        "wks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 600, col: 36, offset: 17392},
+						pos:        position{line: 676, col: 36, offset: 19484},
 						val:        "wk",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 600, col: 41, offset: 17397},
+						pos:        position{line: 676, col: 41, offset: 19489},
 						val:        "w",
 						ignoreCase: false,
 					},
@@ -4459,42 +5121,42 @@ var g = &grammar{
 		},
 		{
 			name: "seconds",
-			pos:  position{line: 602, col: 1, offset: 17402},
+			pos:  position{line: 678, col: 1, offset: 19494},
 			expr: &choiceExpr{
-				pos: position{line: 603, col: 5, offset: 17414},
+				pos: position{line: 679, col: 5, offset: 19506},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 603, col: 5, offset: 17414},
+						pos: position{line: 679, col: 5, offset: 19506},
 						run: (*parser).callonseconds2,
 						expr: &litMatcher{
-							pos:        position{line: 603, col: 5, offset: 17414},
+							pos:        position{line: 679, col: 5, offset: 19506},
 							val:        "second",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 604, col: 5, offset: 17500},
+						pos: position{line: 680, col: 5, offset: 19592},
 						run: (*parser).callonseconds4,
 						expr: &seqExpr{
-							pos: position{line: 604, col: 5, offset: 17500},
+							pos: position{line: 680, col: 5, offset: 19592},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 604, col: 5, offset: 17500},
+									pos:   position{line: 680, col: 5, offset: 19592},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 604, col: 9, offset: 17504},
+										pos:  position{line: 680, col: 9, offset: 19596},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 604, col: 16, offset: 17511},
+									pos: position{line: 680, col: 16, offset: 19603},
 									expr: &ruleRefExpr{
-										pos:  position{line: 604, col: 16, offset: 17511},
+										pos:  position{line: 680, col: 16, offset: 19603},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 604, col: 19, offset: 17514},
+									pos:  position{line: 680, col: 19, offset: 19606},
 									name: "sec_abbrev",
 								},
 							},
@@ -4505,42 +5167,42 @@ var g = &grammar{
 		},
 		{
 			name: "minutes",
-			pos:  position{line: 606, col: 1, offset: 17601},
+			pos:  position{line: 682, col: 1, offset: 19693},
 			expr: &choiceExpr{
-				pos: position{line: 607, col: 5, offset: 17613},
+				pos: position{line: 683, col: 5, offset: 19705},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 607, col: 5, offset: 17613},
+						pos: position{line: 683, col: 5, offset: 19705},
 						run: (*parser).callonminutes2,
 						expr: &litMatcher{
-							pos:        position{line: 607, col: 5, offset: 17613},
+							pos:        position{line: 683, col: 5, offset: 19705},
 							val:        "minute",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 608, col: 5, offset: 17700},
+						pos: position{line: 684, col: 5, offset: 19792},
 						run: (*parser).callonminutes4,
 						expr: &seqExpr{
-							pos: position{line: 608, col: 5, offset: 17700},
+							pos: position{line: 684, col: 5, offset: 19792},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 608, col: 5, offset: 17700},
+									pos:   position{line: 684, col: 5, offset: 19792},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 608, col: 9, offset: 17704},
+										pos:  position{line: 684, col: 9, offset: 19796},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 608, col: 16, offset: 17711},
+									pos: position{line: 684, col: 16, offset: 19803},
 									expr: &ruleRefExpr{
-										pos:  position{line: 608, col: 16, offset: 17711},
+										pos:  position{line: 684, col: 16, offset: 19803},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 608, col: 19, offset: 17714},
+									pos:  position{line: 684, col: 19, offset: 19806},
 									name: "min_abbrev",
 								},
 							},
@@ -4551,42 +5213,42 @@ var g = &grammar{
 		},
 		{
 			name: "hours",
-			pos:  position{line: 610, col: 1, offset: 17810},
+			pos:  position{line: 686, col: 1, offset: 19902},
 			expr: &choiceExpr{
-				pos: position{line: 611, col: 5, offset: 17820},
+				pos: position{line: 687, col: 5, offset: 19912},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 611, col: 5, offset: 17820},
+						pos: position{line: 687, col: 5, offset: 19912},
 						run: (*parser).callonhours2,
 						expr: &litMatcher{
-							pos:        position{line: 611, col: 5, offset: 17820},
+							pos:        position{line: 687, col: 5, offset: 19912},
 							val:        "hour",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 612, col: 5, offset: 17907},
+						pos: position{line: 688, col: 5, offset: 19999},
 						run: (*parser).callonhours4,
 						expr: &seqExpr{
-							pos: position{line: 612, col: 5, offset: 17907},
+							pos: position{line: 688, col: 5, offset: 19999},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 612, col: 5, offset: 17907},
+									pos:   position{line: 688, col: 5, offset: 19999},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 612, col: 9, offset: 17911},
+										pos:  position{line: 688, col: 9, offset: 20003},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 612, col: 16, offset: 17918},
+									pos: position{line: 688, col: 16, offset: 20010},
 									expr: &ruleRefExpr{
-										pos:  position{line: 612, col: 16, offset: 17918},
+										pos:  position{line: 688, col: 16, offset: 20010},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 612, col: 19, offset: 17921},
+									pos:  position{line: 688, col: 19, offset: 20013},
 									name: "hour_abbrev",
 								},
 							},
@@ -4597,42 +5259,42 @@ var g = &grammar{
 		},
 		{
 			name: "days",
-			pos:  position{line: 614, col: 1, offset: 18020},
+			pos:  position{line: 690, col: 1, offset: 20112},
 			expr: &choiceExpr{
-				pos: position{line: 615, col: 5, offset: 18029},
+				pos: position{line: 691, col: 5, offset: 20121},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 615, col: 5, offset: 18029},
+						pos: position{line: 691, col: 5, offset: 20121},
 						run: (*parser).callondays2,
 						expr: &litMatcher{
-							pos:        position{line: 615, col: 5, offset: 18029},
+							pos:        position{line: 691, col: 5, offset: 20121},
 							val:        "day",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 616, col: 5, offset: 18118},
+						pos: position{line: 692, col: 5, offset: 20210},
 						run: (*parser).callondays4,
 						expr: &seqExpr{
-							pos: position{line: 616, col: 5, offset: 18118},
+							pos: position{line: 692, col: 5, offset: 20210},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 616, col: 5, offset: 18118},
+									pos:   position{line: 692, col: 5, offset: 20210},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 616, col: 9, offset: 18122},
+										pos:  position{line: 692, col: 9, offset: 20214},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 616, col: 16, offset: 18129},
+									pos: position{line: 692, col: 16, offset: 20221},
 									expr: &ruleRefExpr{
-										pos:  position{line: 616, col: 16, offset: 18129},
+										pos:  position{line: 692, col: 16, offset: 20221},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 616, col: 19, offset: 18132},
+									pos:  position{line: 692, col: 19, offset: 20224},
 									name: "day_abbrev",
 								},
 							},
@@ -4643,42 +5305,42 @@ var g = &grammar{
 		},
 		{
 			name: "weeks",
-			pos:  position{line: 618, col: 1, offset: 18235},
+			pos:  position{line: 694, col: 1, offset: 20327},
 			expr: &choiceExpr{
-				pos: position{line: 619, col: 5, offset: 18245},
+				pos: position{line: 695, col: 5, offset: 20337},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 619, col: 5, offset: 18245},
+						pos: position{line: 695, col: 5, offset: 20337},
 						run: (*parser).callonweeks2,
 						expr: &litMatcher{
-							pos:        position{line: 619, col: 5, offset: 18245},
+							pos:        position{line: 695, col: 5, offset: 20337},
 							val:        "week",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 620, col: 5, offset: 18337},
+						pos: position{line: 696, col: 5, offset: 20429},
 						run: (*parser).callonweeks4,
 						expr: &seqExpr{
-							pos: position{line: 620, col: 5, offset: 18337},
+							pos: position{line: 696, col: 5, offset: 20429},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 620, col: 5, offset: 18337},
+									pos:   position{line: 696, col: 5, offset: 20429},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 9, offset: 18341},
+										pos:  position{line: 696, col: 9, offset: 20433},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 620, col: 16, offset: 18348},
+									pos: position{line: 696, col: 16, offset: 20440},
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 16, offset: 18348},
+										pos:  position{line: 696, col: 16, offset: 20440},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 620, col: 19, offset: 18351},
+									pos:  position{line: 696, col: 19, offset: 20443},
 									name: "week_abbrev",
 								},
 							},
@@ -4689,53 +5351,53 @@ var g = &grammar{
 		},
 		{
 			name: "number",
-			pos:  position{line: 622, col: 1, offset: 18455},
+			pos:  position{line: 698, col: 1, offset: 20547},
 			expr: &ruleRefExpr{
-				pos:  position{line: 622, col: 10, offset: 18464},
+				pos:  position{line: 698, col: 10, offset: 20556},
 				name: "unsignedInteger",
 			},
 		},
 		{
 			name: "addr",
-			pos:  position{line: 626, col: 1, offset: 18510},
+			pos:  position{line: 702, col: 1, offset: 20575},
 			expr: &actionExpr{
-				pos: position{line: 627, col: 5, offset: 18519},
+				pos: position{line: 703, col: 5, offset: 20584},
 				run: (*parser).callonaddr1,
 				expr: &labeledExpr{
-					pos:   position{line: 627, col: 5, offset: 18519},
+					pos:   position{line: 703, col: 5, offset: 20584},
 					label: "a",
 					expr: &seqExpr{
-						pos: position{line: 627, col: 8, offset: 18522},
+						pos: position{line: 703, col: 8, offset: 20587},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 627, col: 8, offset: 18522},
+								pos:  position{line: 703, col: 8, offset: 20587},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 627, col: 24, offset: 18538},
+								pos:        position{line: 703, col: 24, offset: 20603},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 627, col: 28, offset: 18542},
+								pos:  position{line: 703, col: 28, offset: 20607},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 627, col: 44, offset: 18558},
+								pos:        position{line: 703, col: 44, offset: 20623},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 627, col: 48, offset: 18562},
+								pos:  position{line: 703, col: 48, offset: 20627},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 627, col: 64, offset: 18578},
+								pos:        position{line: 703, col: 64, offset: 20643},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 627, col: 68, offset: 18582},
+								pos:  position{line: 703, col: 68, offset: 20647},
 								name: "unsignedInteger",
 							},
 						},
@@ -4745,32 +5407,32 @@ var g = &grammar{
 		},
 		{
 			name: "ip6addr",
-			pos:  position{line: 631, col: 1, offset: 18762},
+			pos:  position{line: 707, col: 1, offset: 20698},
 			expr: &choiceExpr{
-				pos: position{line: 632, col: 5, offset: 18774},
+				pos: position{line: 708, col: 5, offset: 20710},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 632, col: 5, offset: 18774},
+						pos: position{line: 708, col: 5, offset: 20710},
 						run: (*parser).callonip6addr2,
 						expr: &seqExpr{
-							pos: position{line: 632, col: 5, offset: 18774},
+							pos: position{line: 708, col: 5, offset: 20710},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 632, col: 5, offset: 18774},
+									pos:   position{line: 708, col: 5, offset: 20710},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 632, col: 7, offset: 18776},
+										pos: position{line: 708, col: 7, offset: 20712},
 										expr: &ruleRefExpr{
-											pos:  position{line: 632, col: 8, offset: 18777},
+											pos:  position{line: 708, col: 8, offset: 20713},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 632, col: 20, offset: 18789},
+									pos:   position{line: 708, col: 20, offset: 20725},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 632, col: 22, offset: 18791},
+										pos:  position{line: 708, col: 22, offset: 20727},
 										name: "ip6tail",
 									},
 								},
@@ -4778,51 +5440,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 635, col: 5, offset: 18855},
+						pos: position{line: 711, col: 5, offset: 20791},
 						run: (*parser).callonip6addr9,
 						expr: &seqExpr{
-							pos: position{line: 635, col: 5, offset: 18855},
+							pos: position{line: 711, col: 5, offset: 20791},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 635, col: 5, offset: 18855},
+									pos:   position{line: 711, col: 5, offset: 20791},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 635, col: 7, offset: 18857},
+										pos:  position{line: 711, col: 7, offset: 20793},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 635, col: 11, offset: 18861},
+									pos:   position{line: 711, col: 11, offset: 20797},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 635, col: 13, offset: 18863},
+										pos: position{line: 711, col: 13, offset: 20799},
 										expr: &ruleRefExpr{
-											pos:  position{line: 635, col: 14, offset: 18864},
+											pos:  position{line: 711, col: 14, offset: 20800},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 635, col: 25, offset: 18875},
+									pos:        position{line: 711, col: 25, offset: 20811},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 635, col: 30, offset: 18880},
+									pos:   position{line: 711, col: 30, offset: 20816},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 635, col: 32, offset: 18882},
+										pos: position{line: 711, col: 32, offset: 20818},
 										expr: &ruleRefExpr{
-											pos:  position{line: 635, col: 33, offset: 18883},
+											pos:  position{line: 711, col: 33, offset: 20819},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 635, col: 45, offset: 18895},
+									pos:   position{line: 711, col: 45, offset: 20831},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 635, col: 47, offset: 18897},
+										pos:  position{line: 711, col: 47, offset: 20833},
 										name: "ip6tail",
 									},
 								},
@@ -4830,32 +5492,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 638, col: 5, offset: 18996},
+						pos: position{line: 714, col: 5, offset: 20932},
 						run: (*parser).callonip6addr22,
 						expr: &seqExpr{
-							pos: position{line: 638, col: 5, offset: 18996},
+							pos: position{line: 714, col: 5, offset: 20932},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 638, col: 5, offset: 18996},
+									pos:        position{line: 714, col: 5, offset: 20932},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 638, col: 10, offset: 19001},
+									pos:   position{line: 714, col: 10, offset: 20937},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 638, col: 12, offset: 19003},
+										pos: position{line: 714, col: 12, offset: 20939},
 										expr: &ruleRefExpr{
-											pos:  position{line: 638, col: 13, offset: 19004},
+											pos:  position{line: 714, col: 13, offset: 20940},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 638, col: 25, offset: 19016},
+									pos:   position{line: 714, col: 25, offset: 20952},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 638, col: 27, offset: 19018},
+										pos:  position{line: 714, col: 27, offset: 20954},
 										name: "ip6tail",
 									},
 								},
@@ -4863,32 +5525,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 641, col: 5, offset: 19089},
+						pos: position{line: 717, col: 5, offset: 21025},
 						run: (*parser).callonip6addr30,
 						expr: &seqExpr{
-							pos: position{line: 641, col: 5, offset: 19089},
+							pos: position{line: 717, col: 5, offset: 21025},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 641, col: 5, offset: 19089},
+									pos:   position{line: 717, col: 5, offset: 21025},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 641, col: 7, offset: 19091},
+										pos:  position{line: 717, col: 7, offset: 21027},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 641, col: 11, offset: 19095},
+									pos:   position{line: 717, col: 11, offset: 21031},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 641, col: 13, offset: 19097},
+										pos: position{line: 717, col: 13, offset: 21033},
 										expr: &ruleRefExpr{
-											pos:  position{line: 641, col: 14, offset: 19098},
+											pos:  position{line: 717, col: 14, offset: 21034},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 641, col: 25, offset: 19109},
+									pos:        position{line: 717, col: 25, offset: 21045},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -4896,10 +5558,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 644, col: 5, offset: 19177},
+						pos: position{line: 720, col: 5, offset: 21113},
 						run: (*parser).callonip6addr38,
 						expr: &litMatcher{
-							pos:        position{line: 644, col: 5, offset: 19177},
+							pos:        position{line: 720, col: 5, offset: 21113},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -4909,16 +5571,16 @@ var g = &grammar{
 		},
 		{
 			name: "ip6tail",
-			pos:  position{line: 648, col: 1, offset: 19214},
+			pos:  position{line: 724, col: 1, offset: 21150},
 			expr: &choiceExpr{
-				pos: position{line: 649, col: 5, offset: 19226},
+				pos: position{line: 725, col: 5, offset: 21162},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 649, col: 5, offset: 19226},
+						pos:  position{line: 725, col: 5, offset: 21162},
 						name: "addr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 650, col: 5, offset: 19235},
+						pos:  position{line: 726, col: 5, offset: 21171},
 						name: "h16",
 					},
 				},
@@ -4926,23 +5588,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_append",
-			pos:  position{line: 652, col: 1, offset: 19240},
+			pos:  position{line: 728, col: 1, offset: 21176},
 			expr: &actionExpr{
-				pos: position{line: 652, col: 12, offset: 19251},
+				pos: position{line: 728, col: 12, offset: 21187},
 				run: (*parser).callonh_append1,
 				expr: &seqExpr{
-					pos: position{line: 652, col: 12, offset: 19251},
+					pos: position{line: 728, col: 12, offset: 21187},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 652, col: 12, offset: 19251},
+							pos:        position{line: 728, col: 12, offset: 21187},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 652, col: 16, offset: 19255},
+							pos:   position{line: 728, col: 16, offset: 21191},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 18, offset: 19257},
+								pos:  position{line: 728, col: 18, offset: 21193},
 								name: "h16",
 							},
 						},
@@ -4952,23 +5614,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_prepend",
-			pos:  position{line: 653, col: 1, offset: 19294},
+			pos:  position{line: 729, col: 1, offset: 21230},
 			expr: &actionExpr{
-				pos: position{line: 653, col: 13, offset: 19306},
+				pos: position{line: 729, col: 13, offset: 21242},
 				run: (*parser).callonh_prepend1,
 				expr: &seqExpr{
-					pos: position{line: 653, col: 13, offset: 19306},
+					pos: position{line: 729, col: 13, offset: 21242},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 653, col: 13, offset: 19306},
+							pos:   position{line: 729, col: 13, offset: 21242},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 653, col: 15, offset: 19308},
+								pos:  position{line: 729, col: 15, offset: 21244},
 								name: "h16",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 653, col: 19, offset: 19312},
+							pos:        position{line: 729, col: 19, offset: 21248},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -4978,31 +5640,31 @@ var g = &grammar{
 		},
 		{
 			name: "subnet",
-			pos:  position{line: 655, col: 1, offset: 19350},
+			pos:  position{line: 731, col: 1, offset: 21286},
 			expr: &actionExpr{
-				pos: position{line: 656, col: 5, offset: 19361},
+				pos: position{line: 732, col: 5, offset: 21297},
 				run: (*parser).callonsubnet1,
 				expr: &seqExpr{
-					pos: position{line: 656, col: 5, offset: 19361},
+					pos: position{line: 732, col: 5, offset: 21297},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 656, col: 5, offset: 19361},
+							pos:   position{line: 732, col: 5, offset: 21297},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 656, col: 7, offset: 19363},
+								pos:  position{line: 732, col: 7, offset: 21299},
 								name: "addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 656, col: 12, offset: 19368},
+							pos:        position{line: 732, col: 12, offset: 21304},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 656, col: 16, offset: 19372},
+							pos:   position{line: 732, col: 16, offset: 21308},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 656, col: 18, offset: 19374},
+								pos:  position{line: 732, col: 18, offset: 21310},
 								name: "unsignedInteger",
 							},
 						},
@@ -5012,31 +5674,31 @@ var g = &grammar{
 		},
 		{
 			name: "ip6subnet",
-			pos:  position{line: 660, col: 1, offset: 19458},
+			pos:  position{line: 736, col: 1, offset: 21394},
 			expr: &actionExpr{
-				pos: position{line: 661, col: 5, offset: 19472},
+				pos: position{line: 737, col: 5, offset: 21408},
 				run: (*parser).callonip6subnet1,
 				expr: &seqExpr{
-					pos: position{line: 661, col: 5, offset: 19472},
+					pos: position{line: 737, col: 5, offset: 21408},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 661, col: 5, offset: 19472},
+							pos:   position{line: 737, col: 5, offset: 21408},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 661, col: 7, offset: 19474},
+								pos:  position{line: 737, col: 7, offset: 21410},
 								name: "ip6addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 661, col: 15, offset: 19482},
+							pos:        position{line: 737, col: 15, offset: 21418},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 661, col: 19, offset: 19486},
+							pos:   position{line: 737, col: 19, offset: 21422},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 661, col: 21, offset: 19488},
+								pos:  position{line: 737, col: 21, offset: 21424},
 								name: "unsignedInteger",
 							},
 						},
@@ -5046,15 +5708,15 @@ var g = &grammar{
 		},
 		{
 			name: "unsignedInteger",
-			pos:  position{line: 665, col: 1, offset: 19562},
+			pos:  position{line: 741, col: 1, offset: 21498},
 			expr: &actionExpr{
-				pos: position{line: 666, col: 5, offset: 19582},
+				pos: position{line: 742, col: 5, offset: 21518},
 				run: (*parser).callonunsignedInteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 666, col: 5, offset: 19582},
+					pos:   position{line: 742, col: 5, offset: 21518},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 666, col: 7, offset: 19584},
+						pos:  position{line: 742, col: 7, offset: 21520},
 						name: "suint",
 					},
 				},
@@ -5062,14 +5724,14 @@ var g = &grammar{
 		},
 		{
 			name: "suint",
-			pos:  position{line: 668, col: 1, offset: 19619},
+			pos:  position{line: 744, col: 1, offset: 21555},
 			expr: &actionExpr{
-				pos: position{line: 669, col: 5, offset: 19629},
+				pos: position{line: 745, col: 5, offset: 21565},
 				run: (*parser).callonsuint1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 669, col: 5, offset: 19629},
+					pos: position{line: 745, col: 5, offset: 21565},
 					expr: &charClassMatcher{
-						pos:        position{line: 669, col: 5, offset: 19629},
+						pos:        position{line: 745, col: 5, offset: 21565},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -5080,15 +5742,15 @@ var g = &grammar{
 		},
 		{
 			name: "integer",
-			pos:  position{line: 671, col: 1, offset: 19668},
+			pos:  position{line: 747, col: 1, offset: 21604},
 			expr: &actionExpr{
-				pos: position{line: 672, col: 5, offset: 19680},
+				pos: position{line: 748, col: 5, offset: 21616},
 				run: (*parser).calloninteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 672, col: 5, offset: 19680},
+					pos:   position{line: 748, col: 5, offset: 21616},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 672, col: 7, offset: 19682},
+						pos:  position{line: 748, col: 7, offset: 21618},
 						name: "sinteger",
 					},
 				},
@@ -5096,17 +5758,17 @@ var g = &grammar{
 		},
 		{
 			name: "sinteger",
-			pos:  position{line: 674, col: 1, offset: 19720},
+			pos:  position{line: 750, col: 1, offset: 21656},
 			expr: &actionExpr{
-				pos: position{line: 675, col: 5, offset: 19733},
+				pos: position{line: 751, col: 5, offset: 21669},
 				run: (*parser).callonsinteger1,
 				expr: &seqExpr{
-					pos: position{line: 675, col: 5, offset: 19733},
+					pos: position{line: 751, col: 5, offset: 21669},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 675, col: 5, offset: 19733},
+							pos: position{line: 751, col: 5, offset: 21669},
 							expr: &charClassMatcher{
-								pos:        position{line: 675, col: 5, offset: 19733},
+								pos:        position{line: 751, col: 5, offset: 21669},
 								val:        "[+-]",
 								chars:      []rune{'+', '-'},
 								ignoreCase: false,
@@ -5114,7 +5776,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 675, col: 11, offset: 19739},
+							pos:  position{line: 751, col: 11, offset: 21675},
 							name: "suint",
 						},
 					},
@@ -5123,15 +5785,15 @@ var g = &grammar{
 		},
 		{
 			name: "double",
-			pos:  position{line: 677, col: 1, offset: 19777},
+			pos:  position{line: 753, col: 1, offset: 21713},
 			expr: &actionExpr{
-				pos: position{line: 678, col: 5, offset: 19788},
+				pos: position{line: 754, col: 5, offset: 21724},
 				run: (*parser).callondouble1,
 				expr: &labeledExpr{
-					pos:   position{line: 678, col: 5, offset: 19788},
+					pos:   position{line: 754, col: 5, offset: 21724},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 678, col: 7, offset: 19790},
+						pos:  position{line: 754, col: 7, offset: 21726},
 						name: "sdouble",
 					},
 				},
@@ -5139,47 +5801,47 @@ var g = &grammar{
 		},
 		{
 			name: "sdouble",
-			pos:  position{line: 682, col: 1, offset: 19837},
+			pos:  position{line: 758, col: 1, offset: 21773},
 			expr: &choiceExpr{
-				pos: position{line: 683, col: 5, offset: 19849},
+				pos: position{line: 759, col: 5, offset: 21785},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 683, col: 5, offset: 19849},
+						pos: position{line: 759, col: 5, offset: 21785},
 						run: (*parser).callonsdouble2,
 						expr: &seqExpr{
-							pos: position{line: 683, col: 5, offset: 19849},
+							pos: position{line: 759, col: 5, offset: 21785},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 683, col: 5, offset: 19849},
+									pos: position{line: 759, col: 5, offset: 21785},
 									expr: &litMatcher{
-										pos:        position{line: 683, col: 5, offset: 19849},
+										pos:        position{line: 759, col: 5, offset: 21785},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 683, col: 10, offset: 19854},
+									pos: position{line: 759, col: 10, offset: 21790},
 									expr: &ruleRefExpr{
-										pos:  position{line: 683, col: 10, offset: 19854},
+										pos:  position{line: 759, col: 10, offset: 21790},
 										name: "doubleInteger",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 683, col: 25, offset: 19869},
+									pos:        position{line: 759, col: 25, offset: 21805},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 683, col: 29, offset: 19873},
+									pos: position{line: 759, col: 29, offset: 21809},
 									expr: &ruleRefExpr{
-										pos:  position{line: 683, col: 29, offset: 19873},
+										pos:  position{line: 759, col: 29, offset: 21809},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 683, col: 42, offset: 19886},
+									pos: position{line: 759, col: 42, offset: 21822},
 									expr: &ruleRefExpr{
-										pos:  position{line: 683, col: 42, offset: 19886},
+										pos:  position{line: 759, col: 42, offset: 21822},
 										name: "exponentPart",
 									},
 								},
@@ -5187,35 +5849,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 686, col: 5, offset: 19945},
+						pos: position{line: 762, col: 5, offset: 21881},
 						run: (*parser).callonsdouble13,
 						expr: &seqExpr{
-							pos: position{line: 686, col: 5, offset: 19945},
+							pos: position{line: 762, col: 5, offset: 21881},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 686, col: 5, offset: 19945},
+									pos: position{line: 762, col: 5, offset: 21881},
 									expr: &litMatcher{
-										pos:        position{line: 686, col: 5, offset: 19945},
+										pos:        position{line: 762, col: 5, offset: 21881},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 686, col: 10, offset: 19950},
+									pos:        position{line: 762, col: 10, offset: 21886},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 686, col: 14, offset: 19954},
+									pos: position{line: 762, col: 14, offset: 21890},
 									expr: &ruleRefExpr{
-										pos:  position{line: 686, col: 14, offset: 19954},
+										pos:  position{line: 762, col: 14, offset: 21890},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 686, col: 27, offset: 19967},
+									pos: position{line: 762, col: 27, offset: 21903},
 									expr: &ruleRefExpr{
-										pos:  position{line: 686, col: 27, offset: 19967},
+										pos:  position{line: 762, col: 27, offset: 21903},
 										name: "exponentPart",
 									},
 								},
@@ -5227,29 +5889,29 @@ var g = &grammar{
 		},
 		{
 			name: "doubleInteger",
-			pos:  position{line: 690, col: 1, offset: 20023},
+			pos:  position{line: 766, col: 1, offset: 21959},
 			expr: &choiceExpr{
-				pos: position{line: 691, col: 5, offset: 20041},
+				pos: position{line: 767, col: 5, offset: 21977},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 691, col: 5, offset: 20041},
+						pos:        position{line: 767, col: 5, offset: 21977},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 692, col: 5, offset: 20049},
+						pos: position{line: 768, col: 5, offset: 21985},
 						exprs: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 692, col: 5, offset: 20049},
+								pos:        position{line: 768, col: 5, offset: 21985},
 								val:        "[1-9]",
 								ranges:     []rune{'1', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 692, col: 11, offset: 20055},
+								pos: position{line: 768, col: 11, offset: 21991},
 								expr: &charClassMatcher{
-									pos:        position{line: 692, col: 11, offset: 20055},
+									pos:        position{line: 768, col: 11, offset: 21991},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
@@ -5263,9 +5925,9 @@ var g = &grammar{
 		},
 		{
 			name: "doubleDigit",
-			pos:  position{line: 694, col: 1, offset: 20063},
+			pos:  position{line: 770, col: 1, offset: 21999},
 			expr: &charClassMatcher{
-				pos:        position{line: 694, col: 15, offset: 20077},
+				pos:        position{line: 770, col: 15, offset: 22013},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -5274,17 +5936,17 @@ var g = &grammar{
 		},
 		{
 			name: "exponentPart",
-			pos:  position{line: 696, col: 1, offset: 20084},
+			pos:  position{line: 772, col: 1, offset: 22020},
 			expr: &seqExpr{
-				pos: position{line: 696, col: 16, offset: 20099},
+				pos: position{line: 772, col: 16, offset: 22035},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 696, col: 16, offset: 20099},
+						pos:        position{line: 772, col: 16, offset: 22035},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 696, col: 21, offset: 20104},
+						pos:  position{line: 772, col: 21, offset: 22040},
 						name: "sinteger",
 					},
 				},
@@ -5292,17 +5954,17 @@ var g = &grammar{
 		},
 		{
 			name: "h16",
-			pos:  position{line: 698, col: 1, offset: 20114},
+			pos:  position{line: 774, col: 1, offset: 22050},
 			expr: &actionExpr{
-				pos: position{line: 698, col: 7, offset: 20120},
+				pos: position{line: 774, col: 7, offset: 22056},
 				run: (*parser).callonh161,
 				expr: &labeledExpr{
-					pos:   position{line: 698, col: 7, offset: 20120},
+					pos:   position{line: 774, col: 7, offset: 22056},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 698, col: 13, offset: 20126},
+						pos: position{line: 774, col: 13, offset: 22062},
 						expr: &ruleRefExpr{
-							pos:  position{line: 698, col: 13, offset: 20126},
+							pos:  position{line: 774, col: 13, offset: 22062},
 							name: "hexdigit",
 						},
 					},
@@ -5311,9 +5973,9 @@ var g = &grammar{
 		},
 		{
 			name: "hexdigit",
-			pos:  position{line: 700, col: 1, offset: 20168},
+			pos:  position{line: 776, col: 1, offset: 22104},
 			expr: &charClassMatcher{
-				pos:        position{line: 700, col: 12, offset: 20179},
+				pos:        position{line: 776, col: 12, offset: 22115},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -5322,17 +5984,17 @@ var g = &grammar{
 		},
 		{
 			name: "searchWord",
-			pos:  position{line: 702, col: 1, offset: 20192},
+			pos:  position{line: 778, col: 1, offset: 22128},
 			expr: &actionExpr{
-				pos: position{line: 703, col: 5, offset: 20207},
+				pos: position{line: 779, col: 5, offset: 22143},
 				run: (*parser).callonsearchWord1,
 				expr: &labeledExpr{
-					pos:   position{line: 703, col: 5, offset: 20207},
+					pos:   position{line: 779, col: 5, offset: 22143},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 703, col: 11, offset: 20213},
+						pos: position{line: 779, col: 11, offset: 22149},
 						expr: &ruleRefExpr{
-							pos:  position{line: 703, col: 11, offset: 20213},
+							pos:  position{line: 779, col: 11, offset: 22149},
 							name: "searchWordPart",
 						},
 					},
@@ -5341,33 +6003,33 @@ var g = &grammar{
 		},
 		{
 			name: "searchWordPart",
-			pos:  position{line: 705, col: 1, offset: 20263},
+			pos:  position{line: 781, col: 1, offset: 22199},
 			expr: &choiceExpr{
-				pos: position{line: 706, col: 5, offset: 20282},
+				pos: position{line: 782, col: 5, offset: 22218},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 706, col: 5, offset: 20282},
+						pos: position{line: 782, col: 5, offset: 22218},
 						run: (*parser).callonsearchWordPart2,
 						expr: &seqExpr{
-							pos: position{line: 706, col: 5, offset: 20282},
+							pos: position{line: 782, col: 5, offset: 22218},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 706, col: 5, offset: 20282},
+									pos:        position{line: 782, col: 5, offset: 22218},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 706, col: 10, offset: 20287},
+									pos:   position{line: 782, col: 10, offset: 22223},
 									label: "s",
 									expr: &choiceExpr{
-										pos: position{line: 706, col: 13, offset: 20290},
+										pos: position{line: 782, col: 13, offset: 22226},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 706, col: 13, offset: 20290},
+												pos:  position{line: 782, col: 13, offset: 22226},
 												name: "escapeSequence",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 706, col: 30, offset: 20307},
+												pos:  position{line: 782, col: 30, offset: 22243},
 												name: "searchEscape",
 											},
 										},
@@ -5377,18 +6039,18 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 707, col: 5, offset: 20344},
+						pos: position{line: 783, col: 5, offset: 22279},
 						run: (*parser).callonsearchWordPart9,
 						expr: &seqExpr{
-							pos: position{line: 707, col: 5, offset: 20344},
+							pos: position{line: 783, col: 5, offset: 22279},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 707, col: 5, offset: 20344},
+									pos: position{line: 783, col: 5, offset: 22279},
 									expr: &choiceExpr{
-										pos: position{line: 707, col: 7, offset: 20346},
+										pos: position{line: 783, col: 7, offset: 22281},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 707, col: 7, offset: 20346},
+												pos:        position{line: 783, col: 7, offset: 22281},
 												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;:]",
 												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';', ':'},
 												ranges:     []rune{'\x00', '\x1f'},
@@ -5396,14 +6058,14 @@ var g = &grammar{
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 707, col: 43, offset: 20382},
+												pos:  position{line: 783, col: 43, offset: 22317},
 												name: "ws",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 707, col: 47, offset: 20386,
+									line: 783, col: 47, offset: 22321,
 								},
 							},
 						},
@@ -5413,34 +6075,34 @@ var g = &grammar{
 		},
 		{
 			name: "quotedString",
-			pos:  position{line: 709, col: 1, offset: 20420},
+			pos:  position{line: 785, col: 1, offset: 22355},
 			expr: &choiceExpr{
-				pos: position{line: 710, col: 5, offset: 20437},
+				pos: position{line: 786, col: 5, offset: 22372},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 710, col: 5, offset: 20437},
+						pos: position{line: 786, col: 5, offset: 22372},
 						run: (*parser).callonquotedString2,
 						expr: &seqExpr{
-							pos: position{line: 710, col: 5, offset: 20437},
+							pos: position{line: 786, col: 5, offset: 22372},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 710, col: 5, offset: 20437},
+									pos:        position{line: 786, col: 5, offset: 22372},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 710, col: 9, offset: 20441},
+									pos:   position{line: 786, col: 9, offset: 22376},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 710, col: 11, offset: 20443},
+										pos: position{line: 786, col: 11, offset: 22378},
 										expr: &ruleRefExpr{
-											pos:  position{line: 710, col: 11, offset: 20443},
+											pos:  position{line: 786, col: 11, offset: 22378},
 											name: "doubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 710, col: 29, offset: 20461},
+									pos:        position{line: 786, col: 29, offset: 22396},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -5448,29 +6110,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 711, col: 5, offset: 20498},
+						pos: position{line: 787, col: 5, offset: 22433},
 						run: (*parser).callonquotedString9,
 						expr: &seqExpr{
-							pos: position{line: 711, col: 5, offset: 20498},
+							pos: position{line: 787, col: 5, offset: 22433},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 711, col: 5, offset: 20498},
+									pos:        position{line: 787, col: 5, offset: 22433},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 711, col: 9, offset: 20502},
+									pos:   position{line: 787, col: 9, offset: 22437},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 711, col: 11, offset: 20504},
+										pos: position{line: 787, col: 11, offset: 22439},
 										expr: &ruleRefExpr{
-											pos:  position{line: 711, col: 11, offset: 20504},
+											pos:  position{line: 787, col: 11, offset: 22439},
 											name: "singleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 711, col: 29, offset: 20522},
+									pos:        position{line: 787, col: 29, offset: 22457},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -5482,55 +6144,55 @@ var g = &grammar{
 		},
 		{
 			name: "doubleQuotedChar",
-			pos:  position{line: 713, col: 1, offset: 20556},
+			pos:  position{line: 789, col: 1, offset: 22491},
 			expr: &choiceExpr{
-				pos: position{line: 714, col: 5, offset: 20577},
+				pos: position{line: 790, col: 5, offset: 22512},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 714, col: 5, offset: 20577},
+						pos: position{line: 790, col: 5, offset: 22512},
 						run: (*parser).callondoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 714, col: 5, offset: 20577},
+							pos: position{line: 790, col: 5, offset: 22512},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 714, col: 5, offset: 20577},
+									pos: position{line: 790, col: 5, offset: 22512},
 									expr: &choiceExpr{
-										pos: position{line: 714, col: 7, offset: 20579},
+										pos: position{line: 790, col: 7, offset: 22514},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 714, col: 7, offset: 20579},
+												pos:        position{line: 790, col: 7, offset: 22514},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 714, col: 13, offset: 20585},
+												pos:  position{line: 790, col: 13, offset: 22520},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 714, col: 26, offset: 20598,
+									line: 790, col: 26, offset: 22533,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 715, col: 5, offset: 20635},
+						pos: position{line: 791, col: 5, offset: 22570},
 						run: (*parser).callondoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 715, col: 5, offset: 20635},
+							pos: position{line: 791, col: 5, offset: 22570},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 715, col: 5, offset: 20635},
+									pos:        position{line: 791, col: 5, offset: 22570},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 715, col: 10, offset: 20640},
+									pos:   position{line: 791, col: 10, offset: 22575},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 715, col: 12, offset: 20642},
+										pos:  position{line: 791, col: 12, offset: 22577},
 										name: "escapeSequence",
 									},
 								},
@@ -5542,55 +6204,55 @@ var g = &grammar{
 		},
 		{
 			name: "singleQuotedChar",
-			pos:  position{line: 717, col: 1, offset: 20676},
+			pos:  position{line: 793, col: 1, offset: 22611},
 			expr: &choiceExpr{
-				pos: position{line: 718, col: 5, offset: 20697},
+				pos: position{line: 794, col: 5, offset: 22632},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 718, col: 5, offset: 20697},
+						pos: position{line: 794, col: 5, offset: 22632},
 						run: (*parser).callonsingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 718, col: 5, offset: 20697},
+							pos: position{line: 794, col: 5, offset: 22632},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 718, col: 5, offset: 20697},
+									pos: position{line: 794, col: 5, offset: 22632},
 									expr: &choiceExpr{
-										pos: position{line: 718, col: 7, offset: 20699},
+										pos: position{line: 794, col: 7, offset: 22634},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 718, col: 7, offset: 20699},
+												pos:        position{line: 794, col: 7, offset: 22634},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 718, col: 13, offset: 20705},
+												pos:  position{line: 794, col: 13, offset: 22640},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 718, col: 26, offset: 20718,
+									line: 794, col: 26, offset: 22653,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 719, col: 5, offset: 20755},
+						pos: position{line: 795, col: 5, offset: 22690},
 						run: (*parser).callonsingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 719, col: 5, offset: 20755},
+							pos: position{line: 795, col: 5, offset: 22690},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 719, col: 5, offset: 20755},
+									pos:        position{line: 795, col: 5, offset: 22690},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 719, col: 10, offset: 20760},
+									pos:   position{line: 795, col: 10, offset: 22695},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 719, col: 12, offset: 20762},
+										pos:  position{line: 795, col: 12, offset: 22697},
 										name: "escapeSequence",
 									},
 								},
@@ -5602,38 +6264,38 @@ var g = &grammar{
 		},
 		{
 			name: "escapeSequence",
-			pos:  position{line: 721, col: 1, offset: 20796},
+			pos:  position{line: 797, col: 1, offset: 22731},
 			expr: &choiceExpr{
-				pos: position{line: 722, col: 5, offset: 20815},
+				pos: position{line: 798, col: 5, offset: 22750},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 722, col: 5, offset: 20815},
+						pos: position{line: 798, col: 5, offset: 22750},
 						run: (*parser).callonescapeSequence2,
 						expr: &seqExpr{
-							pos: position{line: 722, col: 5, offset: 20815},
+							pos: position{line: 798, col: 5, offset: 22750},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 722, col: 5, offset: 20815},
+									pos:        position{line: 798, col: 5, offset: 22750},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 722, col: 9, offset: 20819},
+									pos:  position{line: 798, col: 9, offset: 22754},
 									name: "hexdigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 722, col: 18, offset: 20828},
+									pos:  position{line: 798, col: 18, offset: 22763},
 									name: "hexdigit",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 723, col: 5, offset: 20879},
+						pos:  position{line: 799, col: 5, offset: 22814},
 						name: "singleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 724, col: 5, offset: 20900},
+						pos:  position{line: 800, col: 5, offset: 22835},
 						name: "unicodeEscape",
 					},
 				},
@@ -5641,75 +6303,75 @@ var g = &grammar{
 		},
 		{
 			name: "singleCharEscape",
-			pos:  position{line: 726, col: 1, offset: 20915},
+			pos:  position{line: 802, col: 1, offset: 22850},
 			expr: &choiceExpr{
-				pos: position{line: 727, col: 5, offset: 20936},
+				pos: position{line: 803, col: 5, offset: 22871},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 727, col: 5, offset: 20936},
+						pos:        position{line: 803, col: 5, offset: 22871},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 728, col: 5, offset: 20944},
+						pos:        position{line: 804, col: 5, offset: 22879},
 						val:        "\"",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 729, col: 5, offset: 20952},
+						pos:        position{line: 805, col: 5, offset: 22887},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 730, col: 5, offset: 20961},
+						pos: position{line: 806, col: 5, offset: 22896},
 						run: (*parser).callonsingleCharEscape5,
 						expr: &litMatcher{
-							pos:        position{line: 730, col: 5, offset: 20961},
+							pos:        position{line: 806, col: 5, offset: 22896},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 731, col: 5, offset: 20990},
+						pos: position{line: 807, col: 5, offset: 22925},
 						run: (*parser).callonsingleCharEscape7,
 						expr: &litMatcher{
-							pos:        position{line: 731, col: 5, offset: 20990},
+							pos:        position{line: 807, col: 5, offset: 22925},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 732, col: 5, offset: 21019},
+						pos: position{line: 808, col: 5, offset: 22954},
 						run: (*parser).callonsingleCharEscape9,
 						expr: &litMatcher{
-							pos:        position{line: 732, col: 5, offset: 21019},
+							pos:        position{line: 808, col: 5, offset: 22954},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 733, col: 5, offset: 21048},
+						pos: position{line: 809, col: 5, offset: 22983},
 						run: (*parser).callonsingleCharEscape11,
 						expr: &litMatcher{
-							pos:        position{line: 733, col: 5, offset: 21048},
+							pos:        position{line: 809, col: 5, offset: 22983},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 734, col: 5, offset: 21077},
+						pos: position{line: 810, col: 5, offset: 23012},
 						run: (*parser).callonsingleCharEscape13,
 						expr: &litMatcher{
-							pos:        position{line: 734, col: 5, offset: 21077},
+							pos:        position{line: 810, col: 5, offset: 23012},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 735, col: 5, offset: 21106},
+						pos: position{line: 811, col: 5, offset: 23041},
 						run: (*parser).callonsingleCharEscape15,
 						expr: &litMatcher{
-							pos:        position{line: 735, col: 5, offset: 21106},
+							pos:        position{line: 811, col: 5, offset: 23041},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -5719,24 +6381,24 @@ var g = &grammar{
 		},
 		{
 			name: "searchEscape",
-			pos:  position{line: 737, col: 1, offset: 21132},
+			pos:  position{line: 813, col: 1, offset: 23067},
 			expr: &choiceExpr{
-				pos: position{line: 738, col: 5, offset: 21149},
+				pos: position{line: 814, col: 5, offset: 23084},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 738, col: 5, offset: 21149},
+						pos: position{line: 814, col: 5, offset: 23084},
 						run: (*parser).callonsearchEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 738, col: 5, offset: 21149},
+							pos:        position{line: 814, col: 5, offset: 23084},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 739, col: 5, offset: 21177},
+						pos: position{line: 815, col: 5, offset: 23112},
 						run: (*parser).callonsearchEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 739, col: 5, offset: 21177},
+							pos:        position{line: 815, col: 5, offset: 23112},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -5746,41 +6408,41 @@ var g = &grammar{
 		},
 		{
 			name: "unicodeEscape",
-			pos:  position{line: 741, col: 1, offset: 21204},
+			pos:  position{line: 817, col: 1, offset: 23139},
 			expr: &choiceExpr{
-				pos: position{line: 742, col: 5, offset: 21222},
+				pos: position{line: 818, col: 5, offset: 23157},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 742, col: 5, offset: 21222},
+						pos: position{line: 818, col: 5, offset: 23157},
 						run: (*parser).callonunicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 742, col: 5, offset: 21222},
+							pos: position{line: 818, col: 5, offset: 23157},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 742, col: 5, offset: 21222},
+									pos:        position{line: 818, col: 5, offset: 23157},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 742, col: 9, offset: 21226},
+									pos:   position{line: 818, col: 9, offset: 23161},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 742, col: 16, offset: 21233},
+										pos: position{line: 818, col: 16, offset: 23168},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 742, col: 16, offset: 21233},
+												pos:  position{line: 818, col: 16, offset: 23168},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 742, col: 25, offset: 21242},
+												pos:  position{line: 818, col: 25, offset: 23177},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 742, col: 34, offset: 21251},
+												pos:  position{line: 818, col: 34, offset: 23186},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 742, col: 43, offset: 21260},
+												pos:  position{line: 818, col: 43, offset: 23195},
 												name: "hexdigit",
 											},
 										},
@@ -5790,63 +6452,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 745, col: 5, offset: 21323},
+						pos: position{line: 821, col: 5, offset: 23258},
 						run: (*parser).callonunicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 745, col: 5, offset: 21323},
+							pos: position{line: 821, col: 5, offset: 23258},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 745, col: 5, offset: 21323},
+									pos:        position{line: 821, col: 5, offset: 23258},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 745, col: 9, offset: 21327},
+									pos:        position{line: 821, col: 9, offset: 23262},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 745, col: 13, offset: 21331},
+									pos:   position{line: 821, col: 13, offset: 23266},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 745, col: 20, offset: 21338},
+										pos: position{line: 821, col: 20, offset: 23273},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 745, col: 20, offset: 21338},
+												pos:  position{line: 821, col: 20, offset: 23273},
 												name: "hexdigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 745, col: 29, offset: 21347},
+												pos: position{line: 821, col: 29, offset: 23282},
 												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 29, offset: 21347},
+													pos:  position{line: 821, col: 29, offset: 23282},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 745, col: 39, offset: 21357},
+												pos: position{line: 821, col: 39, offset: 23292},
 												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 39, offset: 21357},
+													pos:  position{line: 821, col: 39, offset: 23292},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 745, col: 49, offset: 21367},
+												pos: position{line: 821, col: 49, offset: 23302},
 												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 49, offset: 21367},
+													pos:  position{line: 821, col: 49, offset: 23302},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 745, col: 59, offset: 21377},
+												pos: position{line: 821, col: 59, offset: 23312},
 												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 59, offset: 21377},
+													pos:  position{line: 821, col: 59, offset: 23312},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 745, col: 69, offset: 21387},
+												pos: position{line: 821, col: 69, offset: 23322},
 												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 69, offset: 21387},
+													pos:  position{line: 821, col: 69, offset: 23322},
 													name: "hexdigit",
 												},
 											},
@@ -5854,7 +6516,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 745, col: 80, offset: 21398},
+									pos:        position{line: 821, col: 80, offset: 23333},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -5866,28 +6528,28 @@ var g = &grammar{
 		},
 		{
 			name: "reString",
-			pos:  position{line: 749, col: 1, offset: 21452},
+			pos:  position{line: 825, col: 1, offset: 23387},
 			expr: &actionExpr{
-				pos: position{line: 750, col: 5, offset: 21465},
+				pos: position{line: 826, col: 5, offset: 23400},
 				run: (*parser).callonreString1,
 				expr: &seqExpr{
-					pos: position{line: 750, col: 5, offset: 21465},
+					pos: position{line: 826, col: 5, offset: 23400},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 750, col: 5, offset: 21465},
+							pos:        position{line: 826, col: 5, offset: 23400},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 750, col: 9, offset: 21469},
+							pos:   position{line: 826, col: 9, offset: 23404},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 750, col: 11, offset: 21471},
+								pos:  position{line: 826, col: 11, offset: 23406},
 								name: "reBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 750, col: 18, offset: 21478},
+							pos:        position{line: 826, col: 18, offset: 23413},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -5897,24 +6559,24 @@ var g = &grammar{
 		},
 		{
 			name: "reBody",
-			pos:  position{line: 752, col: 1, offset: 21501},
+			pos:  position{line: 828, col: 1, offset: 23436},
 			expr: &actionExpr{
-				pos: position{line: 753, col: 5, offset: 21512},
+				pos: position{line: 829, col: 5, offset: 23447},
 				run: (*parser).callonreBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 753, col: 5, offset: 21512},
+					pos: position{line: 829, col: 5, offset: 23447},
 					expr: &choiceExpr{
-						pos: position{line: 753, col: 6, offset: 21513},
+						pos: position{line: 829, col: 6, offset: 23448},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 753, col: 6, offset: 21513},
+								pos:        position{line: 829, col: 6, offset: 23448},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&litMatcher{
-								pos:        position{line: 753, col: 13, offset: 21520},
+								pos:        position{line: 829, col: 13, offset: 23455},
 								val:        "\\/",
 								ignoreCase: false,
 							},
@@ -5925,9 +6587,9 @@ var g = &grammar{
 		},
 		{
 			name: "escapedChar",
-			pos:  position{line: 755, col: 1, offset: 21560},
+			pos:  position{line: 831, col: 1, offset: 23495},
 			expr: &charClassMatcher{
-				pos:        position{line: 756, col: 5, offset: 21576},
+				pos:        position{line: 832, col: 5, offset: 23511},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -5937,37 +6599,37 @@ var g = &grammar{
 		},
 		{
 			name: "ws",
-			pos:  position{line: 758, col: 1, offset: 21591},
+			pos:  position{line: 834, col: 1, offset: 23526},
 			expr: &choiceExpr{
-				pos: position{line: 759, col: 5, offset: 21598},
+				pos: position{line: 835, col: 5, offset: 23533},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 759, col: 5, offset: 21598},
+						pos:        position{line: 835, col: 5, offset: 23533},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 760, col: 5, offset: 21607},
+						pos:        position{line: 836, col: 5, offset: 23542},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 761, col: 5, offset: 21616},
+						pos:        position{line: 837, col: 5, offset: 23551},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 762, col: 5, offset: 21625},
+						pos:        position{line: 838, col: 5, offset: 23560},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 763, col: 5, offset: 21633},
+						pos:        position{line: 839, col: 5, offset: 23568},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 764, col: 5, offset: 21646},
+						pos:        position{line: 840, col: 5, offset: 23581},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -5977,33 +6639,33 @@ var g = &grammar{
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 766, col: 1, offset: 21656},
+			pos:         position{line: 842, col: 1, offset: 23591},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 766, col: 18, offset: 21673},
+				pos: position{line: 842, col: 18, offset: 23608},
 				expr: &ruleRefExpr{
-					pos:  position{line: 766, col: 18, offset: 21673},
+					pos:  position{line: 842, col: 18, offset: 23608},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 767, col: 1, offset: 21677},
+			pos:  position{line: 843, col: 1, offset: 23612},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 767, col: 6, offset: 21682},
+				pos: position{line: 843, col: 6, offset: 23617},
 				expr: &ruleRefExpr{
-					pos:  position{line: 767, col: 6, offset: 21682},
+					pos:  position{line: 843, col: 6, offset: 23617},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 769, col: 1, offset: 21687},
+			pos:  position{line: 845, col: 1, offset: 23622},
 			expr: &notExpr{
-				pos: position{line: 769, col: 7, offset: 21693},
+				pos: position{line: 845, col: 7, offset: 23628},
 				expr: &anyMatcher{
-					line: 769, col: 8, offset: 21694,
+					line: 845, col: 8, offset: 23629,
 				},
 			},
 		},
@@ -7149,6 +7811,181 @@ func (p *parser) callonfuse1() (interface{}, error) {
 	return p.cur.onfuse1()
 }
 
+func (c *current) onlookup1(args, key interface{}) (interface{}, error) {
+	var argm = args.(map[string]interface{})
+	var proc = map[string]interface{}{"op": "LookupProc", "key": key, "table": "", "index": false, "miss": "keep"}
+	if _, ok := argm["file"]; ok {
+		proc["table"] = argm["file"]
+	}
+	if _, ok := argm["index"]; ok {
+		proc["table"] = argm["index"]
+		proc["index"] = true
+	}
+	if _, ok := argm["key"]; ok {
+		proc["key_field"] = argm["key"]
+	}
+	if _, ok := argm["miss"]; ok {
+		proc["miss"] = argm["miss"]
+	}
+	return proc, nil
+
+}
+
+func (p *parser) callonlookup1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookup1(stack["args"], stack["key"])
+}
+
+func (c *current) onlookupArgs4(a interface{}) (interface{}, error) {
+	return a, nil
+}
+
+func (p *parser) callonlookupArgs4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArgs4(stack["a"])
+}
+
+func (c *current) onlookupArgs1(args interface{}) (interface{}, error) {
+	return makeArgMap(args)
+}
+
+func (p *parser) callonlookupArgs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArgs1(stack["args"])
+}
+
+func (c *current) onlookupArg2(path interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "file", "value": path}, nil
+}
+
+func (p *parser) callonlookupArg2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArg2(stack["path"])
+}
+
+func (c *current) onlookupArg8(path interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "index", "value": path}, nil
+}
+
+func (p *parser) callonlookupArg8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArg8(stack["path"])
+}
+
+func (c *current) onlookupArg14(field interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "key", "value": field}, nil
+}
+
+func (p *parser) callonlookupArg14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArg14(stack["field"])
+}
+
+func (c *current) onlookupArg25() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonlookupArg25() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArg25()
+}
+
+func (c *current) onlookupArg20(miss interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "miss", "value": miss}, nil
+}
+
+func (p *parser) callonlookupArg20() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupArg20(stack["miss"])
+}
+
+func (c *current) onlookupPath3() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonlookupPath3() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlookupPath3()
+}
+
+func (c *current) onwindow11(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonwindow11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindow11(stack["k"])
+}
+
+func (c *current) onwindow1(args, reducers, keys interface{}) (interface{}, error) {
+	var argm = args.(map[string]interface{})
+	var proc = map[string]interface{}{"op": "WindowProc", "keys": keys, "reducers": reducers}
+	if _, ok := argm["rows"]; ok {
+		proc["rows"] = argm["rows"]
+	}
+	if _, ok := argm["range"]; ok {
+		proc["range"] = argm["range"]
+	}
+	return proc, nil
+
+}
+
+func (p *parser) callonwindow1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindow1(stack["args"], stack["reducers"], stack["keys"])
+}
+
+func (c *current) onwindowArgs4(a interface{}) (interface{}, error) {
+	return a, nil
+}
+
+func (p *parser) callonwindowArgs4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindowArgs4(stack["a"])
+}
+
+func (c *current) onwindowArgs1(args interface{}) (interface{}, error) {
+	return makeArgMap(args)
+}
+
+func (p *parser) callonwindowArgs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindowArgs1(stack["args"])
+}
+
+func (c *current) onwindowArg2(n interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "rows", "value": n}, nil
+}
+
+func (p *parser) callonwindowArg2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindowArg2(stack["n"])
+}
+
+func (c *current) onwindowArg8(dur interface{}) (interface{}, error) {
+	return map[string]interface{}{"name": "range", "value": dur}, nil
+}
+
+func (p *parser) callonwindowArg8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onwindowArg8(stack["dur"])
+}
+
 func (c *current) onExpressionAssignment1(f, e interface{}) (interface{}, error) {
 	return map[string]interface{}{"target": f, "expression": e}, nil
 
@@ -7160,6 +7997,16 @@ func (p *parser) callonExpressionAssignment1() (interface{}, error) {
 	return p.cur.onExpressionAssignment1(stack["f"], stack["e"])
 }
 
+func (c *current) onfieldPath1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonfieldPath1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldPath1()
+}
+
 func (c *current) onFieldAssignment1(l, r interface{}) (interface{}, error) {
 	return map[string]interface{}{"target": l, "source": r}, nil
 
@@ -7171,14 +8018,67 @@ func (p *parser) callonFieldAssignment1() (interface{}, error) {
 	return p.cur.onFieldAssignment1(stack["l"], stack["r"])
 }
 
-func (c *current) onPrimaryExpression11(expr interface{}) (interface{}, error) {
+func (c *current) onPrimaryExpression13(expr interface{}) (interface{}, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimaryExpression11() (interface{}, error) {
+func (p *parser) callonPrimaryExpression13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimaryExpression13(stack["expr"])
+}
+
+func (c *current) onRecordExpression9(f interface{}) (interface{}, error) {
+	return f, nil
+}
+
+func (p *parser) callonRecordExpression9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecordExpression9(stack["f"])
+}
+
+func (c *current) onRecordExpression1(first, rest interface{}) (interface{}, error) {
+	return map[string]interface{}{"op": "RecordExpr", "fields": append([]interface{}{first}, (rest.([]interface{}))...)}, nil
+
+}
+
+func (p *parser) callonRecordExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecordExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onRecordField1(f, e interface{}) (interface{}, error) {
+	return map[string]interface{}{"target": f, "expression": e}, nil
+
+}
+
+func (p *parser) callonRecordField1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecordField1(stack["f"], stack["e"])
+}
+
+func (c *current) onArrayExpression9(e interface{}) (interface{}, error) {
+	return e, nil
+}
+
+func (p *parser) callonArrayExpression9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimaryExpression11(stack["expr"])
+	return p.cur.onArrayExpression9(stack["e"])
+}
+
+func (c *current) onArrayExpression1(first, rest interface{}) (interface{}, error) {
+	return map[string]interface{}{"op": "ArrayExpr", "exprs": append([]interface{}{first}, (rest.([]interface{}))...)}, nil
+
+}
+
+func (p *parser) callonArrayExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayExpression1(stack["first"], stack["rest"])
 }
 
 func (c *current) onConditionalExpression2(condition, thenClause, elseClause interface{}) (interface{}, error) {
@@ -8065,18 +8965,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
@@ -129,3 +129,76 @@ func (r Record) Lookup(typ *zng.TypeRecord, fields []string) (zng.Type, Interfac
 	}
 	return r[k].column.(*Record).Lookup(typ, fields[1:])
 }
+
+// Cut returns a Record that reads only the fields of r named by paths,
+// where each path is a field name split on dots, together with the type of
+// the records it reads.  Fields appear in the order of typ, and paths naming
+// fields not present in typ are ignored.  If no path names a field of typ,
+// Cut returns a nil type.
+func (r Record) Cut(zctx *resolver.Context, typ *zng.TypeRecord, paths [][]string) (*zng.TypeRecord, Record, error) {
+	var columns []zng.Column
+	var out Record
+	for k, col := range typ.Columns {
+		var whole bool
+		var subpaths [][]string
+		for _, path := range paths {
+			if path[0] != col.Name {
+				continue
+			}
+			if len(path) == 1 {
+				whole = true
+				break
+			}
+			subpaths = append(subpaths, path[1:])
+		}
+		var f *Field
+		if r != nil {
+			f = r[k]
+		}
+		if whole {
+			columns = append(columns, col)
+			out = append(out, f)
+			continue
+		}
+		if len(subpaths) == 0 {
+			continue
+		}
+		rtyp, ok := col.Type.(*zng.TypeRecord)
+		if !ok {
+			// As with Lookup, a path through a non-record field
+			// is ignored.
+			continue
+		}
+		// A field whose values are all unset has no column, in which
+		// case only the type of the cut is needed.
+		var inner Record
+		if f != nil && f.column != nil {
+			inner = *f.column.(*Record)
+		}
+		subtyp, subrec, err := inner.Cut(zctx, rtyp, subpaths)
+		if err != nil {
+			return nil, nil, err
+		}
+		if subtyp == nil {
+			continue
+		}
+		columns = append(columns, zng.Column{Name: col.Name, Type: subtyp})
+		if r == nil {
+			// Only the type matters here and out is discarded.
+			continue
+		}
+		cut := &Field{isContainer: true, presence: f.presence}
+		if inner != nil {
+			cut.column = &subrec
+		}
+		out = append(out, cut)
+	}
+	if len(columns) == 0 {
+		return nil, nil, nil
+	}
+	typ, err := zctx.LookupTypeRecord(columns)
+	if err != nil {
+		return nil, nil, err
+	}
+	return typ, out, nil
+}
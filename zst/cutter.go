@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
@@ -39,49 +40,47 @@ func NewCutterFromPath(ctx context.Context, zctx *resolver.Context, path string,
 type CutAssembler struct {
 	zctx    *resolver.Context
 	root    *column.Int
-	schemas []*zng.TypeRecord
-	columns []column.Interface
+	columns []column.Record
 	types   []*zng.TypeRecord
-	nwrap   []int
 	builder zcode.Builder
-	leaf    string
 }
 
+// NewCutAssembler returns an assembler that reads only the given fields,
+// each a field name with dots separating the names of nested records, from
+// the object.  Records having none of the fields are skipped.
 func NewCutAssembler(zctx *resolver.Context, fields []string, object *Object) (*CutAssembler, error) {
+	paths := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		paths = append(paths, strings.Split(field, "."))
+	}
 	a := object.assembly
 	n := len(a.columns)
 	ca := &CutAssembler{
 		zctx:    zctx,
 		root:    &column.Int{},
-		schemas: a.schemas,
-		columns: make([]column.Interface, n),
+		columns: make([]column.Record, n),
 		types:   make([]*zng.TypeRecord, n),
-		nwrap:   make([]int, n),
-		leaf:    fields[len(fields)-1],
 	}
 	if err := ca.root.UnmarshalZNG(a.root, object.seeker); err != nil {
 		return nil, err
 	}
 	cnt := 0
 	for k, schema := range a.schemas {
-		var err error
 		rec := a.columns[k]
 		zv := zng.Value{rec.Type, rec.Raw}
-		topcol := &column.Record{}
-		if err := topcol.UnmarshalZNG(a.schemas[k], zv, object.seeker); err != nil {
+		topcol := column.Record{}
+		if err := topcol.UnmarshalZNG(schema, zv, object.seeker); err != nil {
 			return nil, err
 		}
-		_, ca.columns[k], err = topcol.Lookup(schema, fields)
-		if err == zng.ErrNoSuchField || err == column.ErrNonRecordAccess {
-			continue
-		}
+		typ, col, err := topcol.Cut(zctx, schema, paths)
 		if err != nil {
 			return nil, err
 		}
-		ca.types[k], ca.nwrap[k], err = cutType(zctx, schema, fields)
-		if err != nil {
-			return nil, err
+		if typ == nil {
+			continue
 		}
+		ca.types[k] = typ
+		ca.columns[k] = col
 		cnt++
 	}
 	if cnt == 0 {
@@ -90,35 +89,7 @@ func NewCutAssembler(zctx *resolver.Context, fields []string, object *Object) (*
 	return ca, nil
 }
 
-func cutType(zctx *resolver.Context, typ *zng.TypeRecord, fields []string) (*zng.TypeRecord, int, error) {
-	if len(fields) == 0 {
-		panic("zst.cutType cannot be called with an empty fields argument")
-	}
-	k, ok := typ.ColumnOfField(fields[0])
-	if !ok {
-		return nil, 0, zng.ErrNoSuchField
-	}
-	if len(fields) == 1 {
-		col := []zng.Column{typ.Columns[k]}
-		recType, err := zctx.LookupTypeRecord(col)
-		return recType, 0, err
-	}
-	fieldName := typ.Columns[k].Name
-	typ, ok = typ.Columns[k].Type.(*zng.TypeRecord)
-	if !ok {
-		return nil, 0, column.ErrNonRecordAccess
-	}
-	typ, nwrap, err := cutType(zctx, typ, fields[1:])
-	if err != nil {
-		return nil, 0, err
-	}
-	col := []zng.Column{{fieldName, typ}}
-	wrapType, err := zctx.LookupTypeRecord(col)
-	return wrapType, nwrap + 1, err
-}
-
 func (a *CutAssembler) Read() (*zng.Record, error) {
-	a.builder.Reset()
 	for {
 		schemaID, err := a.root.Read()
 		if err == io.EOF {
@@ -127,24 +98,20 @@ func (a *CutAssembler) Read() (*zng.Record, error) {
 		if schemaID < 0 || int(schemaID) >= len(a.columns) {
 			return nil, errors.New("bad schema id in root reassembly column")
 		}
-		col := a.columns[schemaID]
-		if col == nil {
-			// Skip records that don't have the field we're cutting.
+		recType := a.types[schemaID]
+		if recType == nil {
+			// Skip records that don't have the fields we're cutting.
 			continue
 		}
-		nwrap := a.nwrap[schemaID]
-		for n := nwrap; n > 0; n-- {
-			a.builder.BeginContainer()
+		a.builder.Reset()
+		if err := a.columns[schemaID].Read(&a.builder); err != nil {
+			return nil, err
 		}
-		err = col.Read(&a.builder)
+		body, err := a.builder.Bytes().ContainerBody()
 		if err != nil {
 			return nil, err
 		}
-		for n := nwrap; n > 0; n-- {
-			a.builder.EndContainer()
-		}
-		recType := a.types[schemaID]
-		rec := zng.NewRecord(recType, a.builder.Bytes())
+		rec := zng.NewRecord(recType, body)
 		//XXX if we had a buffer pool where records could be built back to
 		// back in batches, then we could get rid of this extra allocation
 		// and copy on every record